@@ -7,10 +7,15 @@ require (
 	github.com/client9/misspell v0.3.4
 	github.com/golangci/golangci-lint v1.25.0
 	github.com/hashicorp/go-cty v1.4.1-0.20200414143053-d3edf31b6320
+	github.com/hashicorp/go-retryablehttp v0.7.1
 	github.com/hashicorp/go-uuid v1.0.2
 	github.com/hashicorp/terraform-plugin-sdk/v2 v2.10.1
 	github.com/jinzhu/copier v0.4.0
 	github.com/mitchellh/gox v1.0.1
+	// Pinned: the v4 SDK clients are configured through unexported fields of their ApiClient in
+	// nutanix/sdks/v4/sdkconfig/sdkfields.go, audited against exactly the versions below. Upgrading
+	// any of them requires re-auditing that file and updating its auditedV4SDKVersions,
+	// TestV4SDKVersionsAudited fails until then.
 	github.com/nutanix/ntnx-api-golang-clients/clustermgmt-go-client/v4 v4.2.2
 	github.com/nutanix/ntnx-api-golang-clients/datapolicies-go-client/v4 v4.2.2
 	github.com/nutanix/ntnx-api-golang-clients/dataprotection-go-client/v4 v4.3.1
@@ -76,7 +81,6 @@ require (
 	github.com/hashicorp/go-hclog v1.6.3 // indirect
 	github.com/hashicorp/go-multierror v1.1.1 // indirect
 	github.com/hashicorp/go-plugin v1.4.1 // indirect
-	github.com/hashicorp/go-retryablehttp v0.7.1 // indirect
 	github.com/hashicorp/go-version v1.7.0 // indirect
 	github.com/hashicorp/hc-install v0.3.1 // indirect
 	github.com/hashicorp/hcl v1.0.0 // indirect
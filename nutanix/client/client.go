@@ -3,7 +3,6 @@ package client
 import (
 	"bytes"
 	"context"
-	"crypto/tls"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -16,7 +15,6 @@ import (
 	"strings"
 
 	"github.com/PaesslerAG/jsonpath"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 )
 
 const (
@@ -75,8 +73,18 @@ type Credentials struct {
 	NdbEndpoint        string              // Required field for connecting to Era VM APIs.
 	NdbUsername        string
 	NdbPassword        string
-	APIKey             string            // API key for authentication (alternative to username/password)
-	CustomHeaders      map[string]string // Custom headers to add to all requests (e.g., for Cloudflare Access)
+	APIKey             string             // API key for authentication (alternative to username/password)
+	CustomHeaders      map[string]string  // Custom headers to add to all requests (e.g., for Cloudflare Access)
+	MaxRetries         int                // Maximum number of retries for transient API failures, 0 disables retries
+	RetryMaxWait       int                // Maximum wait in seconds between two retries
+	CACertificate      string             // PEM encoded CA certificates trusted in addition to the system ones
+	CAFile             string             // Path to a PEM encoded CA bundle trusted in addition to the system ones
+	ClientCertificate  string             // PEM encoded client certificate for mutual TLS
+	ClientKey          string             // PEM encoded private key of the client certificate
+	RateLimiter        *RateLimiter       // Rate limiter shared by all the clients created from the provider configuration
+	Endpoints          *EndpointPool      // Prism Central endpoints to fail over between, nil if a single endpoint is configured
+	CredentialsSource  *CredentialsSource // Source of the Prism Central credentials, refreshed on expiry, nil if they are static
+	Tracer             *Tracer            // Tracer of the requests shared by all the clients, nil if tracing is disabled
 }
 
 // AdditionalFilter specification for client side filters
@@ -135,17 +143,23 @@ func NewClient(credentials *Credentials, userAgent string, absolutePath string,
 		}
 
 		// override transport config incase of using proxy
-		transCfg := &http.Transport{
-			//nolint:gas
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: credentials.Insecure}, // ignore expired SSL certificates
+		transCfg, err := newTransport(credentials)
+		if err != nil {
+			return nil, err
 		}
 		transCfg.Proxy = http.ProxyURL(proxy)
-		baseClient.client.Transport = logging.NewTransport("Nutanix", transCfg)
+		baseClient.client.Transport = credentials.RateLimiter.Transport(credentials.Tracer.Transport(transCfg))
 	}
 
+	// requests are authenticated with the current credentials of the source, if any
+	baseClient.client.Transport = credentials.CredentialsSource.Transport(baseClient.client.Transport)
+
 	if credentials.SessionAuth {
 		log.Printf("[DEBUG] Using session_auth\n")
 
+		// session cookies are renewed by the transport whenever the session expires
+		baseClient.client.Transport = NewSession().Transport(baseClient.client.Transport)
+
 		ctx := context.TODO()
 		req, err := baseClient.NewRequest(ctx, http.MethodGet, "/users/me", nil)
 		if err != nil {
@@ -163,8 +177,6 @@ func NewClient(credentials *Credentials, userAgent string, absolutePath string,
 		}()
 
 		err = CheckResponse(resp)
-
-		baseClient.Cookies = resp.Cookies()
 	}
 
 	return baseClient, nil
@@ -176,15 +188,15 @@ func NewBaseClient(credentials *Credentials, absolutePath string, isHTTP bool) (
 		return nil, fmt.Errorf("absolutePath argument must be passed")
 	}
 
-	httpClient := http.DefaultClient
-
-	transCfg := &http.Transport{
-
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: credentials.Insecure,
-		},
+	transCfg, err := newTransport(credentials)
+	if err != nil {
+		return nil, err
+	}
+	// every client gets its own http client, so that clients with different
+	// endpoints or TLS settings do not override each other's transport
+	httpClient := &http.Client{
+		Transport: credentials.RateLimiter.Transport(credentials.Tracer.Transport(transCfg)),
 	}
-	httpClient.Transport = logging.NewTransport("Nutanix", transCfg)
 
 	protocol := httpsPrefix
 	if isHTTP {
@@ -327,7 +339,9 @@ func (c *Client) NewUploadRequest(ctx context.Context, method, urlStr string, fi
 	}
 	req.ContentLength = fileInfo.Size()
 	req.GetBody = func() (io.ReadCloser, error) {
-		return io.NopCloser(fileReader), nil
+		// read the whole file independently of the request body, so that a retried upload sends the
+		// whole file again and a copy of the body does not consume the request body
+		return io.NopCloser(io.NewSectionReader(fileReader, 0, req.ContentLength)), nil
 	}
 
 	req.Header.Add("Content-Type", octetStreamType)
@@ -366,7 +380,9 @@ func (c *Client) NewUnAuthUploadRequest(ctx context.Context, method, urlStr stri
 	}
 	req.ContentLength = fileInfo.Size()
 	req.GetBody = func() (io.ReadCloser, error) {
-		return io.NopCloser(fileReader), nil
+		// read the whole file independently of the request body, so that a retried upload sends the
+		// whole file again and a copy of the body does not consume the request body
+		return io.NopCloser(io.NewSectionReader(fileReader, 0, req.ContentLength)), nil
 	}
 
 	req.Header.Add("Content-Type", octetStreamType)
@@ -388,7 +404,7 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) error
 	}
 
 	req = req.WithContext(ctx)
-	resp, err := c.client.Do(req)
+	resp, err := c.doWithRetry(ctx, req)
 	if err != nil {
 		return err
 	}
@@ -442,7 +458,7 @@ func (c *Client) DoWithFilters(ctx context.Context, req *http.Request, v interfa
 		return fmt.Errorf("%s", c.ErrorMsg)
 	}
 	req = req.WithContext(ctx)
-	resp, err := c.client.Do(req)
+	resp, err := c.doWithRetry(ctx, req)
 	if err != nil {
 		return err
 	}
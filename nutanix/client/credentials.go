@@ -0,0 +1,217 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// credentialsCommandTimeout bounds the run of a credentials helper
+	credentialsCommandTimeout = time.Minute
+	// credentialsExpiryMargin is how long before their expiry credentials are fetched again
+	credentialsExpiryMargin = time.Minute
+)
+
+// SourcedCredentials are the credentials returned by a CredentialsSource, as the JSON document
+//
+//	{"username": "...", "password": "...", "api_key": "...", "expires_at": "2006-01-02T15:04:05Z"}
+//
+// Either username and password or api_key must be set, expires_at is optional.
+type SourcedCredentials struct {
+	Username  string    `json:"username"`
+	Password  string    `json:"password"`
+	APIKey    string    `json:"api_key"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// CredentialsSource provides the Prism Central credentials from a JSON file or from the output of an
+// external helper command, in the manner of the docker credential helpers. The credentials are kept
+// until they expire or are rejected by the server, the file is then read again or the helper run
+// again. A credentials source is safe for concurrent use.
+type CredentialsSource struct {
+	file     string
+	command  string
+	args     []string
+	endpoint string
+
+	// mu is held while the credentials are fetched, so that concurrent requests fetch them once
+	mu     sync.Mutex
+	cached *SourcedCredentials
+}
+
+// NewFileCredentialsSource returns a source reading the credentials from the JSON file path
+func NewFileCredentialsSource(path string) *CredentialsSource {
+	return &CredentialsSource{file: path}
+}
+
+// NewExecCredentialsSource returns a source running command with args and reading the credentials
+// from its standard output. The endpoint the credentials are requested for is written to its
+// standard input.
+func NewExecCredentialsSource(command string, args []string, endpoint string) *CredentialsSource {
+	return &CredentialsSource{command: command, args: args, endpoint: endpoint}
+}
+
+// Get returns the current credentials, fetching them if they are missing or about to expire
+func (s *CredentialsSource) Get(ctx context.Context) (SourcedCredentials, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cached != nil && !s.cached.expired() {
+		return *s.cached, nil
+	}
+	return s.fetch(ctx)
+}
+
+// refresh fetches the credentials again if they are still stale, which have been rejected by the
+// server. If a concurrent request has fetched them meanwhile, the new credentials are returned.
+func (s *CredentialsSource) refresh(ctx context.Context, stale SourcedCredentials) (SourcedCredentials, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cached != nil && *s.cached != stale && !s.cached.expired() {
+		return *s.cached, nil
+	}
+	return s.fetch(ctx)
+}
+
+func (s *CredentialsSource) fetch(ctx context.Context) (SourcedCredentials, error) {
+	var data []byte
+	var err error
+	if s.command != "" {
+		data, err = s.run(ctx)
+	} else {
+		data, err = os.ReadFile(s.file)
+		if err != nil {
+			err = fmt.Errorf("error reading credentials file: %s", err)
+		}
+	}
+	if err != nil {
+		return SourcedCredentials{}, err
+	}
+
+	creds := SourcedCredentials{}
+	if err := json.Unmarshal(data, &creds); err != nil {
+		// the document may hold secrets, it is not part of the error
+		return SourcedCredentials{}, fmt.Errorf("error decoding credentials from %s: invalid JSON document", s)
+	}
+	if creds.APIKey == "" && (creds.Username == "" || creds.Password == "") {
+		return SourcedCredentials{}, fmt.Errorf("credentials from %s must have either username and password or api_key", s)
+	}
+
+	log.Printf("[DEBUG] credentials fetched from %s", s)
+	s.cached = &creds
+	return creds, nil
+}
+
+// run runs the helper command and returns its standard output
+func (s *CredentialsSource) run(ctx context.Context) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, credentialsCommandTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, s.command, s.args...)
+	cmd.Stdin = strings.NewReader(s.endpoint)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("error running credentials helper %s: %s: %s", s.command, err, msg)
+		}
+		return nil, fmt.Errorf("error running credentials helper %s: %s", s.command, err)
+	}
+	return stdout.Bytes(), nil
+}
+
+// String describes the source, without any secret
+func (s *CredentialsSource) String() string {
+	if s.command != "" {
+		return fmt.Sprintf("helper %s", s.command)
+	}
+	return fmt.Sprintf("file %s", s.file)
+}
+
+// expired reports whether the credentials expire within credentialsExpiryMargin
+func (c *SourcedCredentials) expired() bool {
+	return !c.ExpiresAt.IsZero() && time.Now().Add(credentialsExpiryMargin).After(c.ExpiresAt)
+}
+
+// Transport wraps next so that requests sent through it are authenticated with the current
+// credentials of the source
+func (s *CredentialsSource) Transport(next http.RoundTripper) http.RoundTripper {
+	if s == nil {
+		return next
+	}
+	return &credentialsTransport{source: s, next: next}
+}
+
+type credentialsTransport struct {
+	source *CredentialsSource
+	next   http.RoundTripper
+}
+
+// RoundTrip replaces the credentials of requests with the current ones of the source. If the server
+// rejects them, they are fetched again and the request is sent once more with the new credentials.
+// Requests without credentials (e.g. using a session cookie) are sent as is.
+func (t *credentialsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("Authorization") == "" && req.Header.Get("X-Ntnx-Api-Key") == "" {
+		return t.next.RoundTrip(req)
+	}
+
+	creds, err := t.source.Get(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(withCredentials(req, creds))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || !canReplay(req) {
+		return resp, err
+	}
+
+	fresh, err := t.source.refresh(req.Context(), creds)
+	if err != nil {
+		log.Printf("[WARN] %s %s: could not refresh rejected credentials: %s", req.Method, req.URL.Path, err)
+		return resp, nil
+	}
+	if fresh == creds {
+		return resp, nil
+	}
+
+	// drain the body so the underlying connection can be reused
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	log.Printf("[DEBUG] %s %s: credentials rejected, sending again with refreshed credentials", req.Method, req.URL.Path)
+	replay := withCredentials(req, fresh)
+	if req.Body != nil && req.Body != http.NoBody {
+		if replay.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+	return t.next.RoundTrip(replay)
+}
+
+// withCredentials returns a copy of req authenticated with creds, the API key taking precedence
+func withCredentials(req *http.Request, creds SourcedCredentials) *http.Request {
+	r := req.Clone(req.Context())
+	r.Header.Del("Authorization")
+	r.Header.Del("X-Ntnx-Api-Key")
+	if creds.APIKey != "" {
+		r.Header.Set("X-Ntnx-Api-Key", creds.APIKey)
+	} else {
+		r.Header.Set("Authorization", "Basic "+
+			base64.StdEncoding.EncodeToString([]byte(creds.Username+":"+creds.Password)))
+	}
+	return r
+}
@@ -0,0 +1,156 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func writeCredentialsFile(t *testing.T, path, content string) {
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("os.WriteFile(): %v", err)
+	}
+}
+
+func TestCredentialsSource_file(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	writeCredentialsFile(t, path, `{"username": "admin", "password": "secret"}`)
+
+	source := NewFileCredentialsSource(path)
+	creds, err := source.Get(context.Background())
+	if err != nil {
+		t.Fatalf("Get(): %v", err)
+	}
+	if creds.Username != "admin" || creds.Password != "secret" {
+		t.Errorf("Get() = %+v, expected the credentials of the file", creds)
+	}
+
+	// credentials without expiry are kept until rejected
+	writeCredentialsFile(t, path, `{"api_key": "key"}`)
+	if creds, _ = source.Get(context.Background()); creds.Username != "admin" {
+		t.Errorf("Get() = %+v, expected the cached credentials", creds)
+	}
+	if creds, _ = source.refresh(context.Background(), creds); creds.APIKey != "key" {
+		t.Errorf("refresh() = %+v, expected the credentials read again", creds)
+	}
+}
+
+func TestCredentialsSource_invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials.json")
+
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{"missing password", `{"username": "admin"}`, "must have either username and password or api_key"},
+		{"invalid document", `username=admin password=secret`, "invalid JSON document"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writeCredentialsFile(t, path, tt.content)
+			_, err := NewFileCredentialsSource(path).Get(context.Background())
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Get() error = %v, expected %q", err, tt.wantErr)
+			}
+			if err != nil && strings.Contains(err.Error(), "secret") {
+				t.Errorf("Get() error %q discloses the password", err)
+			}
+		})
+	}
+}
+
+func TestCredentialsSource_exec(t *testing.T) {
+	if _, err := os.Stat("/bin/sh"); err != nil {
+		t.Skip("no shell available")
+	}
+	counter := filepath.Join(t.TempDir(), "runs")
+
+	// the helper echoes the endpoint it is given on stdin, and returns credentials expiring now
+	expiresAt := time.Now().UTC().Format(time.RFC3339)
+	script := fmt.Sprintf(`echo run >> %s; read endpoint; printf '{"username": "%%s", "password": "p", "expires_at": "%s"}' "$endpoint"`, counter, expiresAt)
+	source := NewExecCredentialsSource("/bin/sh", []string{"-c", script}, "pc.example.com")
+
+	for i := 0; i < 2; i++ {
+		creds, err := source.Get(context.Background())
+		if err != nil {
+			t.Fatalf("Get(): %v", err)
+		}
+		if creds.Username != "pc.example.com" {
+			t.Errorf("Get() username = %s, expected the endpoint", creds.Username)
+		}
+	}
+
+	// expired credentials are fetched again
+	runs, _ := os.ReadFile(counter)
+	if n := strings.Count(string(runs), "run"); n != 2 {
+		t.Errorf("helper ran %d times, expected 2", n)
+	}
+
+	failing := NewExecCredentialsSource("/bin/sh", []string{"-c", "echo locked >&2; exit 3"}, "")
+	if _, err := failing.Get(context.Background()); err == nil || !strings.Contains(err.Error(), "locked") {
+		t.Errorf("Get() error = %v, expected the helper error output", err)
+	}
+}
+
+func TestCredentialsSource_transport(t *testing.T) {
+	mux, client, server := setup()
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	writeCredentialsFile(t, path, `{"username": "admin", "password": "old"}`)
+	client.client.Transport = NewFileCredentialsSource(path).Transport(client.client.Transport)
+
+	var mu sync.Mutex
+	valid := "old"
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if _, password, _ := r.BasicAuth(); password != valid {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"message": "invalid credentials"}`)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		fmt.Fprintf(w, `{"body": %q}`, string(body))
+	})
+
+	req, _ := client.NewRequest(context.TODO(), http.MethodGet, "/", nil)
+	if err := client.Do(context.Background(), req, nil); err != nil {
+		t.Fatalf("Do(): %v", err)
+	}
+
+	// rotate the credentials, the request is sent again once the file has been read again
+	mu.Lock()
+	valid = "new"
+	mu.Unlock()
+	writeCredentialsFile(t, path, `{"username": "admin", "password": "new"}`)
+
+	req, _ = client.NewRequest(context.TODO(), http.MethodPost, "/vms", map[string]string{"name": "bar"})
+	body := make(map[string]string)
+	if err := client.Do(context.Background(), req, &body); err != nil {
+		t.Fatalf("Do(): %v", err)
+	}
+	if body["body"] != "{\"name\":\"bar\"}\n" {
+		t.Errorf("replayed request body = %q, expected the original body", body["body"])
+	}
+
+	// credentials rejected again are not retried forever
+	mu.Lock()
+	valid = "other"
+	mu.Unlock()
+
+	req, _ = client.NewRequest(context.TODO(), http.MethodGet, "/", nil)
+	if err := client.Do(context.Background(), req, nil); err == nil {
+		t.Error("Do() succeeded with rejected credentials")
+	}
+}
@@ -0,0 +1,180 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// endpointDialTimeout bounds the connection attempt to an endpoint when other endpoints are left to try
+	endpointDialTimeout = 10 * time.Second
+	// endpointRetryInterval is how long an unreachable endpoint is skipped before being tried again
+	endpointRetryInterval = time.Minute
+	// endpointProbeInterval is the interval between two health checks of an unreachable endpoint
+	endpointProbeInterval = 15 * time.Second
+)
+
+// DialFunc dials a network connection, as http.Transport.DialContext
+type DialFunc func(ctx context.Context, network, addr string) (net.Conn, error)
+
+// EndpointPool is an ordered list of equivalent endpoints, e.g. the nodes of a scale-out Prism Central.
+// Connections to any of them are established with the first reachable endpoint in order. An endpoint
+// which cannot be reached is skipped until endpointRetryInterval has passed. Meanwhile it is health
+// checked in the background every endpointProbeInterval, so that the preferred endpoints are used again
+// as soon as they accept connections.
+type EndpointPool struct {
+	mu        sync.Mutex
+	addrs     []string
+	downUntil map[string]time.Time
+	probing   map[string]bool
+
+	probeInterval time.Duration
+	probeDial     DialFunc
+}
+
+// NewEndpointPool returns a pool of endpoints, given as host or host:port, port being used for the
+// ones without port. nil is returned if there is less than two endpoints to fail over between.
+func NewEndpointPool(endpoints []string, port string) *EndpointPool {
+	addrs := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		endpoint = strings.TrimSpace(endpoint)
+		if endpoint == "" {
+			continue
+		}
+
+		addr := endpoint
+		if _, _, err := net.SplitHostPort(endpoint); err != nil && port != "" {
+			addr = net.JoinHostPort(strings.Trim(endpoint, "[]"), port)
+		}
+		if !searchSlice(addrs, addr) {
+			addrs = append(addrs, addr)
+		}
+	}
+
+	if len(addrs) < 2 {
+		return nil
+	}
+	dialer := &net.Dialer{Timeout: endpointDialTimeout}
+	return &EndpointPool{
+		addrs:         addrs,
+		downUntil:     make(map[string]time.Time),
+		probing:       make(map[string]bool),
+		probeInterval: endpointProbeInterval,
+		probeDial:     dialer.DialContext,
+	}
+}
+
+// DialContext wraps dial so that connections to any endpoint of the pool fail over to the next
+// reachable endpoint on connection errors. Connections to other addresses are dialed as is.
+func (p *EndpointPool) DialContext(dial DialFunc) DialFunc {
+	if p == nil {
+		return dial
+	}
+
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		if !searchSlice(p.addrs, addr) {
+			return dial(ctx, network, addr)
+		}
+
+		candidates := p.candidates()
+		errs := make([]string, 0, len(candidates))
+		for i, candidate := range candidates {
+			attemptCtx, cancel := ctx, context.CancelFunc(func() {})
+			if i < len(candidates)-1 {
+				attemptCtx, cancel = context.WithTimeout(ctx, endpointDialTimeout)
+			}
+			conn, err := dial(attemptCtx, network, candidate)
+			cancel()
+
+			if err == nil {
+				p.markUp(candidate)
+				if candidate != addr {
+					log.Printf("[DEBUG] connecting to %s instead of %s", candidate, addr)
+				}
+				return conn, nil
+			}
+			if ctx.Err() != nil {
+				return nil, err
+			}
+
+			p.markDown(candidate)
+			log.Printf("[WARN] endpoint %s is unreachable: %s", candidate, err)
+			errs = append(errs, err.Error())
+		}
+
+		return nil, fmt.Errorf("no endpoint is reachable: %s", strings.Join(errs, "; "))
+	}
+}
+
+// candidates returns the endpoints to try in order: the ones not known as unreachable first, then
+// the unreachable ones as a last resort.
+func (p *EndpointPool) candidates() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	up := make([]string, 0, len(p.addrs))
+	down := make([]string, 0)
+	for _, addr := range p.addrs {
+		if until, ok := p.downUntil[addr]; ok && now.Before(until) {
+			down = append(down, addr)
+		} else {
+			up = append(up, addr)
+		}
+	}
+	return append(up, down...)
+}
+
+func (p *EndpointPool) markUp(addr string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.downUntil, addr)
+}
+
+// markDown records addr as unreachable and starts health checking it, unless it is already checked
+func (p *EndpointPool) markDown(addr string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.downUntil[addr] = time.Now().Add(endpointRetryInterval)
+	if !p.probing[addr] {
+		p.probing[addr] = true
+		go p.probe(addr)
+	}
+}
+
+// probe health checks the unreachable endpoint addr every probeInterval with a TCP connection, and
+// marks it reachable as soon as it accepts one. It stops once addr is reachable.
+func (p *EndpointPool) probe(addr string) {
+	ticker := time.NewTicker(p.probeInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if conn, err := p.probeDial(context.Background(), "tcp", addr); err == nil {
+			conn.Close()
+			log.Printf("[INFO] endpoint %s is reachable again", addr)
+			p.markUp(addr)
+		}
+		if p.stopProbing(addr) {
+			return
+		}
+	}
+}
+
+// stopProbing ends the health check of addr if it is reachable
+func (p *EndpointPool) stopProbing(addr string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, down := p.downUntil[addr]; down {
+		return false
+	}
+	delete(p.probing, addr)
+	return true
+}
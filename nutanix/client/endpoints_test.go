@@ -0,0 +1,174 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewEndpointPool(t *testing.T) {
+	tests := []struct {
+		name      string
+		endpoints []string
+		port      string
+		want      []string
+	}{
+		{
+			name:      "port added and duplicates removed",
+			endpoints: []string{"10.0.0.1", "10.0.0.2", " 10.0.0.1 ", "10.0.0.3:9440"},
+			port:      "9440",
+			want:      []string{"10.0.0.1:9440", "10.0.0.2:9440", "10.0.0.3:9440"},
+		},
+		{
+			name:      "ipv6 endpoints",
+			endpoints: []string{"[fd00::1]", "fd00::2"},
+			port:      "9440",
+			want:      []string{"[fd00::1]:9440", "[fd00::2]:9440"},
+		},
+		{
+			name:      "single endpoint",
+			endpoints: []string{"10.0.0.1", "10.0.0.1:9440", ""},
+			port:      "9440",
+			want:      nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pool := NewEndpointPool(tt.endpoints, tt.port)
+			var got []string
+			if pool != nil {
+				got = pool.addrs
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewEndpointPool() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// unreachableAddr returns the address of a closed listener
+func unreachableAddr(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen(): %v", err)
+	}
+	addr := l.Addr().String()
+	l.Close()
+	return addr
+}
+
+func TestEndpointPool_failover(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+
+	down := unreachableAddr(t)
+	up := server.Listener.Addr().String()
+	pool := NewEndpointPool([]string{down, up}, "")
+
+	dialed := make([]string, 0)
+	dialer := &net.Dialer{}
+	client := &http.Client{Transport: &http.Transport{
+		DialContext: pool.DialContext(func(ctx context.Context, network, addr string) (net.Conn, error) {
+			dialed = append(dialed, addr)
+			return dialer.DialContext(ctx, network, addr)
+		}),
+		DisableKeepAlives: true,
+	}}
+
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get("http://" + down + "/")
+		if err != nil {
+			t.Fatalf("Get(): %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	// the unreachable endpoint must be skipped once known as down
+	if want := []string{down, up, up}; !reflect.DeepEqual(dialed, want) {
+		t.Errorf("dialed %v, want %v", dialed, want)
+	}
+	if candidates := pool.candidates(); !reflect.DeepEqual(candidates, []string{up, down}) {
+		t.Errorf("candidates() = %v, want %v", candidates, []string{up, down})
+	}
+}
+
+func TestEndpointPool_allUnreachable(t *testing.T) {
+	pool := NewEndpointPool([]string{unreachableAddr(t), unreachableAddr(t)}, "")
+	dialer := &net.Dialer{}
+
+	_, err := pool.DialContext(dialer.DialContext)(context.Background(), "tcp", pool.addrs[0])
+	if err == nil {
+		t.Fatal("DialContext() succeeded, expected an error")
+	}
+}
+
+func TestEndpointPool_otherAddress(t *testing.T) {
+	pool := NewEndpointPool([]string{"10.0.0.1", "10.0.0.2"}, "9440")
+
+	var dialed string
+	dial := pool.DialContext(func(ctx context.Context, network, addr string) (net.Conn, error) {
+		dialed = addr
+		return nil, fmt.Errorf("unreachable")
+	})
+	if _, err := dial(context.Background(), "tcp", "proxy:3128"); err == nil || dialed != "proxy:3128" {
+		t.Errorf("DialContext() dialed %s, expected proxy:3128 only", dialed)
+	}
+}
+
+func TestEndpointPool_healthCheck(t *testing.T) {
+	// the preferred endpoint is down, its address is reused once it is back
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen(): %v", err)
+	}
+	preferred := l.Addr().String()
+	l.Close()
+
+	fallback, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen(): %v", err)
+	}
+	defer fallback.Close()
+
+	pool := NewEndpointPool([]string{preferred, fallback.Addr().String()}, "")
+	pool.probeInterval = 10 * time.Millisecond
+
+	dialer := &net.Dialer{}
+	conn, err := pool.DialContext(dialer.DialContext)(context.Background(), "tcp", preferred)
+	if err != nil {
+		t.Fatalf("DialContext(): %v", err)
+	}
+	conn.Close()
+	if candidates := pool.candidates(); candidates[0] == preferred {
+		t.Fatalf("candidates() = %v, expected %s to be skipped", candidates, preferred)
+	}
+
+	l, err = net.Listen("tcp", preferred)
+	if err != nil {
+		t.Skipf("could not listen on %s again: %v", preferred, err)
+	}
+	defer l.Close()
+
+	// the health check marks the endpoint reachable without waiting for endpointRetryInterval
+	deadline := time.Now().Add(2 * time.Second)
+	for pool.candidates()[0] != preferred {
+		if time.Now().After(deadline) {
+			t.Fatalf("candidates() = %v, expected %s first once it is back", pool.candidates(), preferred)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	if pool.probing[preferred] {
+		t.Errorf("%s is still health checked once reachable", preferred)
+	}
+}
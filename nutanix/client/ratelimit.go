@@ -0,0 +1,150 @@
+package client
+
+import (
+	"context"
+	"log"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RateLimiter limits the rate and the concurrency of requests sent by all the clients sharing it.
+// Rate is limited with a token bucket, requests waiting for a token or for a free slot are served
+// in their order of arrival.
+type RateLimiter struct {
+	// token bucket state, guarded by mu
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+
+	// slots holds one entry per request in flight, nil if concurrency is not limited
+	slots chan struct{}
+}
+
+// NewRateLimiter returns a rate limiter allowing requestsPerSecond requests per second and at most
+// maxConcurrent requests in flight. Zero or negative values disable the respective limit, nil is
+// returned if both are disabled.
+func NewRateLimiter(requestsPerSecond float64, maxConcurrent int) *RateLimiter {
+	if requestsPerSecond <= 0 && maxConcurrent <= 0 {
+		return nil
+	}
+
+	l := &RateLimiter{}
+	if requestsPerSecond > 0 {
+		l.rate = requestsPerSecond
+		l.burst = math.Max(1, math.Floor(requestsPerSecond))
+		l.tokens = l.burst
+		l.last = time.Now()
+	}
+	if maxConcurrent > 0 {
+		l.slots = make(chan struct{}, maxConcurrent)
+	}
+	return l
+}
+
+// Wait blocks until the request is allowed to be sent or ctx is done. The returned function
+// must be called once the request has completed to free its concurrency slot.
+func (l *RateLimiter) Wait(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	release := func() {
+		if l.slots != nil {
+			<-l.slots
+		}
+	}
+
+	if wait := l.reserve(); wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			l.cancelReservation()
+			release()
+			return nil, ctx.Err()
+		}
+	}
+
+	return release, nil
+}
+
+// Reserve takes a token from the bucket for a request sent later without going through Wait, a
+// retry sent below the limiter, and returns how long to delay it. It does not take a concurrency slot.
+func (l *RateLimiter) Reserve() time.Duration {
+	if l == nil {
+		return 0
+	}
+	return l.reserve()
+}
+
+// reserve takes a token from the bucket and returns how long to wait before it becomes
+// available. Tokens can go negative, so that later callers wait behind earlier ones.
+func (l *RateLimiter) reserve() time.Duration {
+	if l.rate <= 0 {
+		return 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancelReservation gives back a token reserved by a request which will not be sent
+func (l *RateLimiter) cancelReservation() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens = math.Min(l.burst, l.tokens+1)
+}
+
+// Transport wraps next so that every request sent through it is subject to the rate limiter
+func (l *RateLimiter) Transport(next http.RoundTripper) http.RoundTripper {
+	if l == nil {
+		return next
+	}
+	return &rateLimitedTransport{limiter: l, next: next}
+}
+
+type rateLimitedTransport struct {
+	limiter *RateLimiter
+	next    http.RoundTripper
+}
+
+// RoundTrip waits for the rate limiter before sending the request. Concurrency slot is held
+// until the response headers are received, it does not depend on callers closing the body.
+func (t *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	release, err := t.limiter.Wait(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if waited := time.Since(start); waited >= time.Millisecond {
+		log.Printf("[DEBUG] %s %s waited %s in request queue", req.Method, req.URL.Path, waited)
+	}
+
+	return t.next.RoundTrip(req)
+}
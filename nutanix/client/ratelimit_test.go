@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNewRateLimiter_disabled(t *testing.T) {
+	if l := NewRateLimiter(0, 0); l != nil {
+		t.Errorf("NewRateLimiter(0, 0) = %v, expected nil", l)
+	}
+
+	// nil limiter must not limit anything
+	var l *RateLimiter
+	release, err := l.Wait(context.Background())
+	if err != nil {
+		t.Fatalf("Wait(): %v", err)
+	}
+	release()
+}
+
+func TestRateLimiter_rate(t *testing.T) {
+	l := NewRateLimiter(20, 0)
+
+	start := time.Now()
+	for i := 0; i < 30; i++ {
+		release, err := l.Wait(context.Background())
+		if err != nil {
+			t.Fatalf("Wait(): %v", err)
+		}
+		release()
+	}
+
+	// burst of 20 requests is allowed, remaining 10 requests take 0.5s
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Errorf("30 requests at 20 rps took %s, expected at least %s", elapsed, 400*time.Millisecond)
+	}
+}
+
+func TestRateLimiter_reserve(t *testing.T) {
+	l := NewRateLimiter(10, 0)
+
+	// burst of 10 tokens is available, the 11th is available after 0.1s
+	for i := 0; i < 10; i++ {
+		if wait := l.Reserve(); wait != 0 {
+			t.Fatalf("Reserve() = %s within burst, expected 0", wait)
+		}
+	}
+	if wait := l.Reserve(); wait < 50*time.Millisecond || wait > 100*time.Millisecond {
+		t.Errorf("Reserve() = %s after burst, expected about 100ms", wait)
+	}
+
+	var disabled *RateLimiter
+	if wait := disabled.Reserve(); wait != 0 {
+		t.Errorf("Reserve() = %s on nil limiter, expected 0", wait)
+	}
+}
+
+func TestRateLimiter_cancelled(t *testing.T) {
+	l := NewRateLimiter(0, 1)
+
+	release, err := l.Wait(context.Background())
+	if err != nil {
+		t.Fatalf("Wait(): %v", err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := l.Wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Wait() error = %v, expected %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestDo_maxConcurrentRequests(t *testing.T) {
+	mux, client, server := setup()
+	defer server.Close()
+
+	maxConcurrent := 2
+	client.client.Transport = NewRateLimiter(0, maxConcurrent).Transport(client.client.Transport)
+
+	var inFlight, maxInFlight int32
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		for {
+			seen := atomic.LoadInt32(&maxInFlight)
+			if current <= seen || atomic.CompareAndSwapInt32(&maxInFlight, seen, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		fmt.Fprint(w, `{}`)
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := client.NewRequest(context.TODO(), http.MethodGet, "/", nil)
+			if err := client.Do(context.Background(), req, nil); err != nil {
+				t.Errorf("Do(): %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight > int32(maxConcurrent) {
+		t.Errorf("server saw %d concurrent requests, expected at most %d", maxInFlight, maxConcurrent)
+	}
+}
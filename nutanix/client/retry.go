@@ -0,0 +1,200 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	// retryBaseWait is the wait before the first retry, doubled on every subsequent attempt
+	retryBaseWait = 1 * time.Second
+	// defaultRetryMaxWait caps the wait between two attempts when no explicit limit is configured
+	defaultRetryMaxWait = 30 * time.Second
+)
+
+// retryableStatusCodes are the transient status codes returned by Prism Central
+// while it is busy or being upgraded.
+var retryableStatusCodes = map[int]bool{
+	http.StatusTooManyRequests:    true,
+	http.StatusBadGateway:         true,
+	http.StatusServiceUnavailable: true,
+	http.StatusGatewayTimeout:     true,
+}
+
+// maxRetries returns the number of retries configured for the client
+func (c *Client) maxRetries() int {
+	if c.Credentials == nil || c.Credentials.MaxRetries < 0 {
+		return 0
+	}
+	return c.Credentials.MaxRetries
+}
+
+// retryMaxWait returns the maximum wait between two attempts configured for the client
+func (c *Client) retryMaxWait() time.Duration {
+	if c.Credentials == nil || c.Credentials.RetryMaxWait <= 0 {
+		return defaultRetryMaxWait
+	}
+	return time.Duration(c.Credentials.RetryMaxWait) * time.Second
+}
+
+// doWithRetry sends the request and retries it with exponential backoff and jitter
+// as long as the failure is transient and it is safe to replay the request.
+// Response returned is the last one received, its body is left open for the caller.
+func (c *Client) doWithRetry(ctx context.Context, req *http.Request) (*http.Response, error) {
+	retries := c.maxRetries()
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			body, err := rewindBody(req)
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		resp, err := c.client.Do(req)
+
+		if attempt >= retries || !isRetryable(req, resp, err) {
+			return resp, err
+		}
+
+		wait := backoff(attempt, c.retryMaxWait(), resp)
+		if err != nil {
+			log.Printf("[DEBUG] %s %s failed: %s. Retrying in %s (attempt %d/%d)", req.Method, req.URL.Path, err, wait, attempt+1, retries)
+		} else {
+			log.Printf("[DEBUG] %s %s returned %d. Retrying in %s (attempt %d/%d)", req.Method, req.URL.Path, resp.StatusCode, wait, attempt+1, retries)
+			// drain the body so the underlying connection can be reused
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// rewindBody returns a fresh copy of the request body so that it can be sent again
+func rewindBody(req *http.Request) (io.ReadCloser, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req.Body, nil
+	}
+	return req.GetBody()
+}
+
+// isRetryable reports whether the outcome of a request is transient and whether the
+// request can be replayed safely.
+//
+// Idempotent requests are retried on any connection error and on all retryable status
+// codes. Non-idempotent requests are only retried when the server has certainly not
+// processed them: the connection could not be established, or the server rejected the
+// request with 429 (Too Many Requests) or 503 (Service Unavailable).
+func isRetryable(req *http.Request, resp *http.Response, err error) bool {
+	// a request whose body cannot be rebuilt must never be replayed
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	idempotent := isIdempotent(req)
+
+	if err != nil {
+		if isConnectionRefused(err) {
+			return true
+		}
+		return idempotent && isConnectionError(err)
+	}
+
+	if !retryableStatusCodes[resp.StatusCode] {
+		return false
+	}
+	if idempotent {
+		return true
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable
+}
+
+// isIdempotent reports whether the request can be sent more than once without side effects.
+// v3 list APIs use POST but only read entities, so they are treated as idempotent too.
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		return strings.HasSuffix(strings.TrimSuffix(req.URL.Path, "/"), "/list")
+	}
+	return false
+}
+
+// isConnectionRefused reports whether the request failed before it reached the server
+func isConnectionRefused(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNREFUSED)
+}
+
+// isConnectionError reports whether the request failed because of a broken connection
+func isConnectionError(err error) bool {
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// backoff returns the time to wait before the next attempt. Retry-After sent by the server
+// takes precedence over exponential backoff, both are capped to maxWait.
+func backoff(attempt int, maxWait time.Duration, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if wait > maxWait {
+				return maxWait
+			}
+			return wait
+		}
+	}
+
+	wait := retryBaseWait << uint(attempt)
+	if wait <= 0 || wait > maxWait {
+		wait = maxWait
+	}
+	// full jitter avoids all clients retrying in lockstep after an outage
+	//nolint:gosec
+	return time.Duration(rand.Int63n(int64(wait)) + 1)
+}
+
+// parseRetryAfter parses Retry-After header given either in seconds or as HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
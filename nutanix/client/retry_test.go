@@ -0,0 +1,179 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func setupWithRetries(maxRetries int) (*http.ServeMux, *Client, func()) {
+	mux, client, server := setup()
+	client.Credentials.MaxRetries = maxRetries
+	client.Credentials.RetryMaxWait = 1
+
+	return mux, client, server.Close
+}
+
+func TestDo_retryTransientStatus(t *testing.T) {
+	mux, client, teardown := setupWithRetries(3)
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"A":"a"}`)
+	})
+
+	req, _ := client.NewRequest(context.TODO(), http.MethodGet, "/", nil)
+	body := make(map[string]string)
+
+	if err := client.Do(context.Background(), req, &body); err != nil {
+		t.Fatalf("Do(): %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("server received %d calls, expected 3", calls)
+	}
+	if body["A"] != "a" {
+		t.Errorf("Response body = %v, expected %v", body, map[string]string{"A": "a"})
+	}
+}
+
+func TestDo_retryReplaysBody(t *testing.T) {
+	mux, client, teardown := setupWithRetries(1)
+	defer teardown()
+
+	var bodies []string
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		b := make([]byte, r.ContentLength)
+		_, _ = r.Body.Read(b)
+		bodies = append(bodies, string(b))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	})
+
+	req, _ := client.NewRequest(context.TODO(), http.MethodPost, "/", map[string]string{"name": "bar"})
+	if err := client.Do(context.Background(), req, nil); err != nil {
+		t.Fatalf("Do(): %v", err)
+	}
+	if len(bodies) != 2 || bodies[0] != bodies[1] {
+		t.Errorf("request bodies = %v, expected the same body to be sent twice", bodies)
+	}
+}
+
+func TestDo_noRetryNonIdempotent(t *testing.T) {
+	mux, client, teardown := setupWithRetries(3)
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadGateway)
+		fmt.Fprint(w, `{"message": "bad gateway"}`)
+	})
+
+	req, _ := client.NewRequest(context.TODO(), http.MethodPost, "/vms", nil)
+	if err := client.Do(context.Background(), req, nil); err == nil {
+		t.Error("Expected HTTP 502 error.")
+	}
+	if calls != 1 {
+		t.Errorf("server received %d calls, expected 1", calls)
+	}
+}
+
+func TestDo_retriesExhausted(t *testing.T) {
+	mux, client, teardown := setupWithRetries(2)
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprint(w, `{"message": "service unavailable"}`)
+	})
+
+	req, _ := client.NewRequest(context.TODO(), http.MethodGet, "/", nil)
+	if err := client.Do(context.Background(), req, nil); err == nil {
+		t.Error("Expected HTTP 503 error.")
+	}
+	if calls != 3 {
+		t.Errorf("server received %d calls, expected 3", calls)
+	}
+}
+
+func TestDo_retryCancelledContext(t *testing.T) {
+	mux, client, teardown := setupWithRetries(5)
+	defer teardown()
+	client.Credentials.RetryMaxWait = 60
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	req, _ := client.NewRequest(ctx, http.MethodGet, "/", nil)
+	start := time.Now()
+	err := client.Do(ctx, req, nil)
+	if err != context.DeadlineExceeded {
+		t.Errorf("Do() error = %v, expected %v", err, context.DeadlineExceeded)
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Errorf("Do() did not return after context was cancelled")
+	}
+}
+
+func TestIsIdempotent(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		want   bool
+	}{
+		{http.MethodGet, "/api/nutanix/v3/vms/uuid", true},
+		{http.MethodPut, "/api/nutanix/v3/vms/uuid", true},
+		{http.MethodDelete, "/api/nutanix/v3/vms/uuid", true},
+		{http.MethodPost, "/api/nutanix/v3/vms/list", true},
+		{http.MethodPost, "/api/nutanix/v3/vms", false},
+		{http.MethodPatch, "/api/nutanix/v3/vms/uuid", false},
+	}
+
+	for _, tt := range tests {
+		req := &http.Request{Method: tt.method, URL: &url.URL{Path: tt.path}}
+		if got := isIdempotent(req); got != tt.want {
+			t.Errorf("isIdempotent(%s %s) = %v, expected %v", tt.method, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	maxWait := 10 * time.Second
+
+	for attempt := 0; attempt < 10; attempt++ {
+		if wait := backoff(attempt, maxWait, nil); wait <= 0 || wait > maxWait {
+			t.Errorf("backoff(%d) = %s, expected value in (0, %s]", attempt, wait, maxWait)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"5"}}}
+	if wait := backoff(0, maxWait, resp); wait != 5*time.Second {
+		t.Errorf("backoff() with Retry-After = %s, expected %s", wait, 5*time.Second)
+	}
+
+	resp.Header.Set("Retry-After", "120")
+	if wait := backoff(0, maxWait, resp); wait != maxWait {
+		t.Errorf("backoff() with Retry-After = %s, expected %s", wait, maxWait)
+	}
+}
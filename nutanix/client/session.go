@@ -0,0 +1,200 @@
+package client
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Session holds the session cookies of a client. Requests sent with credentials are
+// switched to the session cookies once a session is established. When the session expires,
+// a single request authenticates again with the credentials, the requests sent meanwhile wait
+// for the new session and are replayed with it. A session is safe for concurrent use.
+type Session struct {
+	mu      sync.Mutex
+	cookies []*http.Cookie
+
+	// renewed is closed once the request renewing an expired session completes, nil if the
+	// session is not being renewed
+	renewed chan struct{}
+}
+
+// NewSession returns an empty session, established by the first authenticated response
+func NewSession() *Session {
+	return &Session{}
+}
+
+// Cookie returns the value of the Cookie header for the current session, empty if there is
+// no session established. If the session is being renewed, it waits for the new session.
+func (s *Session) Cookie(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	renewed := s.renewed
+	s.mu.Unlock()
+
+	if renewed != nil {
+		select {
+		case <-renewed:
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return cookieHeader(s.cookies), nil
+}
+
+// update stores the cookies set by resp, replacing the ones with the same name
+func (s *Session) update(resp *http.Response) {
+	if resp.StatusCode >= http.StatusBadRequest {
+		return
+	}
+	received := resp.Cookies()
+	if len(received) == 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cookies := make([]*http.Cookie, 0, len(s.cookies)+len(received))
+	for _, c := range s.cookies {
+		if !hasCookie(received, c.Name) {
+			cookies = append(cookies, c)
+		}
+	}
+	for _, c := range received {
+		if c.Value == "" || c.MaxAge < 0 || (!c.Expires.IsZero() && c.Expires.Before(time.Now())) {
+			continue
+		}
+		cookies = append(cookies, c)
+	}
+	s.cookies = cookies
+}
+
+// expire drops the session if its Cookie header is still cookie. The caller becomes responsible for
+// renewing the session and must call the returned function once done. If the session was already
+// renewed by a concurrent request, its Cookie header is returned instead.
+func (s *Session) expire(ctx context.Context, cookie string) (string, func(), error) {
+	s.mu.Lock()
+	if current := cookieHeader(s.cookies); current != cookie || s.renewed != nil {
+		s.mu.Unlock()
+		current, err := s.Cookie(ctx)
+		return current, func() {}, err
+	}
+
+	renewed := make(chan struct{})
+	s.cookies = nil
+	s.renewed = renewed
+	s.mu.Unlock()
+
+	return "", func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.renewed = nil
+		close(renewed)
+	}, nil
+}
+
+// Transport wraps next so that requests sent through it use the session
+func (s *Session) Transport(next http.RoundTripper) http.RoundTripper {
+	if s == nil {
+		return next
+	}
+	return &sessionTransport{session: s, next: next}
+}
+
+type sessionTransport struct {
+	session *Session
+	next    http.RoundTripper
+}
+
+// RoundTrip sends requests authenticated with credentials using the session cookies instead. If the
+// session has expired, the request is sent again with its credentials to open a new session.
+// Requests without Authorization header (API key) or with their own Cookie header are sent as is.
+func (t *sessionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("Authorization") == "" || req.Header.Get("Cookie") != "" {
+		return t.next.RoundTrip(req)
+	}
+
+	cookie, err := t.session.Cookie(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(withSessionCookie(req, cookie))
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized && cookie != "" && canReplay(req) {
+		// drain the body so the underlying connection can be reused
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		current, done, err := t.session.expire(req.Context(), cookie)
+		if err != nil {
+			return nil, err
+		}
+		defer done()
+
+		if current == "" {
+			log.Printf("[DEBUG] %s %s: session expired, authenticating again", req.Method, req.URL.Path)
+		}
+
+		replay := withSessionCookie(req.Clone(req.Context()), current)
+		if req.Body != nil && req.Body != http.NoBody {
+			if replay.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err = t.next.RoundTrip(replay)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	t.session.update(resp)
+	return resp, nil
+}
+
+// withSessionCookie returns req authenticated with cookie, or req itself if cookie is empty
+func withSessionCookie(req *http.Request, cookie string) *http.Request {
+	if cookie == "" {
+		return req
+	}
+
+	r := req.Clone(req.Context())
+	r.Header.Del("Authorization")
+	r.Header.Set("Cookie", cookie)
+	return r
+}
+
+// canReplay reports whether the body of req can be sent once more
+func canReplay(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+func cookieHeader(cookies []*http.Cookie) string {
+	values := make([]string, 0, len(cookies))
+	for _, c := range cookies {
+		values = append(values, c.Name+"="+c.Value)
+	}
+	return strings.Join(values, "; ")
+}
+
+func hasCookie(cookies []*http.Cookie, name string) bool {
+	for _, c := range cookies {
+		if c.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,154 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+func setupWithSession() (*http.ServeMux, *Client, func()) {
+	mux, client, server := setup()
+	client.client.Transport = NewSession().Transport(client.client.Transport)
+
+	return mux, client, server.Close
+}
+
+// sessionHandler accepts requests with basic auth or with the current session cookie, and opens
+// a new session for every request authenticated with basic auth
+func sessionHandler(t *testing.T, logins *int, sessionID *string, mu *sync.Mutex) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if _, _, ok := r.BasicAuth(); ok {
+			if r.Header.Get("Cookie") != "" {
+				t.Errorf("request sent with both basic auth and cookie")
+			}
+			*logins++
+			*sessionID = fmt.Sprintf("session-%d", *logins)
+			http.SetCookie(w, &http.Cookie{Name: "NTNX_IGW_SESSION", Value: *sessionID})
+		} else if c, err := r.Cookie("NTNX_IGW_SESSION"); err != nil || c.Value != *sessionID {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"message": "session expired"}`)
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		fmt.Fprintf(w, `{"body": %q}`, string(body))
+	}
+}
+
+func TestSession_reuseCookie(t *testing.T) {
+	mux, client, teardown := setupWithSession()
+	defer teardown()
+
+	var mu sync.Mutex
+	logins, sessionID := 0, ""
+	mux.HandleFunc("/", sessionHandler(t, &logins, &sessionID, &mu))
+
+	for i := 0; i < 3; i++ {
+		req, _ := client.NewRequest(context.TODO(), http.MethodGet, "/", nil)
+		if err := client.Do(context.Background(), req, nil); err != nil {
+			t.Fatalf("Do(): %v", err)
+		}
+	}
+	if logins != 1 {
+		t.Errorf("server received %d logins, expected 1", logins)
+	}
+}
+
+func TestSession_expired(t *testing.T) {
+	mux, client, teardown := setupWithSession()
+	defer teardown()
+
+	var mu sync.Mutex
+	logins, sessionID := 0, ""
+	mux.HandleFunc("/", sessionHandler(t, &logins, &sessionID, &mu))
+
+	req, _ := client.NewRequest(context.TODO(), http.MethodGet, "/", nil)
+	if err := client.Do(context.Background(), req, nil); err != nil {
+		t.Fatalf("Do(): %v", err)
+	}
+
+	// expire the session on server side
+	mu.Lock()
+	sessionID = "expired"
+	mu.Unlock()
+
+	req, _ = client.NewRequest(context.TODO(), http.MethodPost, "/vms", map[string]string{"name": "bar"})
+	body := make(map[string]string)
+	if err := client.Do(context.Background(), req, &body); err != nil {
+		t.Fatalf("Do(): %v", err)
+	}
+	if body["body"] != "{\"name\":\"bar\"}\n" {
+		t.Errorf("replayed request body = %q, expected the original body", body["body"])
+	}
+	if logins != 2 {
+		t.Errorf("server received %d logins, expected 2", logins)
+	}
+}
+
+func TestSession_concurrentExpiry(t *testing.T) {
+	mux, client, teardown := setupWithSession()
+	defer teardown()
+
+	var mu sync.Mutex
+	logins, sessionID := 0, ""
+	mux.HandleFunc("/", sessionHandler(t, &logins, &sessionID, &mu))
+
+	req, _ := client.NewRequest(context.TODO(), http.MethodGet, "/", nil)
+	if err := client.Do(context.Background(), req, nil); err != nil {
+		t.Fatalf("Do(): %v", err)
+	}
+
+	mu.Lock()
+	sessionID = "expired"
+	mu.Unlock()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := client.NewRequest(context.TODO(), http.MethodGet, "/", nil)
+			errs <- client.Do(context.Background(), req, nil)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("Do(): %v", err)
+		}
+	}
+	// a single request must authenticate again for all the requests rejected with the expired session
+	if logins != 2 {
+		t.Errorf("server received %d logins, expected 2", logins)
+	}
+}
+
+func TestSession_apiKey(t *testing.T) {
+	mux, client, teardown := setupWithSession()
+	defer teardown()
+	client.Credentials.APIKey = "test-api-key"
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Cookie") != "" {
+			t.Errorf("request with API key sent with cookie %s", r.Header.Get("Cookie"))
+		}
+		http.SetCookie(w, &http.Cookie{Name: "NTNX_IGW_SESSION", Value: "session"})
+		fmt.Fprint(w, `{}`)
+	})
+
+	for i := 0; i < 2; i++ {
+		req, _ := client.NewRequest(context.TODO(), http.MethodGet, "/", nil)
+		if err := client.Do(context.Background(), req, nil); err != nil {
+			t.Fatalf("Do(): %v", err)
+		}
+	}
+}
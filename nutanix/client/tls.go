@@ -0,0 +1,77 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"time"
+)
+
+// NewTLSConfig returns the TLS configuration for connecting with given credentials.
+// Custom CA certificates are added on top of the system trust store and client
+// certificate/key pair is used for mutual TLS if given.
+func NewTLSConfig(credentials *Credentials) (*tls.Config, error) {
+	//nolint:gosec
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: credentials.Insecure, // ignore expired SSL certificates
+	}
+
+	if credentials.CACertificate != "" || credentials.CAFile != "" {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil || rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+
+		if credentials.CACertificate != "" {
+			if ok := rootCAs.AppendCertsFromPEM([]byte(credentials.CACertificate)); !ok {
+				return nil, fmt.Errorf("error parsing ca_certificate: no valid PEM encoded certificate found")
+			}
+		}
+
+		if credentials.CAFile != "" {
+			caBundle, err := os.ReadFile(credentials.CAFile)
+			if err != nil {
+				return nil, fmt.Errorf("error reading ca_file %s: %s", credentials.CAFile, err)
+			}
+			if ok := rootCAs.AppendCertsFromPEM(caBundle); !ok {
+				return nil, fmt.Errorf("error parsing ca_file %s: no valid PEM encoded certificate found", credentials.CAFile)
+			}
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if (credentials.ClientCertificate != "") != (credentials.ClientKey != "") {
+		return nil, fmt.Errorf("client_certificate and client_key must be provided together")
+	}
+
+	if credentials.ClientCertificate != "" {
+		cert, err := tls.X509KeyPair([]byte(credentials.ClientCertificate), []byte(credentials.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("error loading client certificate: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// newTransport returns http transport configured with TLS settings and endpoints of given credentials
+func newTransport(credentials *Credentials) (*http.Transport, error) {
+	tlsConfig, err := NewTLSConfig(credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+
+	return &http.Transport{
+		DialContext:     credentials.Endpoints.DialContext(dialer.DialContext),
+		TLSClientConfig: tlsConfig,
+	}, nil
+}
@@ -0,0 +1,120 @@
+package client
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// setupTLS creates a test client against a TLS server, credentials are built from the server in use
+func setupTLS(t *testing.T, credentials func(*httptest.Server) *Credentials) (*Client, *httptest.Server) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	}))
+
+	client, err := NewClient(credentials(server), testUserAgent, testAbsolutePath, false)
+	if err != nil {
+		server.Close()
+		t.Fatalf("NewClient() errored out with error : %v", err)
+	}
+	client.BaseURL, _ = url.Parse(server.URL)
+
+	return client, server
+}
+
+func serverCertificatePEM(server *httptest.Server) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+}
+
+func TestNewTLSConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		credentials *Credentials
+		wantErr     bool
+	}{
+		{
+			name:        "insecure",
+			credentials: &Credentials{Insecure: true},
+		},
+		{
+			name:        "invalid ca certificate",
+			credentials: &Credentials{CACertificate: "not a certificate"},
+			wantErr:     true,
+		},
+		{
+			name:        "missing ca file",
+			credentials: &Credentials{CAFile: filepath.Join(t.TempDir(), "missing.pem")},
+			wantErr:     true,
+		},
+		{
+			name:        "client certificate without key",
+			credentials: &Credentials{ClientCertificate: "cert"},
+			wantErr:     true,
+		},
+		{
+			name:        "invalid client certificate",
+			credentials: &Credentials{ClientCertificate: "cert", ClientKey: "key"},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			tlsConfig, err := NewTLSConfig(tt.credentials)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewTLSConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && tlsConfig.InsecureSkipVerify != tt.credentials.Insecure {
+				t.Errorf("NewTLSConfig() InsecureSkipVerify = %v, expected %v", tlsConfig.InsecureSkipVerify, tt.credentials.Insecure)
+			}
+		})
+	}
+}
+
+func TestDo_verifiesServerCertificate(t *testing.T) {
+	client, server := setupTLS(t, func(*httptest.Server) *Credentials {
+		return &Credentials{Username: "username", Password: "password"}
+	})
+	defer server.Close()
+
+	req, _ := client.NewRequest(context.TODO(), http.MethodGet, "/", nil)
+	if err := client.Do(context.Background(), req, nil); err == nil {
+		t.Error("Expected certificate verification error.")
+	}
+}
+
+func TestDo_withCACertificate(t *testing.T) {
+	client, server := setupTLS(t, func(server *httptest.Server) *Credentials {
+		return &Credentials{Username: "username", Password: "password", CACertificate: serverCertificatePEM(server)}
+	})
+	defer server.Close()
+
+	req, _ := client.NewRequest(context.TODO(), http.MethodGet, "/", nil)
+	if err := client.Do(context.Background(), req, nil); err != nil {
+		t.Errorf("Do(): %v", err)
+	}
+}
+
+func TestDo_withCAFile(t *testing.T) {
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+
+	client, server := setupTLS(t, func(server *httptest.Server) *Credentials {
+		if err := os.WriteFile(caFile, []byte(serverCertificatePEM(server)), 0600); err != nil {
+			t.Fatalf("error writing ca file: %v", err)
+		}
+		return &Credentials{Username: "username", Password: "password", CAFile: caFile}
+	})
+	defer server.Close()
+
+	req, _ := client.NewRequest(context.TODO(), http.MethodGet, "/", nil)
+	if err := client.Do(context.Background(), req, nil); err != nil {
+		t.Errorf("Do(): %v", err)
+	}
+}
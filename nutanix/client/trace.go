@@ -0,0 +1,276 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+)
+
+const (
+	// TraceFileEnv is the environment variable naming the file requests are traced to
+	TraceFileEnv = "NUTANIX_TRACE_FILE"
+
+	// maxTracedBody is the size above which request and response bodies are not traced
+	maxTracedBody = 1 << 20
+
+	redacted = "REDACTED"
+)
+
+// redactedHeaders are the headers carrying credentials, their values are never traced
+var redactedHeaders = []string{"Authorization", "X-Ntnx-Api-Key", "Cookie", "Set-Cookie", "Proxy-Authorization"}
+
+// redactedFields are the fragments of JSON field names holding secrets or guest customization payloads,
+// matched against the lower case field name without underscores and dashes
+var redactedFields = []string{
+	"password", "secret", "token", "apikey", "privatekey", "passphrase", "clientkey",
+	"userdata", "unattendxml", "cloudinitscript", "sysprepscript", "customkeyvalues",
+}
+
+// Tracer writes one JSON line per request sent through its transports, with credentials and sensitive
+// body fields redacted. A tracer is safe for concurrent use and can be shared by all the clients.
+type Tracer struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// traceEntry is the JSON line traced for a request
+type traceEntry struct {
+	Time            string            `json:"time"`
+	Method          string            `json:"method"`
+	Host            string            `json:"host"`
+	Path            string            `json:"path"`
+	Query           string            `json:"query,omitempty"`
+	Status          int               `json:"status,omitempty"`
+	LatencyMs       float64           `json:"latency_ms"`
+	TaskUUID        string            `json:"task_uuid,omitempty"`
+	RequestHeaders  map[string]string `json:"request_headers,omitempty"`
+	RequestBody     interface{}       `json:"request_body,omitempty"`
+	ResponseHeaders map[string]string `json:"response_headers,omitempty"`
+	ResponseBody    interface{}       `json:"response_body,omitempty"`
+	Error           string            `json:"error,omitempty"`
+}
+
+// NewTracer returns a tracer writing to w
+func NewTracer(w io.Writer) *Tracer {
+	return &Tracer{w: w}
+}
+
+// NewTracerFromEnv returns a tracer appending to the file named by NUTANIX_TRACE_FILE if set, or writing
+// to the provider log if the log level is debug or higher. nil is returned if tracing is disabled.
+func NewTracerFromEnv() (*Tracer, error) {
+	if path := os.Getenv(TraceFileEnv); path != "" {
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("error opening trace file %s: %s", path, err)
+		}
+		return NewTracer(f), nil
+	}
+
+	if logging.IsDebugOrHigher() {
+		return NewTracer(logWriter{}), nil
+	}
+	return nil, nil
+}
+
+// logWriter writes the traced lines to the provider log
+type logWriter struct{}
+
+func (logWriter) Write(p []byte) (int, error) {
+	log.Printf("[DEBUG] nutanix request trace: %s", bytes.TrimSpace(p))
+	return len(p), nil
+}
+
+// Transport wraps next so that requests sent through it are traced
+func (t *Tracer) Transport(next http.RoundTripper) http.RoundTripper {
+	if t == nil {
+		return next
+	}
+	return &traceTransport{tracer: t, next: next}
+}
+
+type traceTransport struct {
+	tracer *Tracer
+	next   http.RoundTripper
+}
+
+// RoundTrip sends req and traces it along with its response. The bodies are read from copies, the
+// request and the response are passed on unchanged.
+func (t *traceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	entry := traceEntry{
+		Time:           time.Now().UTC().Format(time.RFC3339Nano),
+		Method:         req.Method,
+		Host:           req.URL.Host,
+		Path:           req.URL.Path,
+		Query:          req.URL.RawQuery,
+		RequestHeaders: redactHeaders(req.Header),
+	}
+	// only JSON bodies are traced, other bodies such as uploaded files are not read at all
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody != nil && req.ContentLength <= maxTracedBody &&
+		strings.Contains(req.Header.Get("Content-Type"), "json") {
+		if body, err := req.GetBody(); err == nil {
+			data, _ := io.ReadAll(body)
+			body.Close()
+			entry.RequestBody = redactBody(req.Header.Get("Content-Type"), data)
+		}
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	entry.LatencyMs = float64(time.Since(start).Microseconds()) / 1000
+
+	if err != nil {
+		entry.Error = err.Error()
+		t.tracer.write(entry)
+		return resp, err
+	}
+
+	entry.Status = resp.StatusCode
+	entry.ResponseHeaders = redactHeaders(resp.Header)
+	if resp.Body != nil && resp.ContentLength <= maxTracedBody {
+		data, rerr := io.ReadAll(io.LimitReader(resp.Body, maxTracedBody+1))
+		// the part read is given back to the caller, followed by the rest of the body if any
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(data), resp.Body), resp.Body}
+
+		if rerr == nil && len(data) <= maxTracedBody {
+			entry.ResponseBody = redactBody(resp.Header.Get("Content-Type"), data)
+			entry.TaskUUID = taskUUID(data)
+		}
+	}
+
+	t.tracer.write(entry)
+	return resp, nil
+}
+
+func (t *Tracer) write(entry traceEntry) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		log.Printf("[WARN] could not trace %s %s: %s", entry.Method, entry.Path, err)
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, err := t.w.Write(append(line, '\n')); err != nil {
+		log.Printf("[WARN] could not trace %s %s: %s", entry.Method, entry.Path, err)
+	}
+}
+
+// redactHeaders returns the headers with the values of credential headers replaced
+func redactHeaders(header http.Header) map[string]string {
+	if len(header) == 0 {
+		return nil
+	}
+
+	headers := make(map[string]string, len(header))
+	for name, values := range header {
+		if isRedactedHeader(name) {
+			headers[name] = redacted
+			continue
+		}
+		headers[name] = strings.Join(values, ", ")
+	}
+	return headers
+}
+
+func isRedactedHeader(name string) bool {
+	for _, h := range redactedHeaders {
+		if strings.EqualFold(h, name) {
+			return true
+		}
+	}
+	// custom headers, e.g. Cf-Access-Client-Secret
+	return isRedactedField(name)
+}
+
+func isRedactedField(name string) bool {
+	name = strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(name))
+	for _, field := range redactedFields {
+		if strings.Contains(name, field) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactBody returns the JSON body with the sensitive fields replaced. Other bodies are not traced,
+// only their size.
+func redactBody(contentType string, data []byte) interface{} {
+	if len(data) == 0 {
+		return nil
+	}
+
+	var body interface{}
+	if !strings.Contains(contentType, "json") || json.Unmarshal(data, &body) != nil {
+		return fmt.Sprintf("%d bytes", len(data))
+	}
+	return redactValue(body)
+}
+
+func redactValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, field := range value {
+			if isRedactedField(k) && field != nil {
+				value[k] = redacted
+			} else {
+				value[k] = redactValue(field)
+			}
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = redactValue(item)
+		}
+	}
+	return v
+}
+
+// taskUUID returns the task started by a request, as referenced by v3 responses
+// (status.execution_context.task_uuid) or by v4 task references (data.extId)
+func taskUUID(data []byte) string {
+	var body struct {
+		TaskUUID string `json:"task_uuid"`
+		Status   struct {
+			ExecutionContext struct {
+				TaskUUID interface{} `json:"task_uuid"`
+			} `json:"execution_context"`
+		} `json:"status"`
+		Data struct {
+			ObjectType string `json:"$objectType"`
+			ExtID      string `json:"extId"`
+		} `json:"data"`
+	}
+	// fields of other types (e.g. the data list of v4 list responses) are left empty
+	_ = json.Unmarshal(data, &body)
+
+	switch {
+	case body.TaskUUID != "":
+		return body.TaskUUID
+	case body.Data.ExtID != "" && strings.HasSuffix(body.Data.ObjectType, "TaskReference"):
+		return body.Data.ExtID
+	}
+	// the task uuid of some v3 responses is a list
+	switch uuid := body.Status.ExecutionContext.TaskUUID.(type) {
+	case string:
+		return uuid
+	case []interface{}:
+		if len(uuid) > 0 {
+			if s, ok := uuid[0].(string); ok {
+				return s
+			}
+		}
+	}
+	return ""
+}
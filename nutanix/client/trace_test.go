@@ -0,0 +1,137 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestTracer_transport(t *testing.T) {
+	mux, client, server := setup()
+	defer server.Close()
+
+	var trace bytes.Buffer
+	client.client.Transport = NewTracer(&trace).Transport(client.client.Transport)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		http.SetCookie(w, &http.Cookie{Name: "NTNX_IGW_SESSION", Value: "session-secret"})
+		fmt.Fprint(w, `{"status": {"execution_context": {"task_uuid": "task-1"}}}`)
+	})
+
+	body := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"name": "vm",
+			"guest_customization": map[string]interface{}{
+				"cloud_init": map[string]interface{}{"user_data": "cloud-init-secret"},
+			},
+			"admin_password": "password-secret",
+		},
+	}
+	req, _ := client.NewRequest(context.TODO(), http.MethodPost, "/vms", body)
+
+	resp := map[string]interface{}{}
+	if err := client.Do(context.Background(), req, &resp); err != nil {
+		t.Fatalf("Do(): %v", err)
+	}
+	if resp["status"] == nil {
+		t.Errorf("response body = %v, expected the response of the server", resp)
+	}
+
+	line := trace.String()
+	if strings.Count(line, "\n") != 1 {
+		t.Fatalf("traced %q, expected a single line", line)
+	}
+	for _, secret := range []string{"cloud-init-secret", "password-secret", "dXNlcm5hbWU6cGFzc3dvcmQ=", "session-secret"} {
+		if strings.Contains(line, secret) {
+			t.Errorf("trace %s discloses %s", line, secret)
+		}
+	}
+
+	entry := traceEntry{}
+	if err := json.Unmarshal([]byte(line), &entry); err != nil {
+		t.Fatalf("trace %q is not JSON: %v", line, err)
+	}
+	if entry.Method != http.MethodPost || !strings.HasSuffix(entry.Path, "/vms") || entry.Status != http.StatusOK || entry.TaskUUID != "task-1" {
+		t.Errorf("trace = %+v, expected POST /vms 200 with task task-1", entry)
+	}
+	spec := entry.RequestBody.(map[string]interface{})["spec"].(map[string]interface{})
+	if spec["name"] != "vm" {
+		t.Errorf("traced request body %v, expected the vm name", entry.RequestBody)
+	}
+}
+
+func TestTracer_transportUpload(t *testing.T) {
+	mux, client, server := setup()
+	defer server.Close()
+
+	var trace bytes.Buffer
+	client.client.Transport = NewTracer(&trace).Transport(client.client.Transport)
+
+	payload := strings.Repeat("image data ", 1000)
+	var received []byte
+	mux.HandleFunc("/api/nutanix/v3/images/uuid/file", func(w http.ResponseWriter, r *http.Request) {
+		received, _ = io.ReadAll(r.Body)
+	})
+
+	file, err := os.CreateTemp("", "test-upload-*.img")
+	if err != nil {
+		t.Fatalf("Failed to create temp file: %v", err)
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+	file.WriteString(payload)
+	file.Seek(0, io.SeekStart)
+
+	req, err := client.NewUploadRequest(context.TODO(), http.MethodPut, "/images/uuid/file", file)
+	if err != nil {
+		t.Fatalf("NewUploadRequest() error: %v", err)
+	}
+
+	// a copy of the body must not consume the request body
+	if body, err := req.GetBody(); err != nil {
+		t.Fatalf("GetBody() error: %v", err)
+	} else if copied, _ := io.ReadAll(body); string(copied) != payload {
+		t.Errorf("GetBody() read %d bytes, expected %d", len(copied), len(payload))
+	}
+
+	if err := client.Do(context.Background(), req, nil); err != nil {
+		t.Fatalf("Do(): %v", err)
+	}
+	if string(received) != payload {
+		t.Errorf("server received %d bytes, expected %d", len(received), len(payload))
+	}
+	if strings.Count(trace.String(), "\n") != 1 {
+		t.Errorf("traced %q, expected a single line", trace.String())
+	}
+}
+
+func TestTaskUUID(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"v3 execution context", `{"status": {"execution_context": {"task_uuid": "a"}}}`, "a"},
+		{"v3 execution context list", `{"status": {"execution_context": {"task_uuid": ["b"]}}}`, "b"},
+		{"v3 task", `{"task_uuid": "c"}`, "c"},
+		{"v4 task reference", `{"data": {"$objectType": "prism.v4.config.TaskReference", "extId": "d"}}`, "d"},
+		{"v4 entity", `{"data": {"$objectType": "vmm.v4.ahv.config.Vm", "extId": "e"}}`, ""},
+		{"v4 list", `{"data": [{"extId": "f"}], "metadata": {"totalAvailableResults": 1}}`, ""},
+		{"not json", `<html></html>`, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := taskUUID([]byte(tt.body)); got != tt.want {
+				t.Errorf("taskUUID() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -262,22 +262,21 @@ func isConfigListEmptyOrMissingImpl(d *schema.ResourceData, path string, treatUn
 	return li == 0
 }
 
+// TaskStateRefreshPrismTaskGroupFunc returns a StateRefreshFunc polling the v4 task taskUUID.
+//
+// Deprecated: use WaitForTask, which reports progress and stops polling when ctx is canceled.
 func TaskStateRefreshPrismTaskGroupFunc(ctx context.Context, client *prism.Client, taskUUID string) resource.StateRefreshFunc {
+	getTask := prismTaskGetter(client)
 	return func() (interface{}, string, error) {
-		vresp, err := client.TaskRefAPI.GetTaskById(utils.StringPtr(taskUUID), nil)
+		v, err := getTask(taskUUID)
 		if err != nil {
 			return "", "", fmt.Errorf("error while polling prism task: %v", err)
 		}
 
-		// get the group results
-
-		v := vresp.Data.GetValue().(prismConfig.Task)
-
 		if getTaskStatus(v.Status) == "CANCELED" || getTaskStatus(v.Status) == "FAILED" {
-			return v, getTaskStatus(v.Status),
-				fmt.Errorf("error_detail: %s, progress_message: %d", utils.StringValue(v.ErrorMessages[0].Message), utils.IntValue(v.ProgressPercentage))
+			return *v, getTaskStatus(v.Status), newTaskError(v, getTask)
 		}
-		return v, getTaskStatus(v.Status), nil
+		return *v, getTaskStatus(v.Status), nil
 	}
 }
 
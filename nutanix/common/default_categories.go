@@ -0,0 +1,148 @@
+package common
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+)
+
+// DefaultCategoriesDiff returns a CustomizeDiffFunc merging the provider default categories into
+// the categories attribute key of a v3 resource, a set of name/value pairs.
+//
+// Categories set on the resource take precedence over the default value of the same category.
+// If the resource does not set categories, the default values are merged into the categories
+// of the entity instead. The merged categories are planned, so that they do not show as drift.
+func DefaultCategoriesDiff(key string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		client, ok := meta.(*conns.Client)
+		if !ok || len(client.DefaultCategories) == 0 {
+			return nil
+		}
+
+		configured, known := rawConfigAttrState(d, key)
+		if !known {
+			log.Printf("[DEBUG] %s is not known yet, default categories are not merged", key)
+			return nil
+		}
+
+		current := d.Get(key).(*schema.Set).List()
+		merged := MergeDefaultCategories(current, client.DefaultCategories, configured)
+		if schema.NewSet(d.Get(key).(*schema.Set).F, merged).Equal(d.Get(key)) {
+			return nil
+		}
+		return d.SetNew(key, merged)
+	}
+}
+
+// DefaultCategoryExtIDsDiff returns a CustomizeDiffFunc adding the provider default categories to the
+// categories attribute key of a v4 resource. The attribute is either a list of category ext ids, if
+// extIDKey is empty, or a set of blocks referencing a category by their extIDKey attribute.
+func DefaultCategoryExtIDsDiff(key, extIDKey string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		client, ok := meta.(*conns.Client)
+		if !ok || len(client.DefaultCategories) == 0 {
+			return nil
+		}
+
+		if _, known := rawConfigAttrState(d, key); !known {
+			log.Printf("[DEBUG] %s is not known yet, default categories are not merged", key)
+			return nil
+		}
+
+		defaults, err := client.DefaultCategoryExtIDs()
+		if err != nil {
+			return err
+		}
+
+		if extIDKey == "" {
+			current := ExpandListOfString(d.Get(key).([]interface{}))
+			merged := MergeDefaultCategoryExtIDs(current, defaults)
+			if len(merged) == len(current) {
+				return nil
+			}
+			return d.SetNew(key, merged)
+		}
+
+		current := make([]string, 0)
+		for _, v := range d.Get(key).(*schema.Set).List() {
+			if m, ok := v.(map[string]interface{}); ok {
+				current = append(current, m[extIDKey].(string))
+			}
+		}
+		merged := MergeDefaultCategoryExtIDs(current, defaults)
+		if len(merged) == len(current) {
+			return nil
+		}
+
+		refs := make([]interface{}, len(merged))
+		for i, extID := range merged {
+			refs[i] = map[string]interface{}{extIDKey: extID}
+		}
+		return d.SetNew(key, refs)
+	}
+}
+
+// MergeDefaultCategories merges the default categories into categories, a list of name/value pairs.
+// If configured is true, categories come from the configuration and keep their value, otherwise
+// the default values replace the ones of the entity.
+func MergeDefaultCategories(categories []interface{}, defaults map[string]string, configured bool) []interface{} {
+	values := make(map[string]string, len(categories)+len(defaults))
+	for _, v := range categories {
+		category := v.(map[string]interface{})
+		values[category["name"].(string)] = category["value"].(string)
+	}
+	for name, value := range defaults {
+		if _, ok := values[name]; ok && configured {
+			continue
+		}
+		values[name] = value
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	merged := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		merged = append(merged, map[string]interface{}{
+			"name":  name,
+			"value": values[name],
+		})
+	}
+	return merged
+}
+
+// MergeDefaultCategoryExtIDs returns extIDs followed by the default category ext ids missing from it
+func MergeDefaultCategoryExtIDs(extIDs, defaults []string) []string {
+	merged := append(make([]string, 0, len(extIDs)+len(defaults)), extIDs...)
+	for _, extID := range defaults {
+		found := false
+		for _, v := range merged {
+			if v == extID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, extID)
+		}
+	}
+	return merged
+}
+
+// rawConfigAttrState reports whether the top level attribute key is set in the configuration, and
+// whether its value is known at plan time.
+func rawConfigAttrState(d *schema.ResourceDiff, key string) (configured, known bool) {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.Type().IsObjectType() || !rawConfig.Type().HasAttribute(key) {
+		return false, true
+	}
+
+	val := rawConfig.GetAttr(key)
+	return !val.IsNull(), val.IsWhollyKnown()
+}
@@ -0,0 +1,59 @@
+package common
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeDefaultCategories(t *testing.T) {
+	defaults := map[string]string{"CostCenter": "1234", "Owner": "team-a"}
+	categories := []interface{}{
+		map[string]interface{}{"name": "Owner", "value": "team-b"},
+		map[string]interface{}{"name": "Environment", "value": "dev"},
+	}
+
+	tests := []struct {
+		name       string
+		configured bool
+		want       []interface{}
+	}{
+		{
+			name:       "configured categories take precedence",
+			configured: true,
+			want: []interface{}{
+				map[string]interface{}{"name": "CostCenter", "value": "1234"},
+				map[string]interface{}{"name": "Environment", "value": "dev"},
+				map[string]interface{}{"name": "Owner", "value": "team-b"},
+			},
+		},
+		{
+			name:       "defaults replace categories of the entity",
+			configured: false,
+			want: []interface{}{
+				map[string]interface{}{"name": "CostCenter", "value": "1234"},
+				map[string]interface{}{"name": "Environment", "value": "dev"},
+				map[string]interface{}{"name": "Owner", "value": "team-a"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MergeDefaultCategories(categories, defaults, tt.configured); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MergeDefaultCategories() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeDefaultCategoryExtIDs(t *testing.T) {
+	got := MergeDefaultCategoryExtIDs([]string{"b", "a"}, []string{"a", "c"})
+	if want := []string{"b", "a", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeDefaultCategoryExtIDs() = %v, want %v", got, want)
+	}
+
+	got = MergeDefaultCategoryExtIDs(nil, nil)
+	if len(got) != 0 {
+		t.Errorf("MergeDefaultCategoryExtIDs() = %v, want empty", got)
+	}
+}
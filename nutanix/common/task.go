@@ -0,0 +1,196 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	prismConfig "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v4/prism"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+const (
+	// minTaskPollInterval and maxTaskPollInterval bound the interval between two polls of a task
+	minTaskPollInterval = time.Second
+	maxTaskPollInterval = 15 * time.Second
+)
+
+// TaskError is the failure of a v4 task. It reports the error messages of the task, its legacy
+// error message and the errors of its failed subtasks.
+type TaskError struct {
+	TaskUUID  string
+	Operation string
+	Status    string
+	Progress  int
+	Messages  []string
+}
+
+func (e *TaskError) Error() string {
+	operation := ""
+	if e.Operation != "" {
+		operation = fmt.Sprintf(" (%s)", e.Operation)
+	}
+	details := "no error details reported"
+	if len(e.Messages) > 0 {
+		details = strings.Join(e.Messages, "; ")
+	}
+	return fmt.Sprintf("task %s%s %s at %d%%: %s", e.TaskUUID, operation, strings.ToLower(e.Status), e.Progress, details)
+}
+
+// getTaskFunc fetches a v4 task by ext id
+type getTaskFunc func(taskUUID string) (*prismConfig.Task, error)
+
+// WaitForTask waits until the v4 task taskUUID succeeds, and returns it. Polling stops when ctx is
+// canceled (e.g. on interrupt) or after timeout, the task itself keeps running in Prism Central.
+// A failed or canceled task is returned along with a *TaskError.
+//
+// The task is polled more often while it progresses quickly, and less often while its progress
+// stalls. Its progress percentage is logged as it changes.
+func WaitForTask(ctx context.Context, client *prism.Client, taskUUID string, timeout time.Duration) (*prismConfig.Task, error) {
+	return waitForTask(ctx, prismTaskGetter(client), taskUUID, timeout)
+}
+
+func waitForTask(ctx context.Context, getTask getTaskFunc, taskUUID string, timeout time.Duration) (*prismConfig.Task, error) {
+	waitCtx := ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	interval := minTaskPollInterval
+	progress := -1
+	for {
+		task, err := getTask(taskUUID)
+		if err != nil {
+			return nil, fmt.Errorf("error while polling prism task %s: %v", taskUUID, err)
+		}
+
+		status := getTaskStatus(task.Status)
+		current := utils.IntValue(task.ProgressPercentage)
+		if current != progress {
+			log.Printf("[INFO] task %s (%s) %s: %d%%", taskUUID, utils.StringValue(task.Operation), strings.ToLower(status), current)
+		}
+
+		switch status {
+		case "SUCCEEDED":
+			return task, nil
+		case "FAILED", "CANCELED":
+			return task, newTaskError(task, getTask)
+		}
+
+		interval = nextTaskPollInterval(interval, time.Since(start), progress, current)
+		progress = current
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-timer.C:
+		case <-waitCtx.Done():
+			timer.Stop()
+			if ctx.Err() != nil {
+				return task, fmt.Errorf("stopped waiting for task %s at %d%%, it keeps running in Prism Central: %w", taskUUID, current, ctx.Err())
+			}
+			return task, fmt.Errorf("timeout while waiting for task %s at %d%% after %s", taskUUID, current, timeout)
+		}
+	}
+}
+
+// nextTaskPollInterval returns the interval until the next poll of a task. While the task progresses,
+// it is polled about four times over its estimated remaining time. While its progress stalls, the
+// interval grows.
+func nextTaskPollInterval(interval, elapsed time.Duration, previous, current int) time.Duration {
+	if current > previous && current > 0 && current < 100 {
+		remaining := time.Duration(float64(elapsed) * float64(100-current) / float64(current))
+		interval = remaining / 4
+	} else {
+		interval = interval * 3 / 2
+	}
+
+	if interval < minTaskPollInterval {
+		return minTaskPollInterval
+	}
+	if interval > maxTaskPollInterval {
+		return maxTaskPollInterval
+	}
+	return interval
+}
+
+// newTaskError returns the error of the failed task, including the errors of its failed subtasks
+func newTaskError(task *prismConfig.Task, getTask getTaskFunc) *TaskError {
+	taskErr := &TaskError{
+		TaskUUID:  utils.StringValue(task.ExtId),
+		Operation: utils.StringValue(task.Operation),
+		Status:    getTaskStatus(task.Status),
+		Progress:  utils.IntValue(task.ProgressPercentage),
+		Messages:  taskErrorMessages(task),
+	}
+
+	for _, ref := range task.SubTasks {
+		if ref.ExtId == nil {
+			continue
+		}
+		subtask, err := getTask(*ref.ExtId)
+		if err != nil {
+			log.Printf("[WARN] could not fetch subtask %s of task %s: %v", *ref.ExtId, taskErr.TaskUUID, err)
+			continue
+		}
+		if status := getTaskStatus(subtask.Status); status != "FAILED" && status != "CANCELED" {
+			continue
+		}
+
+		messages := taskErrorMessages(subtask)
+		if len(messages) == 0 {
+			messages = []string{strings.ToLower(getTaskStatus(subtask.Status))}
+		}
+		taskErr.Messages = append(taskErr.Messages, fmt.Sprintf("subtask %s (%s): %s",
+			*ref.ExtId, utils.StringValue(subtask.Operation), strings.Join(messages, ", ")))
+	}
+	return taskErr
+}
+
+// taskErrorMessages returns the error messages of a task, prefixed by their error code
+func taskErrorMessages(task *prismConfig.Task) []string {
+	messages := make([]string, 0, len(task.ErrorMessages)+1)
+	for _, m := range task.ErrorMessages {
+		message := utils.StringValue(m.Message)
+		if m.Code != nil {
+			message = fmt.Sprintf("[%s] %s", *m.Code, message)
+		}
+		messages = append(messages, message)
+	}
+
+	if legacy := utils.StringValue(task.LegacyErrorMessage); legacy != "" {
+		duplicate := false
+		for _, m := range task.ErrorMessages {
+			if utils.StringValue(m.Message) == legacy {
+				duplicate = true
+			}
+		}
+		if !duplicate {
+			messages = append(messages, legacy)
+		}
+	}
+	return messages
+}
+
+func prismTaskGetter(client *prism.Client) getTaskFunc {
+	return func(taskUUID string) (*prismConfig.Task, error) {
+		resp, err := client.TaskRefAPI.GetTaskById(utils.StringPtr(taskUUID), nil)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Data == nil {
+			return nil, errors.New("empty task response")
+		}
+		task, ok := resp.Data.GetValue().(prismConfig.Task)
+		if !ok {
+			return nil, fmt.Errorf("unexpected task response type %T", resp.Data.GetValue())
+		}
+		return &task, nil
+	}
+}
@@ -0,0 +1,131 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	prismConfig "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
+	prismError "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/error"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func newTestTask(extID string, status prismConfig.TaskStatus, progress int) *prismConfig.Task {
+	task := prismConfig.NewTask()
+	task.ExtId = utils.StringPtr(extID)
+	task.Operation = utils.StringPtr("kVmCreate")
+	task.Status = status.Ref()
+	task.ProgressPercentage = utils.IntPtr(progress)
+	return task
+}
+
+// fakeTasks returns the tasks of polls in order, the last one being returned once all were polled
+func fakeTasks(tasks map[string][]*prismConfig.Task) (getTaskFunc, map[string]int) {
+	polls := make(map[string]int)
+	return func(taskUUID string) (*prismConfig.Task, error) {
+		states, ok := tasks[taskUUID]
+		if !ok {
+			return nil, errors.New("task not found")
+		}
+		i := polls[taskUUID]
+		polls[taskUUID]++
+		if i >= len(states) {
+			i = len(states) - 1
+		}
+		return states[i], nil
+	}, polls
+}
+
+func TestWaitForTask_succeeded(t *testing.T) {
+	getTask, polls := fakeTasks(map[string][]*prismConfig.Task{
+		"task": {
+			newTestTask("task", prismConfig.TASKSTATUS_QUEUED, 0),
+			newTestTask("task", prismConfig.TASKSTATUS_SUCCEEDED, 100),
+		},
+	})
+
+	task, err := waitForTask(context.Background(), getTask, "task", time.Minute)
+	if err != nil {
+		t.Fatalf("waitForTask(): %v", err)
+	}
+	if utils.IntValue(task.ProgressPercentage) != 100 || polls["task"] != 2 {
+		t.Errorf("waitForTask() = %+v after %d polls, expected the succeeded task after 2 polls", task, polls["task"])
+	}
+}
+
+func TestWaitForTask_failed(t *testing.T) {
+	failed := newTestTask("task", prismConfig.TASKSTATUS_FAILED, 40)
+	failed.ErrorMessages = []prismError.AppMessage{{Code: utils.StringPtr("VMM-30100"), Message: utils.StringPtr("no space left")}}
+	failed.LegacyErrorMessage = utils.StringPtr("kNoSpace")
+	failed.SubTasks = []prismConfig.TaskReferenceInternal{{ExtId: utils.StringPtr("subtask-1")}, {ExtId: utils.StringPtr("subtask-2")}}
+
+	// a task failed without error message must not panic
+	failedSubtask := newTestTask("subtask-2", prismConfig.TASKSTATUS_FAILED, 10)
+
+	getTask, _ := fakeTasks(map[string][]*prismConfig.Task{
+		"task":      {failed},
+		"subtask-1": {newTestTask("subtask-1", prismConfig.TASKSTATUS_SUCCEEDED, 100)},
+		"subtask-2": {failedSubtask},
+	})
+
+	_, err := waitForTask(context.Background(), getTask, "task", time.Minute)
+	taskErr := &TaskError{}
+	if !errors.As(err, &taskErr) {
+		t.Fatalf("waitForTask() error = %v, expected a TaskError", err)
+	}
+
+	want := []string{"[VMM-30100] no space left", "kNoSpace", "subtask subtask-2 (kVmCreate): failed"}
+	if strings.Join(taskErr.Messages, "|") != strings.Join(want, "|") {
+		t.Errorf("TaskError messages = %q, want %q", taskErr.Messages, want)
+	}
+	if !strings.Contains(err.Error(), "task task (kVmCreate) failed at 40%") {
+		t.Errorf("TaskError = %q, expected the task status and progress", err)
+	}
+}
+
+func TestWaitForTask_canceled(t *testing.T) {
+	getTask, _ := fakeTasks(map[string][]*prismConfig.Task{
+		"task": {newTestTask("task", prismConfig.TASKSTATUS_RUNNING, 20)},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := waitForTask(ctx, getTask, "task", time.Hour)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("waitForTask() error = %v, expected context canceled", err)
+	}
+	if time.Since(start) > minTaskPollInterval {
+		t.Errorf("waitForTask() returned after %s, expected to stop polling on cancel", time.Since(start))
+	}
+
+	_, err = waitForTask(context.Background(), getTask, "task", 100*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "timeout") {
+		t.Errorf("waitForTask() error = %v, expected a timeout", err)
+	}
+}
+
+func TestNextTaskPollInterval(t *testing.T) {
+	tests := []struct {
+		name               string
+		interval, elapsed  time.Duration
+		previous, progress int
+		want               time.Duration
+	}{
+		{"stalled progress backs off", 2 * time.Second, time.Minute, 10, 10, 3 * time.Second},
+		{"backoff is bounded", 12 * time.Second, time.Minute, 10, 10, maxTaskPollInterval},
+		{"progressing task polled over its remaining time", 10 * time.Second, 40 * time.Second, 20, 50, 10 * time.Second},
+		{"nearly done task polled often", 10 * time.Second, 10 * time.Second, 50, 95, minTaskPollInterval},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nextTaskPollInterval(tt.interval, tt.elapsed, tt.previous, tt.progress); got != tt.want {
+				t.Errorf("nextTaskPollInterval() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
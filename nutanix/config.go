@@ -1,7 +1,10 @@
 package nutanix
 
 import (
+	"context"
 	"fmt"
+	"log"
+	"sync"
 
 	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/client"
 	era "github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v3/era"
@@ -30,6 +33,7 @@ import (
 // Config ...
 type Config struct {
 	Endpoint           string
+	Endpoints          []string // Additional Prism Central endpoints, in order of preference, to fail over to
 	Username           string
 	Password           string
 	Port               string
@@ -45,15 +49,60 @@ type Config struct {
 	NdbPassword        string
 	APIKey             string            // API key for authentication (alternative to username/password)
 	CustomHeaders      map[string]string // Custom headers to add to all requests (e.g., for Cloudflare Access)
+	MaxRetries         int               // Maximum number of retries for transient API failures
+	RetryMaxWait       int               // Maximum wait in seconds between two retries
+	CACertificate      string            // PEM encoded CA certificates trusted in addition to the system ones
+	CAFile             string            // Path to a PEM encoded CA bundle trusted in addition to the system ones
+	ClientCertificate  string            // PEM encoded client certificate for mutual TLS
+	ClientKey          string            // PEM encoded private key of the client certificate
+	RequestsPerSecond  float64           // Maximum rate of requests sent to all endpoints, 0 means unlimited
+	MaxConcurrent      int               // Maximum number of requests in flight to all endpoints, 0 means unlimited
+	DefaultCategories  map[string]string // Categories (key to value) added to every resource supporting categories
+	CredentialsFile    string            // JSON file holding the Prism Central credentials, replacing Username, Password and APIKey
+	CredentialsCommand string            // Helper command printing the Prism Central credentials as JSON, replacing Username, Password and APIKey
+	CredentialsArgs    []string          // Arguments of CredentialsCommand
 }
 
 // Client ...
 func (c *Config) Client() (*Client, error) {
+	// single rate limiter shared by every client created from these credentials
+	rateLimiter := client.NewRateLimiter(c.RequestsPerSecond, c.MaxConcurrent)
+
+	// requests of every client are traced to NUTANIX_TRACE_FILE, or to the log at debug level
+	tracer, err := client.NewTracerFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	// endpoint is the preferred one, clients fail over to the other endpoints when it is unreachable
+	endpoint := c.Endpoint
+	if endpoint == "" && len(c.Endpoints) > 0 {
+		endpoint = c.Endpoints[0]
+	}
+	endpoints := client.NewEndpointPool(append([]string{endpoint}, c.Endpoints...), c.Port)
+
+	// credentials from a file or a helper are fetched now to validate them, and again on expiry
+	username, password, apiKey := c.Username, c.Password, c.APIKey
+	var credentialsSource *client.CredentialsSource
+	switch {
+	case c.CredentialsCommand != "":
+		credentialsSource = client.NewExecCredentialsSource(c.CredentialsCommand, c.CredentialsArgs, endpoint)
+	case c.CredentialsFile != "":
+		credentialsSource = client.NewFileCredentialsSource(c.CredentialsFile)
+	}
+	if credentialsSource != nil {
+		creds, err := credentialsSource.Get(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		username, password, apiKey = creds.Username, creds.Password, creds.APIKey
+	}
+
 	configCreds := client.Credentials{
-		URL:                fmt.Sprintf("%s:%s", c.Endpoint, c.Port),
-		Endpoint:           c.Endpoint,
-		Username:           c.Username,
-		Password:           c.Password,
+		URL:                fmt.Sprintf("%s:%s", endpoint, c.Port),
+		Endpoint:           endpoint,
+		Username:           username,
+		Password:           password,
 		Port:               c.Port,
 		Insecure:           c.Insecure,
 		SessionAuth:        c.SessionAuth,
@@ -64,125 +113,169 @@ func (c *Config) Client() (*Client, error) {
 		NdbUsername:        c.NdbUsername,
 		NdbPassword:        c.NdbPassword,
 		RequiredFields:     c.RequiredFields,
-		APIKey:             c.APIKey,
+		APIKey:             apiKey,
 		CustomHeaders:      c.CustomHeaders,
+		MaxRetries:         c.MaxRetries,
+		RetryMaxWait:       c.RetryMaxWait,
+		CACertificate:      c.CACertificate,
+		CAFile:             c.CAFile,
+		ClientCertificate:  c.ClientCertificate,
+		ClientKey:          c.ClientKey,
+		RateLimiter:        rateLimiter,
+		Endpoints:          endpoints,
+		CredentialsSource:  credentialsSource,
+		Tracer:             tracer,
 	}
 
-	v3Client, err := v3.NewV3Client(configCreds)
-	if err != nil {
-		return nil, err
-	}
-	karbonClient, err := karbon.NewKarbonAPIClient(configCreds)
-	if err != nil {
-		return nil, err
-	}
-	foundationClient, err := foundation.NewFoundationAPIClient(configCreds)
-	if err != nil {
-		return nil, err
-	}
-	fcClient, err := foundation_central.NewFoundationCentralClient(configCreds)
-	if err != nil {
-		return nil, err
-	}
-	eraClient, err := era.NewEraClient(configCreds)
-	if err != nil {
-		return nil, err
-	}
-	iamClient, err := iam.NewIamClient(configCreds)
-	if err != nil {
-		return nil, err
-	}
-	networkingClient, err := networking.NewNetworkingClient(configCreds)
-	if err != nil {
-		return nil, err
-	}
-	prismClient, err := prism.NewPrismClient(configCreds)
-	if err != nil {
-		return nil, err
-	}
-	microsegClient, err := microseg.NewMicrosegClient(configCreds)
-	if err != nil {
-		return nil, err
-	}
-	volumeClient, err := volumes.NewVolumeClient(configCreds)
-	if err != nil {
-		return nil, err
-	}
-	clustersClient, err := clusters.NewClustersClient(configCreds)
-	if err != nil {
-		return nil, err
-	}
-	dataprotectionClient, err := dataprotection.NewDataProtectionClient(configCreds)
-	if err != nil {
-		return nil, err
-	}
-	vmmClient, err := vmm.NewVmmClient(configCreds)
-	if err != nil {
-		return nil, err
-	}
-	dataPoliciesClient, err := datapolicies.NewDataPoliciesClient(configCreds)
-	if err != nil {
-		return nil, err
-	}
-	LcmClient, err := lcm.NewLcmClient(configCreds)
-	if err != nil {
-		return nil, err
-	}
-	calmClient, err := selfservice.NewCalmClient(configCreds)
-	if err != nil {
-		return nil, err
-	}
-	ObjectStoreClient, err := objectstores.NewObjectStoresClient(configCreds)
-	if err != nil {
-		return nil, err
-	}
-	SecurityClient, err := security.NewSecurityClient(configCreds)
-	if err != nil {
+	// fail early on invalid TLS settings, they are shared by all the clients
+	if _, err := client.NewTLSConfig(&configCreds); err != nil {
 		return nil, err
 	}
 
 	return &Client{
-		WaitTimeout:         c.WaitTimeout,
-		API:                 v3Client,
-		KarbonAPI:           karbonClient,
-		FoundationClientAPI: foundationClient,
-		FoundationCentral:   fcClient,
-		Era:                 eraClient,
-		NetworkingAPI:       networkingClient,
-		PrismAPI:            prismClient,
-		MicroSegAPI:         microsegClient,
-		IamAPI:              iamClient,
-		ClusterAPI:          clustersClient,
-		VolumeAPI:           volumeClient,
-		DataProtectionAPI:   dataprotectionClient,
-		VmmAPI:              vmmClient,
-		DataPoliciesAPI:     dataPoliciesClient,
-		LcmAPI:              LcmClient,
-		CalmAPI:             calmClient,
-		ObjectStoreAPI:      ObjectStoreClient,
-		SecurityAPI:         SecurityClient,
+		WaitTimeout:       c.WaitTimeout,
+		DefaultCategories: c.DefaultCategories,
+		credentials:       configCreds,
 	}, nil
 }
 
-// Client represents the nutanix API client
+// Client represents the nutanix API client. API clients are created on first use, so that
+// configurations only pay for the services they use and an error creating the client of a
+// service is only reported by the resources using that service.
 type Client struct {
-	API                 *v3.Client
-	KarbonAPI           *karbon.Client
-	FoundationClientAPI *foundation.Client
-	WaitTimeout         int64
-	FoundationCentral   *foundation_central.Client
-	Era                 *era.Client
-	NetworkingAPI       *networking.Client
-	PrismAPI            *prism.Client
-	MicroSegAPI         *microseg.Client
-	IamAPI              *iam.Client
-	ClusterAPI          *clusters.Client
-	VolumeAPI           *volumes.Client
-	DataProtectionAPI   *dataprotection.Client
-	VmmAPI              *vmm.Client
-	DataPoliciesAPI     *datapolicies.Client
-	LcmAPI              *lcm.Client
-	CalmAPI             *selfservice.Client
-	ObjectStoreAPI      *objectstores.Client
-	SecurityAPI         *security.Client
+	WaitTimeout       int64
+	DefaultCategories map[string]string
+
+	credentials client.Credentials
+
+	defaultCategoryExtIDs defaultCategoryExtIDs
+
+	api                 lazyClient[*v3.Client]
+	karbonAPI           lazyClient[*karbon.Client]
+	foundationClientAPI lazyClient[*foundation.Client]
+	foundationCentral   lazyClient[*foundation_central.Client]
+	era                 lazyClient[*era.Client]
+	networkingAPI       lazyClient[*networking.Client]
+	prismAPI            lazyClient[*prism.Client]
+	microSegAPI         lazyClient[*microseg.Client]
+	iamAPI              lazyClient[*iam.Client]
+	clusterAPI          lazyClient[*clusters.Client]
+	volumeAPI           lazyClient[*volumes.Client]
+	dataProtectionAPI   lazyClient[*dataprotection.Client]
+	vmmAPI              lazyClient[*vmm.Client]
+	dataPoliciesAPI     lazyClient[*datapolicies.Client]
+	lcmAPI              lazyClient[*lcm.Client]
+	calmAPI             lazyClient[*selfservice.Client]
+	objectStoreAPI      lazyClient[*objectstores.Client]
+	securityAPI         lazyClient[*security.Client]
+}
+
+// lazyClient holds an API client created once, on first use
+type lazyClient[T any] struct {
+	once   sync.Once
+	client T
+	err    error
+}
+
+// get returns the client, creating it with newClient on the first call. It is safe for
+// concurrent use. Constructors return a client reporting their error on every request along
+// with the error, the client is kept so that the error is returned by the resources using it.
+func (l *lazyClient[T]) get(credentials client.Credentials, newClient func(client.Credentials) (T, error)) T {
+	l.once.Do(func() {
+		l.client, l.err = newClient(credentials)
+		if l.err != nil {
+			log.Printf("[ERROR] creating %T client: %s", l.client, l.err)
+		}
+	})
+	return l.client
+}
+
+// API returns the Prism Central v3 API client
+func (c *Client) API() *v3.Client {
+	return c.api.get(c.credentials, v3.NewV3Client)
+}
+
+// KarbonAPI returns the Karbon API client
+func (c *Client) KarbonAPI() *karbon.Client {
+	return c.karbonAPI.get(c.credentials, karbon.NewKarbonAPIClient)
+}
+
+// FoundationClientAPI returns the Foundation API client
+func (c *Client) FoundationClientAPI() *foundation.Client {
+	return c.foundationClientAPI.get(c.credentials, foundation.NewFoundationAPIClient)
+}
+
+// FoundationCentral returns the Foundation Central API client
+func (c *Client) FoundationCentral() *foundation_central.Client {
+	return c.foundationCentral.get(c.credentials, foundation_central.NewFoundationCentralClient)
+}
+
+// Era returns the NDB API client
+func (c *Client) Era() *era.Client {
+	return c.era.get(c.credentials, era.NewEraClient)
+}
+
+// NetworkingAPI returns the v4 networking API client
+func (c *Client) NetworkingAPI() *networking.Client {
+	return c.networkingAPI.get(c.credentials, networking.NewNetworkingClient)
+}
+
+// PrismAPI returns the v4 prism API client
+func (c *Client) PrismAPI() *prism.Client {
+	return c.prismAPI.get(c.credentials, prism.NewPrismClient)
+}
+
+// MicroSegAPI returns the v4 microseg API client
+func (c *Client) MicroSegAPI() *microseg.Client {
+	return c.microSegAPI.get(c.credentials, microseg.NewMicrosegClient)
+}
+
+// IamAPI returns the v4 iam API client
+func (c *Client) IamAPI() *iam.Client {
+	return c.iamAPI.get(c.credentials, iam.NewIamClient)
+}
+
+// ClusterAPI returns the v4 clusters API client
+func (c *Client) ClusterAPI() *clusters.Client {
+	return c.clusterAPI.get(c.credentials, clusters.NewClustersClient)
+}
+
+// VolumeAPI returns the v4 volumes API client
+func (c *Client) VolumeAPI() *volumes.Client {
+	return c.volumeAPI.get(c.credentials, volumes.NewVolumeClient)
+}
+
+// DataProtectionAPI returns the v4 data protection API client
+func (c *Client) DataProtectionAPI() *dataprotection.Client {
+	return c.dataProtectionAPI.get(c.credentials, dataprotection.NewDataProtectionClient)
+}
+
+// VmmAPI returns the v4 vmm API client
+func (c *Client) VmmAPI() *vmm.Client {
+	return c.vmmAPI.get(c.credentials, vmm.NewVmmClient)
+}
+
+// DataPoliciesAPI returns the v4 data policies API client
+func (c *Client) DataPoliciesAPI() *datapolicies.Client {
+	return c.dataPoliciesAPI.get(c.credentials, datapolicies.NewDataPoliciesClient)
+}
+
+// LcmAPI returns the v4 lcm API client
+func (c *Client) LcmAPI() *lcm.Client {
+	return c.lcmAPI.get(c.credentials, lcm.NewLcmClient)
+}
+
+// CalmAPI returns the self service API client
+func (c *Client) CalmAPI() *selfservice.Client {
+	return c.calmAPI.get(c.credentials, selfservice.NewCalmClient)
+}
+
+// ObjectStoreAPI returns the v4 object stores API client
+func (c *Client) ObjectStoreAPI() *objectstores.Client {
+	return c.objectStoreAPI.get(c.credentials, objectstores.NewObjectStoresClient)
+}
+
+// SecurityAPI returns the v4 security API client
+func (c *Client) SecurityAPI() *security.Client {
+	return c.securityAPI.get(c.credentials, security.NewSecurityClient)
 }
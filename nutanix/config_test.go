@@ -1,7 +1,14 @@
 package nutanix
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
 	"testing"
+
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/client"
 )
 
 func TestConfig_Client(t *testing.T) {
@@ -74,3 +81,95 @@ func TestConfig_Client(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_ClientLazy(t *testing.T) {
+	config := &Config{
+		Endpoint: "localhost",
+		Username: "test",
+		Password: "test",
+		Port:     "8080",
+		Insecure: true,
+	}
+
+	client, err := config.Client()
+	if err != nil {
+		t.Fatalf("failed to create wanted client: %s", err)
+	}
+
+	var wg sync.WaitGroup
+	clients := make([]interface{}, 10)
+	for i := range clients {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			clients[i] = client.VmmAPI()
+		}(i)
+	}
+	wg.Wait()
+
+	for i := range clients {
+		if clients[i] == nil || clients[i] != clients[0] {
+			t.Fatalf("Client.VmmAPI() returned different clients %p and %p", clients[0], clients[i])
+		}
+	}
+	if client.API() != client.API() {
+		t.Error("Client.API() returned different clients")
+	}
+}
+
+func TestConfig_ClientLazySessionAuth(t *testing.T) {
+	calls := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"message": "unauthorized"}`)
+	}))
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	config := &Config{
+		Endpoint:    serverURL.Hostname(),
+		Port:        serverURL.Port(),
+		Username:    "test",
+		Password:    "test",
+		Insecure:    true,
+		SessionAuth: true,
+	}
+
+	// session auth must not be attempted before the v3 client is used
+	client, err := config.Client()
+	if err != nil {
+		t.Fatalf("failed to create wanted client: %s", err)
+	}
+	if calls != 0 {
+		t.Errorf("server received %d calls before first use of the client, expected 0", calls)
+	}
+
+	if _, err := client.API().V3.GetVM("uuid"); err == nil {
+		t.Error("Expected HTTP 401 error.")
+	}
+	if calls == 0 {
+		t.Error("server received no calls after first use of the client")
+	}
+
+	config.CACertificate = "not a certificate"
+	if _, err := config.Client(); err == nil {
+		t.Error("Expected error for invalid ca_certificate.")
+	}
+}
+
+func TestLazyClient_error(t *testing.T) {
+	var lazy lazyClient[*string]
+	failing := "failing client"
+	newClient := func(client.Credentials) (*string, error) {
+		return &failing, fmt.Errorf("could not configure transport")
+	}
+
+	// the client is kept along with the error, its requests report the error
+	if got := lazy.get(client.Credentials{}, newClient); got != &failing {
+		t.Errorf("lazyClient.get() = %v, expected the client returned with the error", got)
+	}
+	if lazy.err == nil {
+		t.Error("lazyClient.get() dropped the error of the constructor")
+	}
+}
@@ -0,0 +1,60 @@
+package nutanix
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	import1 "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
+)
+
+// defaultCategoryExtIDs holds the ext ids of the default categories, resolved once
+type defaultCategoryExtIDs struct {
+	once   sync.Once
+	extIDs []string
+	err    error
+}
+
+// DefaultCategoryExtIDs returns the ext ids of the provider default categories, as referenced by
+// v4 resources. They are looked up on first use, every default category must already exist.
+func (c *Client) DefaultCategoryExtIDs() ([]string, error) {
+	c.defaultCategoryExtIDs.once.Do(func() {
+		c.defaultCategoryExtIDs.extIDs, c.defaultCategoryExtIDs.err = c.lookupCategoryExtIDs(c.DefaultCategories)
+	})
+	return c.defaultCategoryExtIDs.extIDs, c.defaultCategoryExtIDs.err
+}
+
+func (c *Client) lookupCategoryExtIDs(categories map[string]string) ([]string, error) {
+	keys := make([]string, 0, len(categories))
+	for key := range categories {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	extIDs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		value := categories[key]
+		filter := fmt.Sprintf("key eq '%s' and value eq '%s'", escapeODataString(key), escapeODataString(value))
+
+		resp, err := c.PrismAPI().CategoriesAPIInstance.ListCategories(nil, nil, &filter, nil, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error while fetching default category %s=%s: %v", key, value, err)
+		}
+
+		var found []import1.Category
+		if resp.Data != nil {
+			found, _ = resp.Data.GetValue().([]import1.Category)
+		}
+		if len(found) == 0 || found[0].ExtId == nil {
+			return nil, fmt.Errorf("default category %s=%s not found", key, value)
+		}
+		extIDs = append(extIDs, *found[0].ExtId)
+	}
+	return extIDs, nil
+}
+
+// escapeODataString escapes single quotes of a string literal used in a $filter expression
+func escapeODataString(s string) string {
+	return strings.ReplaceAll(s, "'", "''")
+}
@@ -10,7 +10,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
 	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/internal"
 	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/services/clusters"
 	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/services/clustersv2"
@@ -36,6 +38,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/services/vmm"
 	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/services/vmmv2"
 	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/services/volumesv2"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
 
 // requiredProviderFields defines the required fields for each provider service.
@@ -71,6 +74,17 @@ func Provider() *schema.Provider {
 			"individual CVM address, as this would cause calls to fail during\n" +
 			"cluster lifecycle management operations, such as AOS upgrades.",
 
+		"endpoints": "Ordered list of Prism Central endpoints (IP or FQDN, optionally with\n" +
+			"port) to fail over to when endpoint cannot be reached, e.g. the nodes of a\n" +
+			"scale-out Prism Central. endpoint, if set, is always tried first. Unreachable\n" +
+			"endpoints are health checked and used again once they are back. Can also be\n" +
+			"set with NUTANIX_ENDPOINTS as a comma separated list.",
+
+		"credentials_source": "Source of the Prism Central credentials, replacing username, password\n" +
+			"and api_key. Either a JSON file, or a helper command printing the credentials\n" +
+			"as JSON. The file is read or the helper run again when the credentials expire\n" +
+			"or are rejected.",
+
 		"foundation_endpoint": "endpoint for foundation VM (eg. Foundation VM IP)",
 
 		"foundation_port": "Port for foundation VM",
@@ -86,6 +100,36 @@ func Provider() *schema.Provider {
 			"service tokens. Headers can also be set via environment variables with\n" +
 			"the NUTANIX_HEADER_ prefix (e.g., NUTANIX_HEADER_CF_ACCESS_CLIENT_ID\n" +
 			"becomes Cf-Access-Client-Id). Config values take precedence over env vars.",
+
+		"max_retries": "Maximum number of retries for transient API failures (429, 502, 503, 504\n" +
+			"and connection errors). Requests which are not idempotent are only retried\n" +
+			"when the server has not processed them. Set to 0 to disable retries.",
+
+		"retry_max_wait": "Maximum time in seconds to wait between two retries. Retry-After\n" +
+			"sent by the server is honored up to this limit.",
+
+		"ca_certificate": "PEM encoded CA certificate(s) used to verify the certificate of\n" +
+			"Prism Central and other endpoints, in addition to the system trust store.",
+
+		"ca_file": "Path to a PEM encoded CA bundle used to verify the certificate of\n" +
+			"Prism Central and other endpoints, in addition to the system trust store.",
+
+		"client_certificate": "PEM encoded client certificate used for mutual TLS authentication.\n" +
+			"Must be set together with client_key.",
+
+		"client_key": "PEM encoded private key of the client certificate used for mutual\n" +
+			"TLS authentication. Must be set together with client_certificate.",
+
+		"requests_per_second": "Maximum number of API requests per second sent by the provider,\n" +
+			"shared by all API clients. Requests above the limit are queued in order of\n" +
+			"arrival. 0 means unlimited.",
+
+		"max_concurrent_requests": "Maximum number of API requests in flight at the same time,\n" +
+			"shared by all API clients. 0 means unlimited.",
+
+		"default_categories": "Categories, as a map of category key to value, added to every resource\n" +
+			"supporting categories. Categories set on a resource take precedence over the\n" +
+			"default value of the same key.",
 	}
 
 	// Nutanix provider schema
@@ -127,6 +171,12 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("NUTANIX_ENDPOINT", nil),
 				Description: descriptions["endpoint"],
 			},
+			"endpoints": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions["endpoints"],
+			},
 			"wait_timeout": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -184,6 +234,94 @@ func Provider() *schema.Provider {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: descriptions["custom_headers"],
 			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NUTANIX_MAX_RETRIES", 3),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  descriptions["max_retries"],
+			},
+			"retry_max_wait": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NUTANIX_RETRY_MAX_WAIT", 30),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  descriptions["retry_max_wait"],
+			},
+			"ca_certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NUTANIX_CA_CERTIFICATE", nil),
+				Description: descriptions["ca_certificate"],
+			},
+			"ca_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NUTANIX_CA_FILE", nil),
+				Description: descriptions["ca_file"],
+			},
+			"client_certificate": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NUTANIX_CLIENT_CERTIFICATE", nil),
+				RequiredWith: []string{"client_key"},
+				Description:  descriptions["client_certificate"],
+			},
+			"client_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				DefaultFunc:  schema.EnvDefaultFunc("NUTANIX_CLIENT_KEY", nil),
+				RequiredWith: []string{"client_certificate"},
+				Description:  descriptions["client_key"],
+			},
+			"requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NUTANIX_REQUESTS_PER_SECOND", 0),
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  descriptions["requests_per_second"],
+			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NUTANIX_MAX_CONCURRENT_REQUESTS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  descriptions["max_concurrent_requests"],
+			},
+			"default_categories": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions["default_categories"],
+			},
+			"credentials_source": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions["credentials_source"],
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"file": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"credentials_source.0.file", "credentials_source.0.command"},
+							Description:  "Path to a JSON file holding the credentials.",
+						},
+						"command": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Helper command printing the credentials as JSON on its standard output.",
+						},
+						"args": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Arguments of the helper command.",
+						},
+					},
+				},
+			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"nutanix_image":                                   vmm.DataSourceNutanixImage(),
@@ -272,6 +410,18 @@ func Provider() *schema.Provider {
 			"nutanix_subnets_v2":                              networkingv2.DataSourceNutanixSubnetsV2(),
 			"nutanix_vpc_v2":                                  networkingv2.DataSourceNutanixVPCv2(),
 			"nutanix_vpcs_v2":                                 networkingv2.DataSourceNutanixVPCsv2(),
+			"nutanix_bgp_session_v2":                          networkingv2.DataSourceNutanixBgpSessionV2(),
+			"nutanix_bgp_sessions_v2":                         networkingv2.DataSourceNutanixBgpSessionsV2(),
+			"nutanix_vpn_gateway_v2":                          networkingv2.DataSourceNutanixVpnGatewayV2(),
+			"nutanix_vpn_gateways_v2":                         networkingv2.DataSourceNutanixVpnGatewaysV2(),
+			"nutanix_vpn_connection_v2":                       networkingv2.DataSourceNutanixVpnConnectionV2(),
+			"nutanix_vpn_connections_v2":                      networkingv2.DataSourceNutanixVpnConnectionsV2(),
+			"nutanix_layer2_stretch_v2":                       networkingv2.DataSourceNutanixLayer2StretchV2(),
+			"nutanix_layer2_stretches_v2":                     networkingv2.DataSourceNutanixLayer2StretchesV2(),
+			"nutanix_traffic_mirror_v2":                       networkingv2.DataSourceNutanixTrafficMirrorV2(),
+			"nutanix_traffic_mirrors_v2":                      networkingv2.DataSourceNutanixTrafficMirrorsV2(),
+			"nutanix_ipfix_exporter_v2":                       networkingv2.DataSourceNutanixIPFIXExporterV2(),
+			"nutanix_ipfix_exporters_v2":                      networkingv2.DataSourceNutanixIPFIXExportersV2(),
 			"nutanix_floating_ip_v2":                          networkingv2.DatasourceNutanixFloatingIPV2(),
 			"nutanix_floating_ips_v2":                         networkingv2.DatasourceNutanixFloatingIPsV2(),
 			"nutanix_network_security_policy_v2":              networkingv2.DataSourceNutanixNetworkSecurityPolicyV2(),
@@ -340,11 +490,19 @@ func Provider() *schema.Provider {
 			"nutanix_ovas_v2":                                 vmmv2.DatasourceNutanixOvasV2(),
 			"nutanix_virtual_machine_v2":                      vmmv2.DatasourceNutanixVirtualMachineV4(),
 			"nutanix_virtual_machines_v2":                     vmmv2.DatasourceNutanixVirtualMachinesV4(),
+			"nutanix_vm_stats_v2":                             vmmv2.DatasourceNutanixVMStatsV2(),
+			"nutanix_vms_stats_v2":                            vmmv2.DatasourceNutanixVMsStatsV2(),
+			"nutanix_esxi_vm_v2":                              vmmv2.DatasourceNutanixEsxiVMV2(),
+			"nutanix_esxi_vms_v2":                             vmmv2.DatasourceNutanixEsxiVMsV2(),
 			"nutanix_template_v2":                             vmmv2.DatasourceNutanixTemplateV2(),
 			"nutanix_templates_v2":                            vmmv2.DatasourceNutanixTemplatesV2(),
+			"nutanix_template_version_v2":                     vmmv2.DatasourceNutanixTemplateVersionV2(),
 			"nutanix_ngt_configuration_v2":                    vmmv2.DatasourceNutanixNGTConfigurationV4(),
 			"nutanix_image_placement_policy_v2":               vmmv2.DatasourceNutanixImagePlacementV4(),
 			"nutanix_image_placement_policies_v2":             vmmv2.DatasourceNutanixImagePlacementsV4(),
+			"nutanix_image_rate_limit_policy_v2":              vmmv2.DatasourceNutanixImageRateLimitPolicyV2(),
+			"nutanix_image_rate_limit_policies_v2":            vmmv2.DatasourceNutanixImageRateLimitPoliciesV2(),
+			"nutanix_image_effective_rate_limit_policies_v2":  vmmv2.DatasourceNutanixImageEffectiveRateLimitPoliciesV2(),
 			"nutanix_vm_anti_affinity_policy_v2":              vmmv2.DatasourceNutanixVMAntiAffinityPolicyV2(),
 			"nutanix_vm_anti_affinity_policies_v2":            vmmv2.DatasourceNutanixVMAntiAffinityPoliciesV2(),
 			"nutanix_vm_host_affinity_policy_v2":              vmmv2.DatasourceNutanixVMHostAffinityPolicyV2(),
@@ -354,6 +512,9 @@ func Provider() *schema.Provider {
 			"nutanix_system_user_passwords_v2":                passwordmanagerv2.DataSourceNutanixPasswordManagersV2(),
 			"nutanix_host_v2":                                 clustersv2.DatasourceNutanixHostEntityV2(),
 			"nutanix_hosts_v2":                                clustersv2.DatasourceNutanixHostEntitiesV2(),
+			"nutanix_host_nics_v2":                            clustersv2.DatasourceNutanixHostNicsV2(),
+			"nutanix_virtual_switch_v2":                       clustersv2.DatasourceNutanixVirtualSwitchV2(),
+			"nutanix_virtual_switches_v2":                     clustersv2.DatasourceNutanixVirtualSwitchesV2(),
 			"nutanix_ssl_certificate_v2":                      clustersv2.DatasourceNutanixSSLCertificateV2(),
 			"nutanix_cluster_profile_v2":                      clustersv2.DatasourceNutanixClusterProfileV2(),
 			"nutanix_cluster_profiles_v2":                     clustersv2.DatasourceNutanixClusterProfilesV2(),
@@ -431,6 +592,13 @@ func Provider() *schema.Provider {
 			"nutanix_subnet_v2":                               networkingv2.ResourceNutanixSubnetV2(),
 			"nutanix_floating_ip_v2":                          networkingv2.ResourceNutanixFloatingIPv2(),
 			"nutanix_vpc_v2":                                  networkingv2.ResourceNutanixVPCsV2(),
+			"nutanix_bgp_session_v2":                          networkingv2.ResourceNutanixBgpSessionV2(),
+			"nutanix_vpn_gateway_v2":                          networkingv2.ResourceNutanixVpnGatewayV2(),
+			"nutanix_vpn_connection_v2":                       networkingv2.ResourceNutanixVpnConnectionV2(),
+			"nutanix_layer2_stretch_v2":                       networkingv2.ResourceNutanixLayer2StretchV2(),
+			"nutanix_subnet_ip_reservation_v2":                networkingv2.ResourceNutanixSubnetIPReservationV2(),
+			"nutanix_traffic_mirror_v2":                       networkingv2.ResourceNutanixTrafficMirrorV2(),
+			"nutanix_ipfix_exporter_v2":                       networkingv2.ResourceNutanixIPFIXExporterV2(),
 			"nutanix_network_security_policy_v2":              networkingv2.ResourceNutanixNetworkSecurityPolicyV2(),
 			"nutanix_routes_v2":                               networkingv2.ResourceNutanixRoutesV2(),
 			"nutanix_pbr_v2":                                  networkingv2.ResourceNutanixPbrsV2(),
@@ -472,6 +640,8 @@ func Provider() *schema.Provider {
 			"nutanix_deploy_templates_v2":                     vmmv2.ResourceNutanixTemplateDeployV2(),
 			"nutanix_template_v2":                             vmmv2.ResourceNutanixTemplatesV2(),
 			"nutanix_template_guest_os_actions_v2":            vmmv2.ResourceNutanixTemplateActionsV2(),
+			"nutanix_template_version_v2":                     vmmv2.ResourceNutanixTemplateVersionV2(),
+			"nutanix_template_active_version_v2":              vmmv2.ResourceNutanixTemplateActiveVersionV2(),
 			"nutanix_ngt_installation_v2":                     vmmv2.ResourceNutanixNGTInstallationV2(),
 			"nutanix_ngt_upgrade_v2":                          vmmv2.ResourceNutanixNGTUpgradeV2(),
 			"nutanix_ngt_insert_iso_v2":                       vmmv2.ResourceNutanixNGTInsertIsoV2(),
@@ -483,11 +653,19 @@ func Provider() *schema.Provider {
 			"nutanix_ova_download_v2":                         vmmv2.ResourceNutanixOvaDownloadV2(),
 			"nutanix_vm_network_device_assign_ip_v2":          vmmv2.ResourceNutanixVmsNetworkDeviceAssignIPV2(),
 			"nutanix_vm_network_device_migrate_v2":            vmmv2.ResourceNutanixVmsNetworkDeviceMigrateV2(),
+			"nutanix_vm_migrate_host_v2":                      vmmv2.ResourceNutanixVMMigrateHostV2(),
+			"nutanix_vm_cross_cluster_migrate_v2":             vmmv2.ResourceNutanixVMCrossClusterMigrateV2(),
+			"nutanix_vm_group_v2":                             vmmv2.ResourceNutanixVMGroupV2(),
+			"nutanix_esxi_vm_power_action_v2":                 vmmv2.ResourceNutanixEsxiVMPowerActionV2(),
+			"nutanix_esxi_vm_categories_v2":                   vmmv2.ResourceNutanixEsxiVMCategoriesV2(),
+			"nutanix_esxi_vm_guest_tools_v2":                  vmmv2.ResourceNutanixEsxiVMGuestToolsV2(),
 			"nutanix_image_placement_policy_v2":               vmmv2.ResourceNutanixImagePlacementV2(),
+			"nutanix_image_rate_limit_policy_v2":              vmmv2.ResourceNutanixImageRateLimitPolicyV2(),
 			"nutanix_vm_host_affinity_policy_v2":              vmmv2.ResourceNutanixVMHostAffinityPolicyV2(),
 			"nutanix_vm_anti_affinity_policy_v2":              vmmv2.ResourceNutanixVMAntiAffinityPolicyV2(),
 			"nutanix_cluster_v2":                              clustersv2.ResourceNutanixClusterV2(),
 			"nutanix_cluster_add_node_v2":                     clustersv2.ResourceNutanixClusterAddNodeV2(),
+			"nutanix_virtual_switch_v2":                       clustersv2.ResourceNutanixVirtualSwitchV2(),
 			"nutanix_clusters_discover_unconfigured_nodes_v2": clustersv2.ResourceNutanixClusterDiscoverUnconfiguredNodesV2(),
 			"nutanix_clusters_unconfigured_node_networks_v2":  clustersv2.ResourceNutanixClusterUnconfiguredNodeNetworkV2(),
 			"nutanix_ssl_certificate_v2":                      clustersv2.ResourceNutanixSSLCertificateV2(),
@@ -521,11 +699,35 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	apiKey := d.Get("api_key").(string)
 	endpoint := d.Get("endpoint").(string)
 
+	// Fail over endpoints, the first one is used if endpoint is not set
+	endpoints := common.ExpandListOfString(d.Get("endpoints").([]interface{}))
+	if len(endpoints) == 0 && os.Getenv("NUTANIX_ENDPOINTS") != "" {
+		endpoints = strings.Split(os.Getenv("NUTANIX_ENDPOINTS"), ",")
+	}
+	if endpoint == "" && len(endpoints) > 0 {
+		endpoint = strings.TrimSpace(endpoints[0])
+	}
+
+	// Credentials from a file or a helper replace the static ones, they are validated by the client
+	credentialsFile, credentialsCommand, credentialsArgs := "", "", []string(nil)
+	if v, ok := d.GetOk("credentials_source"); ok && v.([]interface{})[0] != nil {
+		source := v.([]interface{})[0].(map[string]interface{})
+		credentialsFile = source["file"].(string)
+		credentialsCommand = source["command"].(string)
+		credentialsArgs = common.ExpandListOfString(source["args"].([]interface{}))
+	}
+	hasCredentialsSource := credentialsFile != "" || credentialsCommand != ""
+
 	// Validate authentication: need either (username + password) OR api_key for Prism Central services
 	hasBasicAuth := username != "" && password != ""
 	hasAPIKey := apiKey != ""
 
-	if (username != "") != (password != "") {
+	if hasCredentialsSource && (username != "" || password != "" || hasAPIKey) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "credentials_source is set. username, password and api_key will be ignored.",
+		})
+	} else if (username != "") != (password != "") {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Invalid authentication configuration",
@@ -534,7 +736,7 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		return nil, diags
 	}
 
-	if endpoint != "" && !hasBasicAuth && !hasAPIKey {
+	if endpoint != "" && !hasBasicAuth && !hasAPIKey && !hasCredentialsSource {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Authentication required",
@@ -543,7 +745,7 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		return nil, diags
 	}
 
-	if hasBasicAuth && hasAPIKey {
+	if hasBasicAuth && hasAPIKey && !hasCredentialsSource {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Warning,
 			Summary:  "Both username/password and api_key are set. api_key takes precedence; username and password will be ignored.",
@@ -553,6 +755,10 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	for k, v := range requiredProviderFields {
 		// check if any field is not provided
 		for _, attr := range v {
+			// endpoint may be given by the endpoints list
+			if attr == "endpoint" && endpoint != "" {
+				continue
+			}
 			// for string fields
 			if _, ok := d.GetOk(attr); !ok {
 				disabledProviders = append(disabledProviders, k)
@@ -610,6 +816,7 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 
 	config := conns.Config{
 		Endpoint:           endpoint,
+		Endpoints:          endpoints,
 		Username:           username,
 		Password:           password,
 		Insecure:           d.Get("insecure").(bool),
@@ -625,6 +832,18 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		RequiredFields:     requiredProviderFields,
 		APIKey:             apiKey,
 		CustomHeaders:      customHeaders,
+		MaxRetries:         d.Get("max_retries").(int),
+		RetryMaxWait:       d.Get("retry_max_wait").(int),
+		CACertificate:      d.Get("ca_certificate").(string),
+		CAFile:             d.Get("ca_file").(string),
+		ClientCertificate:  d.Get("client_certificate").(string),
+		ClientKey:          d.Get("client_key").(string),
+		RequestsPerSecond:  d.Get("requests_per_second").(float64),
+		MaxConcurrent:      d.Get("max_concurrent_requests").(int),
+		DefaultCategories:  utils.ConvertMapString(d.Get("default_categories").(map[string]interface{})),
+		CredentialsFile:    credentialsFile,
+		CredentialsCommand: credentialsCommand,
+		CredentialsArgs:    credentialsArgs,
 	}
 	c, err := config.Client()
 	if err != nil {
@@ -6,6 +6,12 @@ import (
 	"testing"
 )
 
+func TestProvider(t *testing.T) {
+	if err := Provider().InternalValidate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
 func TestParseHeadersFromEnv(t *testing.T) {
 	// Save original environment and restore after test
 	originalEnv := os.Environ()
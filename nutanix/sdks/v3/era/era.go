@@ -29,7 +29,10 @@ func NewEraClient(credentials client.Credentials) (*Client, error) {
 
 		c, err := client.NewBaseClient(&credentials, absolutePath, false)
 		if err != nil {
-			return nil, err
+			// requests sent with this client report the error, so that it does not affect
+			// configurations which do not use this service
+			errorMsg := fmt.Sprintf("NDB Client could not be created: %s", err)
+			c = &client.Client{ErrorMsg: errorMsg}
 		}
 		baseClient = c
 	} else {
@@ -36,7 +36,10 @@ func NewFoundationAPIClient(credentials client.Credentials) (*Client, error) {
 		credentials.URL = fmt.Sprintf("%s:%s", credentials.FoundationEndpoint, credentials.FoundationPort)
 		c, err := client.NewBaseClient(&credentials, absolutePath, true)
 		if err != nil {
-			return nil, err
+			// requests sent with this client report the error, so that it does not affect
+			// configurations which do not use this service
+			errorMsg := fmt.Sprintf("Foundation Client could not be created: %s", err)
+			c = &client.Client{ErrorMsg: errorMsg}
 		}
 		baseClient = c
 	} else {
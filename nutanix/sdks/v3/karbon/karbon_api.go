@@ -32,7 +32,10 @@ func NewKarbonAPIClient(credentials client.Credentials) (*Client, error) {
 	if hasEndpoint && (hasBasicAuth || hasAPIKey) {
 		c, err := client.NewClient(&credentials, userAgent, absolutePath, false)
 		if err != nil {
-			return nil, err
+			// requests sent with this client report the error, so that it does not affect
+			// configurations which do not use this service
+			errorMsg := fmt.Sprintf("Karbon Client could not be created: %s", err)
+			c = &client.Client{UserAgent: userAgent, ErrorMsg: errorMsg}
 		}
 		baseClient = c
 	} else {
@@ -3,6 +3,7 @@ package prism
 import (
 	"context"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"os"
@@ -34,6 +35,7 @@ type Service interface {
 	ListImage(getEntitiesRequest *DSMetadata) (*ImageListIntentResponse, error)
 	UpdateImage(uuid string, body *ImageIntentInput) (*ImageIntentResponse, error)
 	UploadImage(uuid string, filepath string, checksum *Checksum) error
+	UploadImageWithProgress(ctx context.Context, uuid string, filepath string, checksum *Checksum, progress UploadProgressFunc) error
 	CreateOrUpdateCategoryKey(body *CategoryKey) (*CategoryKeyStatus, error)
 	ListCategories(getEntitiesRequest *CategoryListMetadata) (*CategoryKeyListResponse, error)
 	DeleteCategoryKey(name string) error
@@ -382,8 +384,23 @@ func (op Operations) CreateImage(body *ImageIntentInput) (*ImageIntentResponse,
  * @param uuid @param filepath
  */
 func (op Operations) UploadImage(uuid, filepath string, checksum *Checksum) error {
-	ctx := context.Background()
+	return op.uploadImage(context.Background(), uuid, filepath, checksum, nil, true)
+}
+
+// UploadProgressFunc is called as the file of an image is uploaded with the number of bytes sent and the
+// size of the file
+type UploadProgressFunc func(sent, total int64)
 
+// UploadImageWithProgress streams the file to the image and reports the progress of the upload. The
+// request is sent once, it is not retried by the client: every attempt sends the whole file, so callers
+// decide how many times a failed upload is sent again.
+func (op Operations) UploadImageWithProgress(ctx context.Context, uuid, filepath string, checksum *Checksum, progress UploadProgressFunc) error {
+	return op.uploadImage(ctx, uuid, filepath, checksum, progress, false)
+}
+
+// uploadImage streams the file to the image. The client retries the request on transient failures
+// when retry is set, sending the file again from its beginning.
+func (op Operations) uploadImage(ctx context.Context, uuid, filepath string, checksum *Checksum, progress UploadProgressFunc, retry bool) error {
 	path := fmt.Sprintf("/images/%s/file", uuid)
 
 	file, err := os.Open(filepath)
@@ -397,6 +414,25 @@ func (op Operations) UploadImage(uuid, filepath string, checksum *Checksum) erro
 		return fmt.Errorf("error: Creating request %s", err)
 	}
 
+	if !retry {
+		// a request whose body cannot be rebuilt is never replayed by the client
+		req.GetBody = nil
+	}
+
+	if progress != nil {
+		total := req.ContentLength
+		req.Body = &progressReader{reader: file, total: total, progress: progress}
+		if getBody := req.GetBody; getBody != nil {
+			req.GetBody = func() (io.ReadCloser, error) {
+				body, err := getBody()
+				if err != nil {
+					return nil, err
+				}
+				return &progressReader{reader: body, total: total, progress: progress}, nil
+			}
+		}
+	}
+
 	if checksum != nil && *checksum != (Checksum{}) {
 		req.Header.Add("X-Nutanix-Checksum-Type", *checksum.ChecksumAlgorithm)
 		req.Header.Add("X-Nutanix-Checksum-Bytes", *checksum.ChecksumValue)
@@ -407,6 +443,27 @@ func (op Operations) UploadImage(uuid, filepath string, checksum *Checksum) erro
 	return err
 }
 
+// progressReader reports the bytes read from the underlying reader
+type progressReader struct {
+	reader   io.Reader
+	sent     int64
+	total    int64
+	progress UploadProgressFunc
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		r.sent += int64(n)
+		r.progress(r.sent, r.total)
+	}
+	return n, err
+}
+
+func (r *progressReader) Close() error {
+	return nil
+}
+
 /*DeleteImage deletes a IMAGE
  * This operation submits a request to delete a IMAGE.
  *
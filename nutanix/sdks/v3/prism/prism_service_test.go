@@ -1,12 +1,14 @@
 package prism
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
 	"reflect"
 	"testing"
 
@@ -1044,6 +1046,75 @@ func TestOperations_UploadImage(t *testing.T) {
 	}
 }
 
+func TestOperations_UploadImageWithProgress(t *testing.T) {
+	mux, c, server := setup()
+
+	defer server.Close()
+
+	mux.HandleFunc("/api/nutanix/v3/images/cfde831a-4e87-4a75-960f-89b0148aa2cc/file", func(w http.ResponseWriter, r *http.Request) {
+		testHTTPMethod(t, r, http.MethodPut)
+
+		if got := r.Header.Get("X-Nutanix-Checksum-Type"); got != "SHA_256" {
+			t.Errorf("Operations.UploadImageWithProgress() checksum type = %s, want SHA_256", got)
+		}
+
+		bodyBytes, _ := ioutil.ReadAll(r.Body)
+		file, _ := ioutil.ReadFile("prism.go")
+
+		if !reflect.DeepEqual(bodyBytes, file) {
+			t.Errorf("Operations.UploadImageWithProgress() error: different uploaded files")
+		}
+	})
+
+	info, err := os.Stat("prism.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sent, total int64
+	op := Operations{
+		client: c,
+	}
+	checksum := &Checksum{
+		ChecksumAlgorithm: utils.StringPtr("SHA_256"),
+		ChecksumValue:     utils.StringPtr("0000"),
+	}
+	err = op.UploadImageWithProgress(context.Background(), "cfde831a-4e87-4a75-960f-89b0148aa2cc", "prism.go", checksum, func(s, t int64) {
+		sent, total = s, t
+	})
+	if err != nil {
+		t.Errorf("Operations.UploadImageWithProgress() error = %v", err)
+	}
+	if sent != info.Size() || total != info.Size() {
+		t.Errorf("Operations.UploadImageWithProgress() progress = %d/%d, want %d/%d", sent, total, info.Size(), info.Size())
+	}
+}
+
+func TestOperations_UploadImageWithProgressNotRetried(t *testing.T) {
+	mux, c, server := setup()
+
+	defer server.Close()
+
+	c.Credentials.MaxRetries = 3
+	calls := 0
+	mux.HandleFunc("/api/nutanix/v3/images/cfde831a-4e87-4a75-960f-89b0148aa2cc/file", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprint(w, `{"message": "service unavailable"}`)
+	})
+
+	op := Operations{
+		client: c,
+	}
+	err := op.UploadImageWithProgress(context.Background(), "cfde831a-4e87-4a75-960f-89b0148aa2cc", "prism.go", nil, func(s, t int64) {})
+	if err == nil {
+		t.Error("Operations.UploadImageWithProgress() expected error for HTTP 503")
+	}
+	if calls != 1 {
+		t.Errorf("Operations.UploadImageWithProgress() sent the file %d times, want 1", calls)
+	}
+}
+
 func TestOperations_DeleteImage(t *testing.T) {
 	mux, c, server := setup()
 
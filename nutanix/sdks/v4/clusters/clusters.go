@@ -17,6 +17,7 @@ type Client struct {
 
 func NewClustersClient(credentials client.Credentials) (*Client, error) {
 	var baseClient *cluster.ApiClient
+	var err error
 
 	pcClient := cluster.NewApiClient()
 	if cfg := sdkconfig.ConfigureV4Client(credentials, pcClient); cfg != nil {
@@ -25,6 +26,8 @@ func NewClustersClient(credentials client.Credentials) (*Client, error) {
 		pcClient.Username = cfg.Username
 		pcClient.Password = cfg.Password
 		pcClient.VerifySSL = cfg.VerifySSL
+		// requests to this service fail with err when its transport could not be configured
+		err = sdkconfig.ConfigureV4Transport(pcClient, credentials)
 		pcClient.AllowVersionNegotiation = cfg.AllowVersionNegotiation
 		baseClient = pcClient
 	}
@@ -35,5 +38,5 @@ func NewClustersClient(credentials client.Credentials) (*Client, error) {
 		PasswordManagerAPI:   api.NewPasswordManagerApi(baseClient),
 		ClusterProfilesAPI:   api.NewClusterProfilesApi(baseClient),
 		SSLCertificateAPI:    api.NewSSLCertificateApi(baseClient),
-	}, nil
+	}, err
 }
@@ -14,6 +14,7 @@ type Client struct {
 
 func NewDataPoliciesClient(credentials client.Credentials) (*Client, error) {
 	var baseClient *datapolicies.ApiClient
+	var err error
 
 	pcClient := datapolicies.NewApiClient()
 	if cfg := sdkconfig.ConfigureV4Client(credentials, pcClient); cfg != nil {
@@ -22,6 +23,8 @@ func NewDataPoliciesClient(credentials client.Credentials) (*Client, error) {
 		pcClient.Username = cfg.Username
 		pcClient.Password = cfg.Password
 		pcClient.VerifySSL = cfg.VerifySSL
+		// requests to this service fail with err when its transport could not be configured
+		err = sdkconfig.ConfigureV4Transport(pcClient, credentials)
 		pcClient.AllowVersionNegotiation = cfg.AllowVersionNegotiation
 		baseClient = pcClient
 	}
@@ -29,5 +32,5 @@ func NewDataPoliciesClient(credentials client.Credentials) (*Client, error) {
 	return &Client{
 		ProtectionPolicies: api.NewProtectionPoliciesApi(baseClient),
 		StoragePolicies:    api.NewStoragePoliciesApi(baseClient),
-	}, nil
+	}, err
 }
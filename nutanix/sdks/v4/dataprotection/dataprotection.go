@@ -14,6 +14,7 @@ type Client struct {
 
 func NewDataProtectionClient(credentials client.Credentials) (*Client, error) {
 	var baseClient *dataprotection.ApiClient
+	var err error
 
 	pcClient := dataprotection.NewApiClient()
 	if cfg := sdkconfig.ConfigureV4Client(credentials, pcClient); cfg != nil {
@@ -22,6 +23,8 @@ func NewDataProtectionClient(credentials client.Credentials) (*Client, error) {
 		pcClient.Username = cfg.Username
 		pcClient.Password = cfg.Password
 		pcClient.VerifySSL = cfg.VerifySSL
+		// requests to this service fail with err when its transport could not be configured
+		err = sdkconfig.ConfigureV4Transport(pcClient, credentials)
 		pcClient.AllowVersionNegotiation = cfg.AllowVersionNegotiation
 		baseClient = pcClient
 	}
@@ -29,5 +32,5 @@ func NewDataProtectionClient(credentials client.Credentials) (*Client, error) {
 	return &Client{
 		RecoveryPoint:     api.NewRecoveryPointsApi(baseClient),
 		ProtectedResource: api.NewProtectedResourcesApi(baseClient),
-	}, nil
+	}, err
 }
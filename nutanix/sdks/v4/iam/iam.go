@@ -21,6 +21,7 @@ type Client struct {
 
 func NewIamClient(credentials client.Credentials) (*Client, error) {
 	var baseClient *iam.ApiClient
+	var err error
 
 	pcClient := iam.NewApiClient()
 	if cfg := sdkconfig.ConfigureV4Client(credentials, pcClient); cfg != nil {
@@ -29,6 +30,8 @@ func NewIamClient(credentials client.Credentials) (*Client, error) {
 		pcClient.Username = cfg.Username
 		pcClient.Password = cfg.Password
 		pcClient.VerifySSL = cfg.VerifySSL
+		// requests to this service fail with err when its transport could not be configured
+		err = sdkconfig.ConfigureV4Transport(pcClient, credentials)
 		pcClient.AllowVersionNegotiation = cfg.AllowVersionNegotiation
 		baseClient = pcClient
 	}
@@ -45,5 +48,5 @@ func NewIamClient(credentials client.Credentials) (*Client, error) {
 		APIClientInstance:           baseClient,
 	}
 
-	return f, nil
+	return f, err
 }
@@ -18,6 +18,7 @@ type Client struct {
 
 func NewLcmClient(credentials client.Credentials) (*Client, error) {
 	var baseClient *lcm.ApiClient
+	var err error
 
 	pcClient := lcm.NewApiClient()
 	if cfg := sdkconfig.ConfigureV4Client(credentials, pcClient); cfg != nil {
@@ -26,6 +27,8 @@ func NewLcmClient(credentials client.Credentials) (*Client, error) {
 		pcClient.Username = cfg.Username
 		pcClient.Password = cfg.Password
 		pcClient.VerifySSL = cfg.VerifySSL
+		// requests to this service fail with err when its transport could not be configured
+		err = sdkconfig.ConfigureV4Transport(pcClient, credentials)
 		pcClient.AllowVersionNegotiation = cfg.AllowVersionNegotiation
 		baseClient = pcClient
 	}
@@ -37,5 +40,5 @@ func NewLcmClient(credentials client.Credentials) (*Client, error) {
 		LcmStatusAPIInstance:    api.NewStatusApi(baseClient),
 		LcmEntitiesAPIInstance:  api.NewEntitiesApi(baseClient),
 		LcmUpgradeAPIInstance:   api.NewUpgradesApi(baseClient),
-	}, nil
+	}, err
 }
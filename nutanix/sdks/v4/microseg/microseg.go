@@ -16,6 +16,7 @@ type Client struct {
 
 func NewMicrosegClient(credentials client.Credentials) (*Client, error) {
 	var baseClient *microseg.ApiClient
+	var err error
 
 	pcClient := microseg.NewApiClient()
 	if cfg := sdkconfig.ConfigureV4Client(credentials, pcClient); cfg != nil {
@@ -24,6 +25,8 @@ func NewMicrosegClient(credentials client.Credentials) (*Client, error) {
 		pcClient.Username = cfg.Username
 		pcClient.Password = cfg.Password
 		pcClient.VerifySSL = cfg.VerifySSL
+		// requests to this service fail with err when its transport could not be configured
+		err = sdkconfig.ConfigureV4Transport(pcClient, credentials)
 		pcClient.AllowVersionNegotiation = cfg.AllowVersionNegotiation
 		baseClient = pcClient
 	}
@@ -35,5 +38,5 @@ func NewMicrosegClient(credentials client.Credentials) (*Client, error) {
 		EntityGroupsAPIInstance:    api.NewEntityGroupsApi(baseClient),
 	}
 
-	return f, nil
+	return f, err
 }
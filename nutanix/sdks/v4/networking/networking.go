@@ -8,18 +8,27 @@ import (
 )
 
 type Client struct {
-	Routes                *api.RoutesApi
-	RoutesTable           *api.RouteTablesApi
-	APIClientInstance     *network.ApiClient
-	RoutingPolicy         *api.RoutingPoliciesApi
-	NetworkFunctionAPI    *api.NetworkFunctionsApi
-	SubnetAPIInstance     *api.SubnetsApi
-	VpcAPIInstance        *api.VpcsApi
-	FloatingIPAPIInstance *api.FloatingIpsApi
+	Routes                   *api.RoutesApi
+	RoutesTable              *api.RouteTablesApi
+	APIClientInstance        *network.ApiClient
+	RoutingPolicy            *api.RoutingPoliciesApi
+	NetworkFunctionAPI       *api.NetworkFunctionsApi
+	SubnetAPIInstance        *api.SubnetsApi
+	VpcAPIInstance           *api.VpcsApi
+	FloatingIPAPIInstance    *api.FloatingIpsApi
+	BgpSessionAPIInstance    *api.BgpSessionsApi
+	GatewayAPIInstance       *api.GatewaysApi
+	VpnConnectionAPIInstance *api.VpnConnectionsApi
+	Layer2StretchAPIInstance *api.Layer2StretchesApi
+	IPReservationAPIInstance *api.SubnetIPReservationApi
+	TrafficMirrorAPIInstance *api.TrafficMirrorsApi
+	IPFIXExporterAPIInstance *api.IPFIXExportersApi
+	VirtualSwitchAPIInstance *api.VirtualSwitchesApi
 }
 
 func NewNetworkingClient(credentials client.Credentials) (*Client, error) {
 	var baseClient *network.ApiClient
+	var err error
 
 	pcClient := network.NewApiClient()
 	if cfg := sdkconfig.ConfigureV4Client(credentials, pcClient); cfg != nil {
@@ -28,17 +37,27 @@ func NewNetworkingClient(credentials client.Credentials) (*Client, error) {
 		pcClient.Username = cfg.Username
 		pcClient.Password = cfg.Password
 		pcClient.VerifySSL = cfg.VerifySSL
+		// requests to this service fail with err when its transport could not be configured
+		err = sdkconfig.ConfigureV4Transport(pcClient, credentials)
 		pcClient.AllowVersionNegotiation = cfg.AllowVersionNegotiation
 		baseClient = pcClient
 	}
 
 	return &Client{
-		Routes:                api.NewRoutesApi(baseClient),
-		RoutesTable:           api.NewRouteTablesApi(baseClient),
-		RoutingPolicy:         api.NewRoutingPoliciesApi(baseClient),
-		NetworkFunctionAPI:    api.NewNetworkFunctionsApi(baseClient),
-		SubnetAPIInstance:     api.NewSubnetsApi(baseClient),
-		VpcAPIInstance:        api.NewVpcsApi(baseClient),
-		FloatingIPAPIInstance: api.NewFloatingIpsApi(baseClient),
-	}, nil
+		Routes:                   api.NewRoutesApi(baseClient),
+		RoutesTable:              api.NewRouteTablesApi(baseClient),
+		RoutingPolicy:            api.NewRoutingPoliciesApi(baseClient),
+		NetworkFunctionAPI:       api.NewNetworkFunctionsApi(baseClient),
+		SubnetAPIInstance:        api.NewSubnetsApi(baseClient),
+		VpcAPIInstance:           api.NewVpcsApi(baseClient),
+		FloatingIPAPIInstance:    api.NewFloatingIpsApi(baseClient),
+		BgpSessionAPIInstance:    api.NewBgpSessionsApi(baseClient),
+		GatewayAPIInstance:       api.NewGatewaysApi(baseClient),
+		VpnConnectionAPIInstance: api.NewVpnConnectionsApi(baseClient),
+		Layer2StretchAPIInstance: api.NewLayer2StretchesApi(baseClient),
+		IPReservationAPIInstance: api.NewSubnetIPReservationApi(baseClient),
+		TrafficMirrorAPIInstance: api.NewTrafficMirrorsApi(baseClient),
+		IPFIXExporterAPIInstance: api.NewIPFIXExportersApi(baseClient),
+		VirtualSwitchAPIInstance: api.NewVirtualSwitchesApi(baseClient),
+	}, err
 }
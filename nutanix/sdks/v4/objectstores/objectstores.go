@@ -13,6 +13,7 @@ type Client struct {
 
 func NewObjectStoresClient(credentials client.Credentials) (*Client, error) {
 	var baseClient *objects.ApiClient
+	var err error
 
 	pcClient := objects.NewApiClient()
 	if cfg := sdkconfig.ConfigureV4Client(credentials, pcClient); cfg != nil {
@@ -21,11 +22,13 @@ func NewObjectStoresClient(credentials client.Credentials) (*Client, error) {
 		pcClient.Username = cfg.Username
 		pcClient.Password = cfg.Password
 		pcClient.VerifySSL = cfg.VerifySSL
+		// requests to this service fail with err when its transport could not be configured
+		err = sdkconfig.ConfigureV4Transport(pcClient, credentials)
 		pcClient.AllowVersionNegotiation = cfg.AllowVersionNegotiation
 		baseClient = pcClient
 	}
 
 	return &Client{
 		ObjectStoresAPIInstance: api.NewObjectStoresApi(baseClient),
-	}, nil
+	}, err
 }
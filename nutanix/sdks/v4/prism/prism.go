@@ -16,6 +16,7 @@ type Client struct {
 
 func NewPrismClient(credentials client.Credentials) (*Client, error) {
 	var baseClient *prism.ApiClient
+	var err error
 
 	pcClient := prism.NewApiClient()
 	if cfg := sdkconfig.ConfigureV4Client(credentials, pcClient); cfg != nil {
@@ -24,6 +25,8 @@ func NewPrismClient(credentials client.Credentials) (*Client, error) {
 		pcClient.Username = cfg.Username
 		pcClient.Password = cfg.Password
 		pcClient.VerifySSL = cfg.VerifySSL
+		// requests to this service fail with err when its transport could not be configured
+		err = sdkconfig.ConfigureV4Transport(pcClient, credentials)
 		pcClient.AllowVersionNegotiation = cfg.AllowVersionNegotiation
 		baseClient = pcClient
 	}
@@ -33,5 +36,5 @@ func NewPrismClient(credentials client.Credentials) (*Client, error) {
 		CategoriesAPIInstance:           api.NewCategoriesApi(baseClient),
 		DomainManagerAPIInstance:        api.NewDomainManagerApi(baseClient),
 		DomainManagerBackupsAPIInstance: api.NewDomainManagerBackupsApi(baseClient),
-	}, nil
+	}, err
 }
@@ -44,7 +44,7 @@ func ConfigureV4Client(credentials client.Credentials, apiClient V4ApiClient) *V
 	cfg := &V4ApiClientConfig{
 		Host:                    credentials.Endpoint,
 		Port:                    port,
-		VerifySSL:               false,
+		VerifySSL:               !credentials.Insecure,
 		AllowVersionNegotiation: AllowVersionNegotiation,
 	}
 
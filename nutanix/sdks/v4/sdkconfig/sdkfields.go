@@ -0,0 +1,129 @@
+//go:build !purego
+
+package sdkconfig
+
+// The generated v4 SDK ApiClients keep their http clients and session cookie in unexported fields,
+// every access to them goes through this file. It was audited against the SDK versions pinned in
+// auditedV4SDKVersions, TestV4ApiClientLayout checks the fields used here on all the SDK clients
+// and TestV4SDKVersionsAudited fails once go.mod requires other versions. Any SDK upgrade must
+// re-audit this file and update auditedV4SDKVersions: the ApiClient.setupClient of the new version must still keep
+// the *http.Transport of retryClient.HTTPClient and wrap retryClient in httpClient, and must still
+// read cookie and refreshCookie only when sending requests.
+//
+// Building with the purego tag leaves this file out, the v4 transports cannot be configured then.
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"unsafe"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// auditedV4SDKVersions are the v4 SDK module versions this file was audited against
+var auditedV4SDKVersions = map[string]string{
+	"github.com/nutanix/ntnx-api-golang-clients/clustermgmt-go-client/v4":    "v4.2.2",
+	"github.com/nutanix/ntnx-api-golang-clients/datapolicies-go-client/v4":   "v4.2.2",
+	"github.com/nutanix/ntnx-api-golang-clients/dataprotection-go-client/v4": "v4.3.1",
+	"github.com/nutanix/ntnx-api-golang-clients/iam-go-client/v4":            "v4.1.2-beta.2",
+	"github.com/nutanix/ntnx-api-golang-clients/lifecycle-go-client/v4":      "v4.2.2",
+	"github.com/nutanix/ntnx-api-golang-clients/microseg-go-client/v4":       "v4.2.2",
+	"github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4":     "v4.3.1",
+	"github.com/nutanix/ntnx-api-golang-clients/objects-go-client/v4":        "v4.0.3",
+	"github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4":          "v4.3.1",
+	"github.com/nutanix/ntnx-api-golang-clients/security-go-client/v4":       "v4.1.2",
+	"github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4":            "v4.2.2",
+	"github.com/nutanix/ntnx-api-golang-clients/volumes-go-client/v4":        "v4.2.2",
+}
+
+// disableV4Cookies stops a v4 SDK ApiClient from storing and sending session cookies itself
+func disableV4Cookies(apiClient interface{}) error {
+	refreshCookie, err := v4ClientValue(apiClient, "refreshCookie", reflect.Bool)
+	if err != nil {
+		return err
+	}
+	cookie, err := v4ClientValue(apiClient, "cookie", reflect.String)
+	if err != nil {
+		return err
+	}
+
+	refreshCookie.SetBool(false)
+	cookie.SetString("")
+	return nil
+}
+
+// v4HTTPClient returns the http client used by a v4 SDK ApiClient to send requests
+func v4HTTPClient(apiClient interface{}) (*http.Client, error) {
+	field, err := v4ClientField(apiClient, "httpClient")
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient, ok := field.Interface().(*http.Client)
+	if !ok || httpClient == nil {
+		return nil, fmt.Errorf("v4 api client %T has no http client configured", apiClient)
+	}
+	return httpClient, nil
+}
+
+// v4RetryClient returns the retryable http client of a v4 SDK ApiClient
+func v4RetryClient(apiClient interface{}) (*retryablehttp.Client, error) {
+	field, err := v4ClientField(apiClient, "retryClient")
+	if err != nil {
+		return nil, err
+	}
+
+	retryClient, ok := field.Interface().(*retryablehttp.Client)
+	if !ok {
+		return nil, fmt.Errorf("unsupported retry client %s for v4 api client %T", field.Type(), apiClient)
+	}
+	return retryClient, nil
+}
+
+// v4Transport returns the http transport used by the retryable http client of a v4 SDK ApiClient
+func v4Transport(apiClient interface{}) (*http.Transport, error) {
+	retryClient, err := v4RetryClient(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := retryClient.HTTPClient
+	if httpClient == nil {
+		return nil, fmt.Errorf("v4 api client %T has no http client configured", apiClient)
+	}
+
+	transport, ok := httpClient.Transport.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("unsupported transport %T for v4 api client %T", httpClient.Transport, apiClient)
+	}
+
+	return transport, nil
+}
+
+// v4ClientField returns an accessible view on the unexported pointer field name of a v4 SDK ApiClient
+func v4ClientField(apiClient interface{}, name string) (reflect.Value, error) {
+	field, err := v4ClientValue(apiClient, name, reflect.Ptr)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	if field.IsNil() {
+		return reflect.Value{}, fmt.Errorf("v4 api client %T has no http client configured", apiClient)
+	}
+	return field, nil
+}
+
+// v4ClientValue returns an accessible and settable view on the unexported field name of kind kind
+// of a v4 SDK ApiClient
+func v4ClientValue(apiClient interface{}, name string, kind reflect.Kind) (reflect.Value, error) {
+	v := reflect.ValueOf(apiClient)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("unsupported v4 api client type %T", apiClient)
+	}
+
+	field := v.Elem().FieldByName(name)
+	if !field.IsValid() || field.Kind() != kind {
+		return reflect.Value{}, fmt.Errorf("unsupported v4 api client %T: no %s field %s", apiClient, kind, name)
+	}
+	return reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem(), nil
+}
@@ -0,0 +1,30 @@
+//go:build purego
+
+package sdkconfig
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// errV4ClientFields is returned for every access to the unexported fields of a v4 SDK ApiClient
+// in purego builds, see sdkfields.go
+var errV4ClientFields = errors.New("the transport of v4 api clients cannot be configured in purego builds")
+
+func disableV4Cookies(apiClient interface{}) error {
+	return errV4ClientFields
+}
+
+func v4HTTPClient(apiClient interface{}) (*http.Client, error) {
+	return nil, errV4ClientFields
+}
+
+func v4RetryClient(apiClient interface{}) (*retryablehttp.Client, error) {
+	return nil, errV4ClientFields
+}
+
+func v4Transport(apiClient interface{}) (*http.Transport, error) {
+	return nil, errV4ClientFields
+}
@@ -0,0 +1,18 @@
+//go:build purego
+
+package sdkconfig
+
+import (
+	"strings"
+	"testing"
+
+	vmm "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/client"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/client"
+)
+
+func TestConfigureV4Transport_purego(t *testing.T) {
+	err := ConfigureV4Transport(vmm.NewApiClient(), client.Credentials{})
+	if err == nil || !strings.Contains(err.Error(), errV4ClientFields.Error()) {
+		t.Fatalf("expected %v, got %v", errV4ClientFields, err)
+	}
+}
@@ -0,0 +1,114 @@
+package sdkconfig
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/client"
+)
+
+// ConfigureV4Transport applies the provider TLS settings (custom CA bundle and client
+// certificate), endpoint failover, request tracing, the shared rate limiter, the credentials source
+// and session authentication to the transport of a v4 SDK ApiClient.
+//
+// The generated ApiClients do not expose their http transport, they only allow toggling
+// VerifySSL. The transport is created once by the SDK and kept as long as its
+// InsecureSkipVerify matches !VerifySSL, so it is updated in place here. VerifySSL must be
+// set on the apiClient before calling this. The SDKs have no supported hook for this, the
+// unexported fields are only accessed in sdkfields.go, see there for the audited SDK versions.
+//
+// When the transport cannot be configured, the returned error is also reported by every request
+// sent with the apiClient, so that they are never sent with the default transport of the SDK.
+func ConfigureV4Transport(apiClient interface{}, credentials client.Credentials) error {
+	if err := configureV4Transport(apiClient, credentials); err != nil {
+		err = fmt.Errorf("could not configure transport of %T: %s", apiClient, err)
+		failV4Transport(apiClient, credentials.Insecure, err)
+		return err
+	}
+	return nil
+}
+
+func configureV4Transport(apiClient interface{}, credentials client.Credentials) error {
+	tlsConfig, err := client.NewTLSConfig(&credentials)
+	if err != nil {
+		return err
+	}
+
+	transport, err := v4Transport(apiClient)
+	if err != nil {
+		return err
+	}
+	transport.TLSClientConfig = tlsConfig
+	if credentials.Endpoints != nil && transport.DialContext != nil {
+		transport.DialContext = credentials.Endpoints.DialContext(transport.DialContext)
+	}
+
+	if credentials.RateLimiter == nil && credentials.CredentialsSource == nil && credentials.Tracer == nil && !credentials.SessionAuth {
+		return nil
+	}
+
+	httpClient, err := v4HTTPClient(apiClient)
+	if err != nil {
+		return err
+	}
+
+	// wrap the retrying round tripper, so that every request is traced and rate limited once
+	httpClient.Transport = credentials.Tracer.Transport(httpClient.Transport)
+	httpClient.Transport = credentials.RateLimiter.Transport(httpClient.Transport)
+	if credentials.RateLimiter != nil {
+		// the SDK requires the transport below its retryable client to be a *http.Transport, the
+		// retries take their token from the rate limiter while backing off instead
+		retryClient, err := v4RetryClient(apiClient)
+		if err != nil {
+			return err
+		}
+		backoff := retryClient.Backoff
+		retryClient.Backoff = func(min, max time.Duration, attempt int, resp *http.Response) time.Duration {
+			return backoff(min, max, attempt, resp) + credentials.RateLimiter.Reserve()
+		}
+	}
+	httpClient.Transport = credentials.CredentialsSource.Transport(httpClient.Transport)
+
+	if credentials.SessionAuth {
+		// the SDK keeps a single session cookie without synchronisation, it is replaced by a
+		// session shared safely by all the requests of this client
+		if err := disableV4Cookies(apiClient); err != nil {
+			return err
+		}
+		httpClient.Transport = client.NewSession().Transport(httpClient.Transport)
+	}
+
+	return nil
+}
+
+// failV4Transport makes every request of a v4 SDK ApiClient fail with err
+func failV4Transport(apiClient interface{}, insecure bool, err error) {
+	transport, tErr := v4Transport(apiClient)
+	if tErr != nil {
+		return
+	}
+	httpClient, hErr := v4HTTPClient(apiClient)
+	if hErr != nil {
+		return
+	}
+
+	// the SDK creates a new http client when the TLS settings of its transport do not match
+	// VerifySSL, which would drop the failing transport
+	//nolint:gosec
+	transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: insecure}
+	httpClient.Transport = errorTransport{err: err}
+}
+
+// errorTransport is a http.RoundTripper failing every request with err
+type errorTransport struct {
+	err error
+}
+
+func (t errorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	return nil, t.err
+}
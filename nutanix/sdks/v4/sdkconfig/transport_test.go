@@ -0,0 +1,287 @@
+//go:build !purego
+
+package sdkconfig
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	cluster "github.com/nutanix/ntnx-api-golang-clients/clustermgmt-go-client/v4/client"
+	datapolicies "github.com/nutanix/ntnx-api-golang-clients/datapolicies-go-client/v4/client"
+	dataprotection "github.com/nutanix/ntnx-api-golang-clients/dataprotection-go-client/v4/client"
+	iam "github.com/nutanix/ntnx-api-golang-clients/iam-go-client/v4/client"
+	lcm "github.com/nutanix/ntnx-api-golang-clients/lifecycle-go-client/v4/client"
+	microseg "github.com/nutanix/ntnx-api-golang-clients/microseg-go-client/v4/client"
+	network "github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/client"
+	objects "github.com/nutanix/ntnx-api-golang-clients/objects-go-client/v4/client"
+	prism "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/client"
+	security "github.com/nutanix/ntnx-api-golang-clients/security-go-client/v4/client"
+	vmm "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/client"
+	volumes "github.com/nutanix/ntnx-api-golang-clients/volumes-go-client/v4/client"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/client"
+)
+
+func TestConfigureV4Transport(t *testing.T) {
+	credentials := client.Credentials{
+		Endpoint: "foo.com",
+		Username: "username",
+		Password: "password",
+		Insecure: false,
+	}
+
+	apiClient := vmm.NewApiClient()
+	cfg := ConfigureV4Client(credentials, apiClient)
+	if cfg == nil {
+		t.Fatal("ConfigureV4Client() returned nil config")
+	}
+	if !cfg.VerifySSL {
+		t.Errorf("ConfigureV4Client() VerifySSL = %v, expected %v", cfg.VerifySSL, true)
+	}
+	apiClient.VerifySSL = cfg.VerifySSL
+
+	if err := ConfigureV4Transport(apiClient, credentials); err != nil {
+		t.Fatalf("ConfigureV4Transport() errored out with error : %v", err)
+	}
+
+	transport, err := v4Transport(apiClient)
+	if err != nil {
+		t.Fatalf("v4Transport() errored out with error : %v", err)
+	}
+	if transport.TLSClientConfig == nil || transport.TLSClientConfig.InsecureSkipVerify {
+		t.Errorf("v4 transport does not verify certificates")
+	}
+
+	credentials.CACertificate = "not a certificate"
+	if err := ConfigureV4Transport(apiClient, credentials); err == nil {
+		t.Error("Expected error for invalid ca_certificate.")
+	}
+}
+
+func TestConfigureV4Transport_failsRequests(t *testing.T) {
+	credentials := client.Credentials{
+		Endpoint:      "foo.com",
+		Username:      "username",
+		Password:      "password",
+		Insecure:      true,
+		CACertificate: "not a certificate",
+	}
+
+	apiClient := vmm.NewApiClient()
+	apiClient.VerifySSL = !credentials.Insecure
+	apiClient.AllowVersionNegotiation = false
+	err := ConfigureV4Transport(apiClient, credentials)
+	if err == nil {
+		t.Fatal("Expected error for invalid ca_certificate.")
+	}
+
+	uri := "/api/vmm/v4.0/ahv/config/vms"
+	_, callErr := apiClient.CallApi(&uri, http.MethodGet, nil, url.Values{}, map[string]string{}, url.Values{}, nil, nil, nil)
+	if callErr == nil || !strings.Contains(callErr.Error(), err.Error()) {
+		t.Errorf("CallApi() error = %v, expected %v", callErr, err)
+	}
+}
+
+func TestConfigureV4Transport_unsupportedClient(t *testing.T) {
+	if err := ConfigureV4Transport(&struct{}{}, client.Credentials{}); err == nil {
+		t.Error("Expected error for unsupported api client.")
+	}
+}
+
+func TestConfigureV4Transport_sessionAuth(t *testing.T) {
+	credentials := client.Credentials{
+		Endpoint:    "foo.com",
+		Username:    "username",
+		Password:    "password",
+		SessionAuth: true,
+	}
+
+	apiClient := vmm.NewApiClient()
+	if err := ConfigureV4Transport(apiClient, credentials); err != nil {
+		t.Fatalf("ConfigureV4Transport() errored out with error : %v", err)
+	}
+
+	refreshCookie, err := v4ClientValue(apiClient, "refreshCookie", reflect.Bool)
+	if err != nil {
+		t.Fatalf("v4ClientValue() errored out with error : %v", err)
+	}
+	if refreshCookie.Bool() {
+		t.Error("v4 api client still manages session cookies")
+	}
+
+	httpClient, err := v4HTTPClient(apiClient)
+	if err != nil {
+		t.Fatalf("v4HTTPClient() errored out with error : %v", err)
+	}
+	if reflect.TypeOf(httpClient.Transport).String() != "*client.sessionTransport" {
+		t.Errorf("v4 http client transport = %T, expected session transport", httpClient.Transport)
+	}
+}
+
+func TestConfigureV4Transport_credentialsSource(t *testing.T) {
+	credentials := client.Credentials{
+		Endpoint:          "foo.com",
+		Username:          "username",
+		Password:          "password",
+		CredentialsSource: client.NewFileCredentialsSource("credentials.json"),
+	}
+
+	apiClient := vmm.NewApiClient()
+	if err := ConfigureV4Transport(apiClient, credentials); err != nil {
+		t.Fatalf("ConfigureV4Transport() errored out with error : %v", err)
+	}
+
+	httpClient, err := v4HTTPClient(apiClient)
+	if err != nil {
+		t.Fatalf("v4HTTPClient() errored out with error : %v", err)
+	}
+	if reflect.TypeOf(httpClient.Transport).String() != "*client.credentialsTransport" {
+		t.Errorf("v4 http client transport = %T, expected credentials transport", httpClient.Transport)
+	}
+}
+
+func TestConfigureV4Transport_rateLimitedRetries(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	port, _ := strconv.Atoi(serverURL.Port())
+	credentials := client.Credentials{
+		Endpoint:    serverURL.Hostname(),
+		Username:    "username",
+		Password:    "password",
+		RateLimiter: client.NewRateLimiter(10, 0),
+	}
+
+	apiClient := vmm.NewApiClient()
+	apiClient.Scheme = "http"
+	apiClient.Host = serverURL.Hostname()
+	apiClient.Port = port
+	apiClient.AllowVersionNegotiation = false
+	apiClient.MaxRetryAttempts = 2
+	apiClient.RetryInterval = time.Millisecond
+
+	// the SDK recreates its http client on the first request after the retry settings changed
+	uri := "/api/vmm/v4.0/ahv/config/vms"
+	_, _ = apiClient.CallApi(&uri, http.MethodGet, nil, url.Values{}, map[string]string{}, url.Values{}, nil, nil, nil)
+	calls = 0
+
+	if err := ConfigureV4Transport(apiClient, credentials); err != nil {
+		t.Fatalf("ConfigureV4Transport() errored out with error : %v", err)
+	}
+
+	// use up the burst, every attempt of the request then waits 0.1s for a token
+	for i := 0; i < 10; i++ {
+		credentials.RateLimiter.Reserve()
+	}
+
+	start := time.Now()
+	_, _ = apiClient.CallApi(&uri, http.MethodGet, nil, url.Values{}, map[string]string{}, url.Values{}, nil, nil, nil)
+	if calls != 3 {
+		t.Fatalf("server received %d calls, expected 3", calls)
+	}
+	if elapsed := time.Since(start); elapsed < 250*time.Millisecond {
+		t.Errorf("3 attempts took %s, expected retries to be rate limited", elapsed)
+	}
+}
+
+// TestV4SDKVersionsAudited fails when go.mod requires v4 SDK versions sdkfields.go was not audited for
+func TestV4SDKVersionsAudited(t *testing.T) {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		t.Skip("no build info")
+	}
+
+	found := map[string]string{}
+	for _, dep := range info.Deps {
+		if _, ok := auditedV4SDKVersions[dep.Path]; ok {
+			found[dep.Path] = dep.Version
+		}
+	}
+	for path, version := range auditedV4SDKVersions {
+		if found[path] != version {
+			t.Errorf("%s %s was not audited, re-audit sdkfields.go for it and update auditedV4SDKVersions (audited %s)", path, found[path], version)
+		}
+	}
+}
+
+// TestV4ApiClientLayout fails when an upgrade of a v4 SDK changes the unexported fields of its
+// ApiClient written by ConfigureV4Transport, or the way the SDK keeps its http client
+func TestV4ApiClientLayout(t *testing.T) {
+	apiClients := map[string]interface{}{
+		"clustermgmt":    cluster.NewApiClient(),
+		"datapolicies":   datapolicies.NewApiClient(),
+		"dataprotection": dataprotection.NewApiClient(),
+		"iam":            iam.NewApiClient(),
+		"lifecycle":      lcm.NewApiClient(),
+		"microseg":       microseg.NewApiClient(),
+		"networking":     network.NewApiClient(),
+		"objects":        objects.NewApiClient(),
+		"prism":          prism.NewApiClient(),
+		"security":       security.NewApiClient(),
+		"vmm":            vmm.NewApiClient(),
+		"volumes":        volumes.NewApiClient(),
+	}
+	fields := map[string]reflect.Type{
+		"retryClient":   reflect.TypeOf(&retryablehttp.Client{}),
+		"httpClient":    reflect.TypeOf(&http.Client{}),
+		"cookie":        reflect.TypeOf(""),
+		"refreshCookie": reflect.TypeOf(false),
+	}
+
+	for name, apiClient := range apiClients {
+		t.Run(name, func(t *testing.T) {
+			clientType := reflect.TypeOf(apiClient).Elem()
+			for fieldName, fieldType := range fields {
+				field, ok := clientType.FieldByName(fieldName)
+				if !ok || field.Type != fieldType {
+					t.Fatalf("%s has no field %s of type %s, ConfigureV4Transport must be updated for this SDK version",
+						clientType, fieldName, fieldType)
+				}
+			}
+
+			credentials := client.Credentials{
+				Endpoint:    "foo.com",
+				Username:    "username",
+				Password:    "password",
+				Tracer:      client.NewTracer(io.Discard),
+				RateLimiter: client.NewRateLimiter(10, 1),
+				SessionAuth: true,
+			}
+			if err := ConfigureV4Transport(apiClient, credentials); err != nil {
+				t.Fatalf("ConfigureV4Transport() errored out with error : %v", err)
+			}
+
+			// the SDK must keep the configured http client across requests
+			credentials.CACertificate = "not a certificate"
+			err := ConfigureV4Transport(apiClient, credentials)
+			if err == nil {
+				t.Fatal("Expected error for invalid ca_certificate.")
+			}
+			reflect.ValueOf(apiClient).Elem().FieldByName("AllowVersionNegotiation").SetBool(false)
+			uri := "/api"
+			callAPI := reflect.ValueOf(apiClient).MethodByName("CallApi")
+			out := callAPI.Call([]reflect.Value{
+				reflect.ValueOf(&uri), reflect.ValueOf(http.MethodGet), reflect.Zero(callAPI.Type().In(2)),
+				reflect.ValueOf(url.Values{}), reflect.ValueOf(map[string]string{}), reflect.ValueOf(url.Values{}),
+				reflect.ValueOf([]string(nil)), reflect.ValueOf([]string(nil)), reflect.ValueOf([]string(nil)),
+			})
+			callErr, _ := out[1].Interface().(error)
+			if callErr == nil || !strings.Contains(callErr.Error(), err.Error()) {
+				t.Errorf("CallApi() error = %v, expected the SDK to keep the configured transport", callErr)
+			}
+		})
+	}
+}
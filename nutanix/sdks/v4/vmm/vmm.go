@@ -12,13 +12,17 @@ type Client struct {
 	TemplatesAPIInstance            *api.TemplatesApi
 	VMAPIInstance                   *api.VmApi
 	ImagesPlacementAPIInstance      *api.ImagePlacementPoliciesApi
+	ImagesRateLimitAPIInstance      *api.ImageRateLimitPoliciesApi
 	OvasAPIInstance                 *api.OvasApi
 	VMAntiAffinityPolicyAPIInstance *api.VmAntiAffinityPoliciesApi
 	VMHostAffinityPolicyAPIInstance *api.VmHostAffinityPoliciesApi
+	StatsAPIInstance                *api.StatsApi
+	EsxiVMAPIInstance               *api.EsxiVmApi
 }
 
 func NewVmmClient(credentials client.Credentials) (*Client, error) {
 	var baseClient *vmm.ApiClient
+	var err error
 
 	pcClient := vmm.NewApiClient()
 	if cfg := sdkconfig.ConfigureV4Client(credentials, pcClient); cfg != nil {
@@ -27,6 +31,8 @@ func NewVmmClient(credentials client.Credentials) (*Client, error) {
 		pcClient.Username = cfg.Username
 		pcClient.Password = cfg.Password
 		pcClient.VerifySSL = cfg.VerifySSL
+		// requests to this service fail with err when its transport could not be configured
+		err = sdkconfig.ConfigureV4Transport(pcClient, credentials)
 		pcClient.AllowVersionNegotiation = cfg.AllowVersionNegotiation
 		baseClient = pcClient
 	}
@@ -36,10 +42,13 @@ func NewVmmClient(credentials client.Credentials) (*Client, error) {
 		TemplatesAPIInstance:            api.NewTemplatesApi(baseClient),
 		VMAPIInstance:                   api.NewVmApi(baseClient),
 		ImagesPlacementAPIInstance:      api.NewImagePlacementPoliciesApi(baseClient),
+		ImagesRateLimitAPIInstance:      api.NewImageRateLimitPoliciesApi(baseClient),
 		OvasAPIInstance:                 api.NewOvasApi(baseClient),
 		VMAntiAffinityPolicyAPIInstance: api.NewVmAntiAffinityPoliciesApi(baseClient),
 		VMHostAffinityPolicyAPIInstance: api.NewVmHostAffinityPoliciesApi(baseClient),
+		StatsAPIInstance:                api.NewStatsApi(baseClient),
+		EsxiVMAPIInstance:               api.NewEsxiVmApi(baseClient),
 	}
 
-	return f, nil
+	return f, err
 }
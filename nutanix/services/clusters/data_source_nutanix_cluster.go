@@ -369,7 +369,7 @@ func DataSourceNutanixCluster() *schema.Resource {
 
 func dataSourceNutanixClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	c, ok := d.GetOk("cluster_id")
 	var v *v3.ClusterIntentResponse
@@ -372,7 +372,7 @@ func DataSourceNutanixClusters() *schema.Resource {
 
 func dataSourceNutanixClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	var filter string
 	resp, err := conn.V3.ListAllCluster(filter)
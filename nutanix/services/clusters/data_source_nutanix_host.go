@@ -255,7 +255,7 @@ func DataSourceNutanixHost() *schema.Resource {
 
 func dataSourceNutanixHostRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	hostID := d.Get("host_id").(string)
 
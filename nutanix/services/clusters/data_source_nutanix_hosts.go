@@ -263,7 +263,7 @@ func DataSourceNutanixHosts() *schema.Resource {
 
 func dataSourceNutanixHostsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	resp, err := conn.V3.ListAllHost()
 	if err != nil {
@@ -54,7 +54,7 @@ func DatasourceNutanixClusterEntitiesV2() *schema.Resource {
 }
 
 func DatasourceNutanixClusterEntitiesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ClusterAPI
+	conn := meta.(*conns.Client).ClusterAPI()
 
 	// initialize query params
 	var filter, orderBy, apply, expand, selectQ *string
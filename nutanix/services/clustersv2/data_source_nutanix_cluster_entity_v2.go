@@ -532,7 +532,7 @@ func DatasourceNutanixClusterEntityV2() *schema.Resource {
 }
 
 func DatasourceNutanixClusterEntityV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ClusterAPI
+	conn := meta.(*conns.Client).ClusterAPI()
 	var expand *string
 
 	extID := d.Get("ext_id")
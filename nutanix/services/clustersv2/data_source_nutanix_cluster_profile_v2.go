@@ -351,7 +351,7 @@ func DatasourceNutanixClusterProfileV2() *schema.Resource {
 }
 
 func DatasourceNutanixClusterProfileV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ClusterAPI
+	conn := meta.(*conns.Client).ClusterAPI()
 
 	extID := d.Get("ext_id").(string)
 
@@ -46,7 +46,7 @@ func DatasourceNutanixClusterProfilesV2() *schema.Resource {
 }
 
 func DatasourceNutanixClusterProfilesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ClusterAPI
+	conn := meta.(*conns.Client).ClusterAPI()
 
 	// initialize query params
 	var filter, orderBy, selectQ *string
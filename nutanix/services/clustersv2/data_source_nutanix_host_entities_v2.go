@@ -50,7 +50,7 @@ func DatasourceNutanixHostEntitiesV2() *schema.Resource {
 }
 
 func DatasourceNutanixHostEntitiesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ClusterAPI
+	conn := meta.(*conns.Client).ClusterAPI()
 
 	// initialize query params
 	var filter, orderBy, apply, selectQ *string
@@ -303,7 +303,7 @@ func DatasourceNutanixHostEntityV2() *schema.Resource {
 }
 
 func DatasourceNutanixHostEntityV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ClusterAPI
+	conn := meta.(*conns.Client).ClusterAPI()
 
 	extID := d.Get("ext_id")
 	clsID := d.Get("cluster_ext_id")
@@ -0,0 +1,395 @@
+package clustersv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/clustermgmt-go-client/v4/models/clustermgmt/v4/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func DatasourceNutanixHostNicsV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DatasourceNutanixHostNicsV2Read,
+		Schema: map[string]*schema.Schema{
+			"cluster_ext_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"host_ext_id"},
+			},
+			"host_ext_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"cluster_ext_id"},
+			},
+			"page": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"limit": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"order_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"select": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"host_nics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     schemaForHostNic(),
+			},
+		},
+	}
+}
+
+func DatasourceNutanixHostNicsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).ClusterAPI()
+
+	// initialize query params
+	var filter, orderBy, selectQ *string
+	var page, limit *int
+
+	if pagef, ok := d.GetOk("page"); ok {
+		page = utils.IntPtr(pagef.(int))
+	}
+	if limitf, ok := d.GetOk("limit"); ok {
+		limit = utils.IntPtr(limitf.(int))
+	}
+	if filterf, ok := d.GetOk("filter"); ok {
+		filter = utils.StringPtr(filterf.(string))
+	}
+	if order, ok := d.GetOk("order_by"); ok {
+		orderBy = utils.StringPtr(order.(string))
+	}
+	if selectQy, ok := d.GetOk("select"); ok {
+		selectQ = utils.StringPtr(selectQy.(string))
+	}
+
+	var data interface{}
+	// list the NICs of a single host when both cluster and host are given, all host NICs otherwise
+	if hostExtID, ok := d.GetOk("host_ext_id"); ok {
+		clusterExtID := d.Get("cluster_ext_id").(string)
+		resp, err := conn.ClusterEntityAPI.ListHostNicsByHostId(utils.StringPtr(clusterExtID), utils.StringPtr(hostExtID.(string)), page, limit, filter, orderBy, selectQ)
+		if err != nil {
+			return diag.Errorf("error while fetching host NICs of host %s : %v", hostExtID.(string), err)
+		}
+		if resp.Data != nil {
+			data = resp.Data.GetValue()
+		}
+	} else {
+		resp, err := conn.ClusterEntityAPI.ListHostNics(page, limit, filter, orderBy, selectQ)
+		if err != nil {
+			return diag.Errorf("error while fetching host NICs : %v", err)
+		}
+		if resp.Data != nil {
+			data = resp.Data.GetValue()
+		}
+	}
+
+	hostNics, _ := data.([]import1.HostNic)
+	if err := d.Set("host_nics", flattenHostNics(hostNics)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.UniqueId())
+	return nil
+}
+
+func schemaForHostNic() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"ext_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"links": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"href": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rel": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_ext_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"node_uuid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"host_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mac_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mtu_in_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"link_speed_in_kbps": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"link_capacity_in_mbps": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"interface_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_dhcp_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"ipv4_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     common.SchemaForIPList(false),
+			},
+			"ipv6_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     common.SchemaForIPList(false),
+			},
+			"discovery_protocol": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"driver_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"firmware_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pci_model_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"nic_profile_ext_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rx_ring_size_in_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tx_ring_size_in_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"supported_capabilities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"virtual_switch_ext_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"virtual_nic_ext_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"switch_device_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"switch_port_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"switch_mac_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"switch_vendor_info": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"switch_vlan_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"switch_management_ip": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     common.SchemaForIPList(false),
+			},
+			"attached_switch_interface_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ext_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"switch_interface_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"switch_interface_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"switch_interface_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"index": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"mac_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mtu_in_bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"speed_in_kbps": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"attached_host_uuid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"attached_host_nic_uuids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"switch_management_address": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     common.SchemaForIPList(false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func flattenHostNics(pr []import1.HostNic) []interface{} {
+	if len(pr) > 0 {
+		nics := make([]interface{}, len(pr))
+
+		for k, v := range pr {
+			nic := make(map[string]interface{})
+
+			nic["ext_id"] = v.ExtId
+			nic["tenant_id"] = v.TenantId
+			nic["links"] = common.FlattenLinks(v.Links)
+			nic["name"] = v.Name
+			nic["cluster_ext_id"] = v.ClusterExtId
+			nic["node_uuid"] = v.NodeUuid
+			nic["host_description"] = v.HostDescription
+			nic["mac_address"] = v.MacAddress
+			nic["mtu_in_bytes"] = v.MtuInBytes
+			nic["link_speed_in_kbps"] = v.LinkSpeedInKbps
+			nic["link_capacity_in_mbps"] = v.LinkCapacityInMbps
+			nic["interface_status"] = v.InterfaceStatus
+			nic["is_dhcp_enabled"] = v.IsDhcpEnabled
+			nic["ipv4_addresses"] = flattenIPAddressList(v.Ipv4Addresses)
+			nic["ipv6_addresses"] = flattenIPAddressList(v.Ipv6Addresses)
+			nic["discovery_protocol"] = v.DiscoveryProtocol
+			nic["driver_version"] = v.DriverVersion
+			nic["firmware_version"] = v.FirmwareVersion
+			nic["pci_model_id"] = v.PciModelId
+			nic["nic_profile_ext_id"] = v.NicProfileExtId
+			nic["rx_ring_size_in_bytes"] = v.RxRingSizeInBytes
+			nic["tx_ring_size_in_bytes"] = v.TxRingSizeInBytes
+			nic["supported_capabilities"] = v.SupportedCapabilities
+			nic["virtual_switch_ext_id"] = v.VirtualSwitchExtId
+			nic["virtual_nic_ext_ids"] = v.VirtualNicExtIds
+			nic["switch_device_id"] = v.SwitchDeviceId
+			nic["switch_port_id"] = v.SwitchPortId
+			nic["switch_mac_address"] = v.SwitchMacAddress
+			nic["switch_vendor_info"] = v.SwitchVendorInfo
+			nic["switch_vlan_id"] = v.SwitchVlanId
+			nic["switch_management_ip"] = flattenIPAddress(v.SwitchManagementIp)
+			nic["attached_switch_interface_list"] = flattenNetworkSwitchInterfaces(v.AttachedSwitchInterfaceList)
+
+			nics[k] = nic
+		}
+		return nics
+	}
+	return []interface{}{}
+}
+
+func flattenNetworkSwitchInterfaces(pr []import1.NetworkSwitchInterface) []map[string]interface{} {
+	if len(pr) > 0 {
+		interfaces := make([]map[string]interface{}, len(pr))
+
+		for k, v := range pr {
+			iface := make(map[string]interface{})
+
+			iface["ext_id"] = v.ExtId
+			iface["switch_interface_name"] = v.SwitchInterfaceName
+			iface["switch_interface_description"] = v.SwitchInterfaceDescription
+			iface["switch_interface_type"] = v.SwitchInterfaceType
+			iface["index"] = v.Index
+			iface["port"] = v.Port
+			iface["mac_address"] = v.MacAddress
+			iface["mtu_in_bytes"] = v.MtuInBytes
+			iface["speed_in_kbps"] = v.SpeedInKbps
+			iface["attached_host_uuid"] = v.AttachedHostUuid
+			iface["attached_host_nic_uuids"] = v.AttachedHostNicUuids
+			iface["switch_management_address"] = flattenIPAddress(v.SwitchManagementAddress)
+
+			interfaces[k] = iface
+		}
+		return interfaces
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package clustersv2_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+)
+
+const datasourceNameHostNics = "data.nutanix_host_nics_v2.test"
+
+func TestAccV2NutanixHostNicsDatasource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testHostNicsDatasourceV4Config(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceNameHostNics, "host_nics.#"),
+					resource.TestCheckResourceAttrSet(datasourceNameHostNics, "host_nics.0.ext_id"),
+					resource.TestCheckResourceAttrSet(datasourceNameHostNics, "host_nics.0.mac_address"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixHostNicsDatasource_ByHost(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testHostNicsDatasourceV4ByHostConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceNameHostNics, "host_nics.#"),
+					resource.TestCheckResourceAttrPair(datasourceNameHostNics, "host_nics.0.node_uuid", "data.nutanix_hosts_v2.test", "host_entities.0.ext_id"),
+					resource.TestCheckResourceAttr(datasourceNameHostNics, "host_nics.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testHostNicsDatasourceV4Config() string {
+	return `
+	data "nutanix_host_nics_v2" "test" {}
+	`
+}
+
+func testHostNicsDatasourceV4ByHostConfig() string {
+	return `
+		data "nutanix_hosts_v2" "test" {
+			limit = 1
+		}
+
+		data "nutanix_host_nics_v2" "test" {
+			cluster_ext_id = data.nutanix_hosts_v2.test.host_entities.0.cluster.0.uuid
+			host_ext_id    = data.nutanix_hosts_v2.test.host_entities.0.ext_id
+			limit          = 1
+		}
+	`
+}
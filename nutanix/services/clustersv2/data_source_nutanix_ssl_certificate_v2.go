@@ -48,7 +48,7 @@ func DatasourceNutanixSSLCertificateV2() *schema.Resource {
 }
 
 func DatasourceNutanixSSLCertificateV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ClusterAPI
+	conn := meta.(*conns.Client).ClusterAPI()
 
 	clusterExtID := d.Get("cluster_ext_id").(string)
 
@@ -0,0 +1,335 @@
+package clustersv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	import3 "github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/common/v1/config"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/networking/v4/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func DatasourceNutanixVirtualSwitchV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DatasourceNutanixVirtualSwitchV2Read,
+		Schema: map[string]*schema.Schema{
+			"ext_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bond_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mtu": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"is_quick_mode": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"clusters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ext_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vlan_identifier": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"gateway_ip_address": schemaForVirtualSwitchIPv4Address(),
+						"hosts": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ext_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"host_nics": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"internal_bridge_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"ip_address": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"ip": schemaForVirtualSwitchIPv4Address(),
+												"prefix_length": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"route_table": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"active_uplink": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"is_default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"owner_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"has_deployment_error": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"has_update_in_progress": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"has_delete_in_progress": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"links": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"href": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rel": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"metadata": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: schemaForVirtualSwitchMetadata(),
+				},
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func DatasourceNutanixVirtualSwitchV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	extID := d.Get("ext_id")
+
+	resp, err := conn.VirtualSwitchAPIInstance.GetVirtualSwitchById(utils.StringPtr(extID.(string)), nil)
+	if err != nil {
+		return diag.Errorf("error while fetching virtual switch : %v", err)
+	}
+
+	getResp := resp.Data.GetValue().(import1.VirtualSwitch)
+
+	for key, value := range flattenVirtualSwitchEntity(getResp) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(utils.StringValue(getResp.ExtId))
+	return nil
+}
+
+func flattenVirtualSwitchEntity(pr import1.VirtualSwitch) map[string]interface{} {
+	vSwitch := make(map[string]interface{})
+
+	vSwitch["ext_id"] = utils.StringValue(pr.ExtId)
+	vSwitch["name"] = utils.StringValue(pr.Name)
+	vSwitch["description"] = utils.StringValue(pr.Description)
+	if pr.BondMode != nil {
+		vSwitch["bond_mode"] = pr.BondMode.GetName()
+	}
+	vSwitch["mtu"] = utils.Int64Value(pr.Mtu)
+	vSwitch["is_quick_mode"] = utils.BoolValue(pr.IsQuickMode)
+	vSwitch["clusters"] = flattenVirtualSwitchClusters(pr.Clusters)
+	vSwitch["is_default"] = utils.BoolValue(pr.IsDefault)
+	if pr.OwnerType != nil {
+		vSwitch["owner_type"] = pr.OwnerType.GetName()
+	}
+	vSwitch["has_deployment_error"] = utils.BoolValue(pr.HasDeploymentError)
+	vSwitch["has_update_in_progress"] = utils.BoolValue(pr.HasUpdateInProgress)
+	vSwitch["has_delete_in_progress"] = utils.BoolValue(pr.HasDeleteInProgress)
+	vSwitch["links"] = common.FlattenLinks(pr.Links)
+	vSwitch["metadata"] = flattenVirtualSwitchMetadata(pr.Metadata)
+	vSwitch["tenant_id"] = utils.StringValue(pr.TenantId)
+
+	return vSwitch
+}
+
+func flattenVirtualSwitchClusters(pr []import1.Cluster) []map[string]interface{} {
+	if len(pr) == 0 {
+		return nil
+	}
+
+	clusters := make([]map[string]interface{}, len(pr))
+	for k, v := range pr {
+		cluster := make(map[string]interface{})
+
+		cluster["ext_id"] = utils.StringValue(v.ExtId)
+		cluster["vlan_identifier"] = utils.IntValue(v.VlanIdentifier)
+		cluster["gateway_ip_address"] = flattenVirtualSwitchIPv4Address(v.GatewayIpAddress)
+		cluster["hosts"] = flattenVirtualSwitchHosts(v.Hosts)
+
+		clusters[k] = cluster
+	}
+	return clusters
+}
+
+func flattenVirtualSwitchHosts(pr []import1.Host) []map[string]interface{} {
+	if len(pr) == 0 {
+		return nil
+	}
+
+	hosts := make([]map[string]interface{}, len(pr))
+	for k, v := range pr {
+		host := make(map[string]interface{})
+
+		host["ext_id"] = utils.StringValue(v.ExtId)
+		host["host_nics"] = v.HostNics
+		host["internal_bridge_name"] = utils.StringValue(v.InternalBridgeName)
+		host["ip_address"] = flattenVirtualSwitchIPv4Subnet(v.IpAddress)
+		host["route_table"] = utils.IntValue(v.RouteTable)
+		host["active_uplink"] = utils.StringValue(v.ActiveUplink)
+
+		hosts[k] = host
+	}
+	return hosts
+}
+
+// schemaForVirtualSwitchIPv4Address is the schema of an IPv4 address of the networking API
+func schemaForVirtualSwitchIPv4Address() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"value": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"prefix_length": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func schemaForVirtualSwitchMetadata() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"owner_reference_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"owner_user_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"project_reference_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"project_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"category_ids": {
+			Type:     schema.TypeSet,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
+
+func flattenVirtualSwitchMetadata(pr *import3.Metadata) []map[string]interface{} {
+	if pr == nil {
+		return nil
+	}
+
+	meta := make(map[string]interface{})
+	meta["owner_reference_id"] = utils.StringValue(pr.OwnerReferenceId)
+	meta["owner_user_name"] = utils.StringValue(pr.OwnerUserName)
+	meta["project_reference_id"] = utils.StringValue(pr.ProjectReferenceId)
+	meta["project_name"] = utils.StringValue(pr.ProjectName)
+	meta["category_ids"] = pr.CategoryIds
+
+	return []map[string]interface{}{meta}
+}
+
+func flattenVirtualSwitchIPv4Address(pr *import3.IPv4Address) []map[string]interface{} {
+	if pr == nil {
+		return nil
+	}
+
+	ip := make(map[string]interface{})
+	if pr.Value != nil {
+		ip["value"] = pr.Value
+	}
+	if pr.PrefixLength != nil {
+		ip["prefix_length"] = pr.PrefixLength
+	}
+
+	return []map[string]interface{}{ip}
+}
+
+func flattenVirtualSwitchIPv4Subnet(pr *import1.IPv4Subnet) []map[string]interface{} {
+	if pr == nil {
+		return nil
+	}
+
+	sub := make(map[string]interface{})
+	sub["ip"] = flattenVirtualSwitchIPv4Address(pr.Ip)
+	sub["prefix_length"] = pr.PrefixLength
+
+	return []map[string]interface{}{sub}
+}
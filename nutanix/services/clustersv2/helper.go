@@ -9,7 +9,6 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nutanix/ntnx-api-golang-clients/clustermgmt-go-client/v4/models/clustermgmt/v4/config"
 	import4 "github.com/nutanix/ntnx-api-golang-clients/clustermgmt-go-client/v4/models/common/v1/config"
@@ -589,8 +588,8 @@ func FindAddedCategories(oldCategories, newCategories []string) []string {
 // UpdateClusterCategories handles category association and disassociation for a cluster
 // This is a shared function used by both cluster entity and cluster categories resources
 func UpdateClusterCategories(ctx context.Context, d *schema.ResourceData, meta interface{}, clusterExtID string, oldCategoriesRaw, newCategoriesRaw interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ClusterAPI
-	taskconn := meta.(*conns.Client).PrismAPI
+	conn := meta.(*conns.Client).ClusterAPI()
+	taskconn := meta.(*conns.Client).PrismAPI()
 
 	// Convert to slices - handles both TypeList and TypeSet
 	oldCategoriesList := common.InterfaceToSlice(oldCategoriesRaw)
@@ -626,14 +625,7 @@ func UpdateClusterCategories(ctx context.Context, d *schema.ResourceData, meta i
 		taskUUID := TaskRef.ExtId
 
 		// Wait for the categories to be disassociated
-		stateConf := &resource.StateChangeConf{
-			Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-			Target:  []string{"SUCCEEDED"},
-			Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-			Timeout: d.Timeout(schema.TimeoutUpdate),
-		}
-
-		if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+		if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutUpdate)); errWaitTask != nil {
 			resourceUUID, _ := taskconn.TaskRefAPI.GetTaskById(taskUUID, nil)
 			if resourceUUID != nil {
 				rUUID := resourceUUID.Data.GetValue().(import2.Task)
@@ -671,14 +663,7 @@ func UpdateClusterCategories(ctx context.Context, d *schema.ResourceData, meta i
 		taskUUID := TaskRef.ExtId
 
 		// Wait for the categories to be associated
-		stateConf := &resource.StateChangeConf{
-			Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-			Target:  []string{"SUCCEEDED"},
-			Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-			Timeout: d.Timeout(schema.TimeoutUpdate),
-		}
-
-		if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+		if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutUpdate)); errWaitTask != nil {
 			return diag.Errorf("error waiting for categories to be associated to the cluster (%s): %s", utils.StringValue(taskUUID), errWaitTask)
 		}
 
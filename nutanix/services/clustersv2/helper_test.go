@@ -23,7 +23,7 @@ func taskStateRefreshPrismTaskGroupFunc(taskUUID string) resource.StateRefreshFu
 	return func() (interface{}, string, error) {
 		// data := base64.StdEncoding.EncodeToString([]byte("ergon"))
 		// encodeUUID := data + ":" + taskUUID
-		vresp, err := conn.PrismAPI.TaskRefAPI.GetTaskById(utils.StringPtr(taskUUID), nil)
+		vresp, err := conn.PrismAPI().TaskRefAPI.GetTaskById(utils.StringPtr(taskUUID), nil)
 
 		if err != nil {
 			return "", "", fmt.Errorf("error while polling prism task: %v", err)
@@ -218,22 +218,22 @@ func testAccCheckNutanixClusterDestroy(s *terraform.State) error {
 			continue
 		}
 
-		readResp, err := conn.ClusterAPI.ClusterEntityAPI.GetClusterById(utils.StringPtr(rs.Primary.ID), nil)
+		readResp, err := conn.ClusterAPI().ClusterEntityAPI.GetClusterById(utils.StringPtr(rs.Primary.ID), nil)
 		if err == nil {
 			// delete the cluster
 			//extract etag from read response
 			args := make(map[string]interface{})
-			etagValue := conn.ClusterAPI.ClusterEntityAPI.ApiClient.GetEtag(readResp)
+			etagValue := conn.ClusterAPI().ClusterEntityAPI.ApiClient.GetEtag(readResp)
 			args["If-Match"] = utils.StringPtr(etagValue)
 
-			deleteResp, err := conn.ClusterAPI.ClusterEntityAPI.DeleteClusterById(utils.StringPtr(rs.Primary.ID), utils.BoolPtr(false), args)
+			deleteResp, err := conn.ClusterAPI().ClusterEntityAPI.DeleteClusterById(utils.StringPtr(rs.Primary.ID), utils.BoolPtr(false), args)
 			if err != nil {
 				return err
 			}
 			TaskRef := deleteResp.Data.GetValue().(clusterPrism.TaskReference)
 			taskUUID := TaskRef.ExtId
 
-			taskconn := conn.PrismAPI
+			taskconn := conn.PrismAPI()
 			// Wait for the cluster to be deleted
 			stateConf := &resource.StateChangeConf{
 				Pending: []string{"PENDING", "RUNNING", "QUEUED"},
@@ -278,7 +278,7 @@ func isCategoryNotFoundErr(err error) bool {
 // helper function to check if categories and cluster categories association are destroyed
 func testAccCheckNutanixClusterCategoriesDestroy(s *terraform.State) error {
 	conn := acc.TestAccProvider.Meta().(*conns.Client)
-	categoryClient := conn.PrismAPI.CategoriesAPIInstance
+	categoryClient := conn.PrismAPI().CategoriesAPIInstance
 
 	// Collect all category IDs that should be destroyed
 	var categoryIDs []string
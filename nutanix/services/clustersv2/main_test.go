@@ -52,6 +52,11 @@ type TestConfig struct {
 			Username string `json:"username"`
 			Password string `json:"password"`
 		} `json:"remote_cluster"`
+		VirtualSwitch struct {
+			ClusterExtID string `json:"cluster_ext_id"`
+			HostExtID    string `json:"host_ext_id"`
+			HostNicExtID string `json:"host_nic_ext_id"`
+		} `json:"virtual_switch"`
 		SSLCertificate struct {
 			Passphrase        string `json:"passphrase"`
 			PrivateKey        string `json:"private_key"`
@@ -6,7 +6,6 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/nutanix/ntnx-api-golang-clients/clustermgmt-go-client/v4/models/clustermgmt/v4/config"
@@ -464,7 +463,7 @@ func userInfoSchema() *schema.Schema {
 }
 
 func ResourceNutanixClusterAddNodeV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ClusterAPI
+	conn := meta.(*conns.Client).ClusterAPI()
 
 	clusterExtID := d.Get("cluster_ext_id")
 
@@ -494,15 +493,9 @@ func ResourceNutanixClusterAddNodeV2Create(ctx context.Context, d *schema.Resour
 	TaskRef := resp.Data.GetValue().(clustermgmtPrism.TaskReference)
 	taskUUID := TaskRef.ExtId
 
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the node to be added
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutCreate),
-	}
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
 		return diag.Errorf("error waiting for node (%s) to add: %s", utils.StringValue(taskUUID), errWaitTask)
 	}
 	// Get UUID from TASK API
@@ -527,7 +520,7 @@ func ResourceNutanixClusterAddNodeV2Update(ctx context.Context, d *schema.Resour
 }
 
 func ResourceNutanixClusterAddNodeV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ClusterAPI
+	conn := meta.(*conns.Client).ClusterAPI()
 	body := &config.NodeRemovalParams{}
 	clusterExtID := d.Get("cluster_ext_id")
 
@@ -571,15 +564,9 @@ func ResourceNutanixClusterAddNodeV2Delete(ctx context.Context, d *schema.Resour
 	TaskRef := resp.Data.GetValue().(clustermgmtPrism.TaskReference)
 	taskUUID := TaskRef.ExtId
 
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the node to be removed
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING", "PENDING"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutCreate),
-	}
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
 		taskResp, _ := taskconn.TaskRefAPI.GetTaskById(taskUUID, nil)
 		taskDetails := taskResp.Data.GetValue().(import2.Task)
 		aJSON, _ = json.MarshalIndent(taskDetails, "", "  ")
@@ -13,7 +13,6 @@ import (
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/nutanix/ntnx-api-golang-clients/clustermgmt-go-client/v4/models/clustermgmt/v4/config"
@@ -836,7 +835,7 @@ func ResourceNutanixClusterV2() *schema.Resource {
 }
 
 func ResourceNutanixClusterV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ClusterAPI
+	conn := meta.(*conns.Client).ClusterAPI()
 	body := config.NewCluster()
 	var dryRun *bool
 
@@ -884,15 +883,9 @@ func ResourceNutanixClusterV2Create(ctx context.Context, d *schema.ResourceData,
 	TaskRef := resp.Data.GetValue().(import1.TaskReference)
 	taskUUID := TaskRef.ExtId
 
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the cluster to be created
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING", "PENDING"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutCreate),
-	}
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
 		return diag.Errorf("error waiting for cluster (%s) to create: %s", utils.StringValue(taskUUID), errWaitTask)
 	}
 	// Get UUID from TASK API
@@ -918,7 +911,7 @@ func ResourceNutanixClusterV2Create(ctx context.Context, d *schema.ResourceData,
 }
 
 func ResourceNutanixClusterV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ClusterAPI
+	conn := meta.(*conns.Client).ClusterAPI()
 
 	if d.Get("ext_id").(string) == "" {
 		log.Printf("[DEBUG] ResourceNutanixClusterV2Read : extID is empty")
@@ -945,7 +938,7 @@ func ResourceNutanixClusterV2Read(ctx context.Context, d *schema.ResourceData, m
 }
 
 func ResourceNutanixClusterV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ClusterAPI
+	conn := meta.(*conns.Client).ClusterAPI()
 	var expand *string
 	var nodeChanges bool
 
@@ -1026,16 +1019,10 @@ func ResourceNutanixClusterV2Update(ctx context.Context, d *schema.ResourceData,
 	// === Wait for Task completion ===
 	TaskRef := updateResp.Data.GetValue().(import1.TaskReference)
 	taskUUID := TaskRef.ExtId
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 
 	// Wait for the cluster to be updated
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING", "PENDING"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutUpdate),
-	}
-	if _, errWait := stateConf.WaitForStateContext(ctx); errWait != nil {
+	if _, errWait := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutUpdate)); errWait != nil {
 		return diag.Errorf("error waiting for cluster (%s) to update: %s", utils.StringValue(taskUUID), errWait)
 	}
 	// Get UUID from TASK API
@@ -1052,7 +1039,7 @@ func ResourceNutanixClusterV2Update(ctx context.Context, d *schema.ResourceData,
 }
 
 func ResourceNutanixClusterV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ClusterAPI
+	conn := meta.(*conns.Client).ClusterAPI()
 	var expand *string
 
 	if expandVar, ok := d.GetOk("expand"); ok {
@@ -1103,15 +1090,9 @@ func ResourceNutanixClusterV2Delete(ctx context.Context, d *schema.ResourceData,
 	TaskRef := resp.Data.GetValue().(import1.TaskReference)
 	taskUUID := TaskRef.ExtId
 
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the cluster to be deleted
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING", "PENDING"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutDelete),
-	}
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutDelete)); errWaitTask != nil {
 		return diag.Errorf("error waiting for cluster (%s) to delete: %s", utils.StringValue(taskUUID), errWaitTask)
 	}
 	// Get UUID from TASK API
@@ -1827,7 +1808,7 @@ func handleClusterProfileAssociationUpdate(ctx context.Context, d *schema.Resour
 	log.Printf("[DEBUG] Cluster profile association change - old: '%s', new: '%s'", oldProfileExtID, newProfileExtID)
 
 	clusterUUID := d.Id()
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 
 	// Build cluster reference for this cluster
 	clusterRef := config.ClusterReference{
@@ -1851,13 +1832,7 @@ func handleClusterProfileAssociationUpdate(ctx context.Context, d *schema.Resour
 		taskUUID := TaskRef.ExtId
 
 		// Wait for the cluster profile to be disassociated
-		stateConf := &resource.StateChangeConf{
-			Pending: []string{"QUEUED", "RUNNING", "PENDING"},
-			Target:  []string{"SUCCEEDED"},
-			Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-			Timeout: d.Timeout(schema.TimeoutUpdate),
-		}
-		if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+		if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutUpdate)); errWaitTask != nil {
 			return diag.Errorf("error waiting for cluster profile (%s) to disassociate: %s", utils.StringValue(taskUUID), errWaitTask)
 		}
 		log.Printf("[DEBUG] Cluster profile disassociation task %s completed", utils.StringValue(taskUUID))
@@ -1883,13 +1858,7 @@ func handleClusterProfileAssociationUpdate(ctx context.Context, d *schema.Resour
 		taskUUID := TaskRef.ExtId
 
 		// Wait for the cluster profile to be associated
-		stateConf := &resource.StateChangeConf{
-			Pending: []string{"QUEUED", "RUNNING", "PENDING"},
-			Target:  []string{"SUCCEEDED"},
-			Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-			Timeout: d.Timeout(schema.TimeoutUpdate),
-		}
-		if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+		if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutUpdate)); errWaitTask != nil {
 			return diag.Errorf("error waiting for cluster profile (%s) to associate: %s", utils.StringValue(taskUUID), errWaitTask)
 		}
 		log.Printf("[DEBUG] Cluster profile association task %s completed", utils.StringValue(taskUUID))
@@ -1998,15 +1967,9 @@ func removeNodeFromCluster(ctx context.Context, d *schema.ResourceData, meta int
 	TaskRef := resp.Data.GetValue().(import1.TaskReference)
 	taskUUID := TaskRef.ExtId
 
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the node to be removed
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING", "PENDING"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutUpdate),
-	}
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutUpdate)); errWaitTask != nil {
 		taskResp, _ := taskconn.TaskRefAPI.GetTaskById(taskUUID, nil)
 		taskDetails := taskResp.Data.GetValue().(import2.Task)
 		aJSON, _ := json.MarshalIndent(taskDetails, "", "  ")
@@ -2111,15 +2074,9 @@ func expandClusterWithNewNode(ctx context.Context, d *schema.ResourceData, meta
 	TaskRef := resp.Data.GetValue().(import1.TaskReference)
 	taskUUID := TaskRef.ExtId
 
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the node to be added
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutUpdate),
-	}
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutUpdate)); errWaitTask != nil {
 		return diag.Errorf("error waiting for node (%s) to add: %s", utils.StringValue(taskUUID), errWaitTask)
 	}
 	// Get UUID from TASK API
@@ -2175,15 +2132,9 @@ func fetchNetworkDetailsForNodes(ctx context.Context, d *schema.ResourceData, me
 	TaskRef := networkDetailsResp.Data.GetValue().(import1.TaskReference)
 	taskUUID := TaskRef.ExtId
 
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the fetch node networking details operation to complete
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING", "PENDING"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutCreate),
-	}
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
 		return diag.Errorf("error waiting for fetch node networking details (%s) to complete: %s", utils.StringValue(taskUUID), errWaitTask), nil
 	}
 	// Get UUID from TASK API
@@ -2245,15 +2196,9 @@ func discoverUnconfiguredNode(ctx context.Context, d *schema.ResourceData, meta
 	TaskRef := discoverUnconfiguredNodesResp.Data.GetValue().(import1.TaskReference)
 	taskUUID := TaskRef.ExtId
 
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the discover unconfigured nodes operation to complete
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutCreate),
-	}
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
 		return diag.Errorf("error waiting for unconfigured nodes (%s) to discover: %s", utils.StringValue(taskUUID), errWaitTask), nil
 	}
 	// Get UUID from TASK API
@@ -2298,7 +2243,7 @@ func clusterImportFunc(ctx context.Context, d *schema.ResourceData, meta interfa
 }
 
 func clusterRead(d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ClusterAPI
+	conn := meta.(*conns.Client).ClusterAPI()
 
 	var expand *string
 
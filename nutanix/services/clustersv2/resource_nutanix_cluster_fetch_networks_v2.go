@@ -7,7 +7,6 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/nutanix/ntnx-api-golang-clients/clustermgmt-go-client/v4/models/clustermgmt/v4/config"
@@ -251,7 +250,7 @@ func nodeListSchema() *schema.Schema {
 }
 
 func ResourceNutanixClusterUnconfiguredNodeNetworkV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ClusterAPI
+	conn := meta.(*conns.Client).ClusterAPI()
 	body := &config.NodeDetails{}
 	clusterExtID := d.Get("ext_id")
 	var expand *string
@@ -286,15 +285,9 @@ func ResourceNutanixClusterUnconfiguredNodeNetworkV2Create(ctx context.Context,
 	TaskRef := resp.Data.GetValue().(clsMangPrismConfig.TaskReference)
 	taskUUID := TaskRef.ExtId
 
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the fetch node networking details operation to complete
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING", "PENDING"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutCreate),
-	}
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
 		return diag.Errorf("error waiting for fetch node networking details (%s) to complete: %s", utils.StringValue(taskUUID), errWaitTask)
 	}
 	// Get UUID from TASK API
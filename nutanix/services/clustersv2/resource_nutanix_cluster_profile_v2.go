@@ -7,7 +7,6 @@ import (
 	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/nutanix/ntnx-api-golang-clients/clustermgmt-go-client/v4/models/clustermgmt/v4/config"
@@ -426,7 +425,7 @@ func ResourceNutanixClusterProfileV2() *schema.Resource {
 }
 
 func ResourceNutanixClusterProfileV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ClusterAPI
+	conn := meta.(*conns.Client).ClusterAPI()
 	body := expandClusterProfile(d)
 
 	aJSON, _ := json.MarshalIndent(body, "", "  ")
@@ -440,15 +439,9 @@ func ResourceNutanixClusterProfileV2Create(ctx context.Context, d *schema.Resour
 	TaskRef := createResp.Data.GetValue().(import3.TaskReference)
 	taskUUID := TaskRef.ExtId
 
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the cluster profile to be created
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING", "PENDING"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutCreate),
-	}
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
 		return diag.Errorf("error waiting for cluster profile (%s) to create: %s", utils.StringValue(taskUUID), errWaitTask)
 	}
 	// Get UUID from TASK API
@@ -472,7 +465,7 @@ func ResourceNutanixClusterProfileV2Create(ctx context.Context, d *schema.Resour
 }
 
 func ResourceNutanixClusterProfileV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ClusterAPI
+	conn := meta.(*conns.Client).ClusterAPI()
 
 	// Fetch the Cluster Profile by UUID
 	clusterProfileResp, err := conn.ClusterProfilesAPI.GetClusterProfileById(utils.StringPtr(d.Id()))
@@ -557,7 +550,7 @@ func ResourceNutanixClusterProfileV2Read(ctx context.Context, d *schema.Resource
 }
 
 func ResourceNutanixClusterProfileV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ClusterAPI
+	conn := meta.(*conns.Client).ClusterAPI()
 
 	// Fetch the Cluster Profile by UUID
 	clusterProfileResp, err := conn.ClusterProfilesAPI.GetClusterProfileById(utils.StringPtr(d.Id()))
@@ -658,15 +651,9 @@ func ResourceNutanixClusterProfileV2Update(ctx context.Context, d *schema.Resour
 	TaskRef := updateResp.Data.GetValue().(import3.TaskReference)
 	taskUUID := TaskRef.ExtId
 
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the cluster profile to be updated
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING", "PENDING"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutUpdate),
-	}
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutUpdate)); errWaitTask != nil {
 		return diag.Errorf("error waiting for cluster profile (%s) to update: %s", utils.StringValue(taskUUID), errWaitTask)
 	}
 	// Get UUID from TASK API
@@ -682,7 +669,7 @@ func ResourceNutanixClusterProfileV2Update(ctx context.Context, d *schema.Resour
 }
 
 func ResourceNutanixClusterProfileV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ClusterAPI
+	conn := meta.(*conns.Client).ClusterAPI()
 
 	deleteResp, deleteErr := conn.ClusterProfilesAPI.DeleteClusterProfileById(utils.StringPtr(d.Id()))
 	if deleteErr != nil {
@@ -691,15 +678,9 @@ func ResourceNutanixClusterProfileV2Delete(ctx context.Context, d *schema.Resour
 
 	TaskRef := deleteResp.Data.GetValue().(import3.TaskReference)
 	taskUUID := TaskRef.ExtId
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the cluster profile to be deleted
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING", "PENDING"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutDelete),
-	}
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutDelete)); errWaitTask != nil {
 		return diag.Errorf("error waiting for cluster profile (%s) to delete: %s", utils.StringValue(taskUUID), errWaitTask)
 	}
 	// Get UUID from TASK API
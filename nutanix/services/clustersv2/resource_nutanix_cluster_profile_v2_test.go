@@ -593,7 +593,7 @@ func testAccCheckClusterProfileDestroy(s *terraform.State) error {
 			continue
 		}
 		// Check API if resource exists
-		_, errRead := conn.ClusterAPI.ClusterProfilesAPI.GetClusterProfileById(utils.StringPtr(rs.Primary.ID))
+		_, errRead := conn.ClusterAPI().ClusterProfilesAPI.GetClusterProfileById(utils.StringPtr(rs.Primary.ID))
 		if errRead != nil {
 			if isClusterProfileNotFound(errRead, rs.Primary.ID) {
 				continue
@@ -601,7 +601,7 @@ func testAccCheckClusterProfileDestroy(s *terraform.State) error {
 			return errRead
 		}
 		log.Printf("[DEBUG] Cluster Profile %s still exists, destroying...", rs.Primary.ID)
-		_, err := conn.ClusterAPI.ClusterProfilesAPI.DeleteClusterProfileById(utils.StringPtr(rs.Primary.ID))
+		_, err := conn.ClusterAPI().ClusterProfilesAPI.DeleteClusterProfileById(utils.StringPtr(rs.Primary.ID))
 		if err != nil {
 			return err
 		}
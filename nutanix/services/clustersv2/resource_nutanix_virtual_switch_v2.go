@@ -0,0 +1,418 @@
+package clustersv2
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	import3 "github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/common/v1/config"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/networking/v4/config"
+	import4 "github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/prism/v4/config"
+	prismConfig "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func ResourceNutanixVirtualSwitchV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceNutanixVirtualSwitchV2Create,
+		ReadContext:   ResourceNutanixVirtualSwitchV2Read,
+		UpdateContext: ResourceNutanixVirtualSwitchV2Update,
+		DeleteContext: ResourceNutanixVirtualSwitchV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"ext_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"bond_mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ACTIVE_BACKUP", "BALANCE_SLB", "BALANCE_TCP", "NONE"}, false),
+			},
+			"mtu": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"is_quick_mode": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"clusters": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ext_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"vlan_identifier": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"gateway_ip_address": schemaForVirtualSwitchIPv4Address(),
+						"hosts": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ext_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"host_nics": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"internal_bridge_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"ip_address": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"ip": schemaForVirtualSwitchIPv4Address(),
+												"prefix_length": {
+													Type:     schema.TypeInt,
+													Optional: true,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"route_table": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+									"active_uplink": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"is_default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"owner_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"has_deployment_error": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"has_update_in_progress": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"has_delete_in_progress": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"links": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"href": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rel": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"metadata": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: schemaForVirtualSwitchMetadata(),
+				},
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func ResourceNutanixVirtualSwitchV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	inputSpec := import1.NewVirtualSwitch()
+
+	inputSpec.Name = utils.StringPtr(d.Get("name").(string))
+	inputSpec.BondMode = common.ExpandEnum[import1.BondModeType](d.Get("bond_mode"))
+	inputSpec.Clusters = expandVirtualSwitchClusters(d.Get("clusters").([]interface{}))
+
+	if description, ok := d.GetOk("description"); ok {
+		inputSpec.Description = utils.StringPtr(description.(string))
+	}
+	if mtu, ok := d.GetOk("mtu"); ok {
+		inputSpec.Mtu = utils.Int64Ptr(int64(mtu.(int)))
+	}
+	if isQuickMode, ok := d.GetOkExists("is_quick_mode"); ok { //nolint:staticcheck
+		inputSpec.IsQuickMode = utils.BoolPtr(isQuickMode.(bool))
+	}
+
+	aJSON, _ := json.MarshalIndent(inputSpec, "", " ")
+	log.Printf("[DEBUG] Virtual Switch create payload : %s", string(aJSON))
+
+	resp, err := conn.VirtualSwitchAPIInstance.CreateVirtualSwitch(inputSpec, nil)
+	if err != nil {
+		return diag.Errorf("error while creating virtual switch : %v", err)
+	}
+
+	TaskRef := resp.Data.GetValue().(import4.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	// calling group API to poll for completion of task
+	taskconn := meta.(*conns.Client).PrismAPI()
+
+	// Wait for the virtual switch to be created
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
+		return diag.Errorf("error waiting for virtual switch (%s) to create: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+
+	// Get UUID from TASK API
+	taskResp, err := taskconn.TaskRefAPI.GetTaskById(taskUUID, nil)
+	if err != nil {
+		return diag.Errorf("error while fetching virtual switch task: %v", err)
+	}
+	taskDetails := taskResp.Data.GetValue().(prismConfig.Task)
+	aJSON, _ = json.MarshalIndent(taskDetails, "", "  ")
+	log.Printf("[DEBUG] Create Virtual Switch Task Details: %s", string(aJSON))
+
+	uuid, err := common.ExtractEntityUUIDFromTask(taskDetails, utils.RelEntityTypeVirtualSwitch, "Virtual switch")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(utils.StringValue(uuid))
+	return ResourceNutanixVirtualSwitchV2Read(ctx, d, meta)
+}
+
+func ResourceNutanixVirtualSwitchV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	resp, err := conn.VirtualSwitchAPIInstance.GetVirtualSwitchById(utils.StringPtr(d.Id()), nil)
+	if err != nil {
+		return diag.Errorf("error while fetching virtual switch : %v", err)
+	}
+
+	getResp := resp.Data.GetValue().(import1.VirtualSwitch)
+
+	for key, value := range flattenVirtualSwitchEntity(getResp) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func ResourceNutanixVirtualSwitchV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	readResp, err := conn.VirtualSwitchAPIInstance.GetVirtualSwitchById(utils.StringPtr(d.Id()), nil)
+	if err != nil {
+		return diag.Errorf("error while fetching virtual switch : %v", err)
+	}
+
+	updateSpec := readResp.Data.GetValue().(import1.VirtualSwitch)
+	// Extract E-Tag Header
+	etagValue := conn.VirtualSwitchAPIInstance.ApiClient.GetEtag(readResp)
+
+	args := make(map[string]interface{})
+	args["If-Match"] = utils.StringPtr(etagValue)
+
+	if d.HasChange("name") {
+		updateSpec.Name = utils.StringPtr(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		updateSpec.Description = utils.StringPtr(d.Get("description").(string))
+	}
+	if d.HasChange("bond_mode") {
+		updateSpec.BondMode = common.ExpandEnum[import1.BondModeType](d.Get("bond_mode"))
+	}
+	if d.HasChange("mtu") {
+		updateSpec.Mtu = utils.Int64Ptr(int64(d.Get("mtu").(int)))
+	}
+	if d.HasChange("is_quick_mode") {
+		updateSpec.IsQuickMode = utils.BoolPtr(d.Get("is_quick_mode").(bool))
+	}
+	if d.HasChange("clusters") {
+		updateSpec.Clusters = expandVirtualSwitchClusters(d.Get("clusters").([]interface{}))
+	}
+	// runtime state is read only
+	updateSpec.HasDeploymentError = nil
+	updateSpec.HasUpdateInProgress = nil
+	updateSpec.HasDeleteInProgress = nil
+
+	aJSON, _ := json.MarshalIndent(updateSpec, "", " ")
+	log.Printf("[DEBUG] Virtual Switch update payload : %s", string(aJSON))
+
+	updateResp, err := conn.VirtualSwitchAPIInstance.UpdateVirtualSwitchById(utils.StringPtr(d.Id()), &updateSpec, nil, args)
+	if err != nil {
+		return diag.Errorf("error while updating virtual switch : %v", err)
+	}
+
+	TaskRef := updateResp.Data.GetValue().(import4.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	// calling group API to poll for completion of task
+	taskconn := meta.(*conns.Client).PrismAPI()
+
+	// Wait for the virtual switch to be updated
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutUpdate)); errWaitTask != nil {
+		return diag.Errorf("error waiting for virtual switch (%s) to update: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+	return ResourceNutanixVirtualSwitchV2Read(ctx, d, meta)
+}
+
+func ResourceNutanixVirtualSwitchV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	resp, err := conn.VirtualSwitchAPIInstance.DeleteVirtualSwitchById(utils.StringPtr(d.Id()), nil)
+	if err != nil {
+		return diag.Errorf("error while deleting virtual switch : %v", err)
+	}
+	TaskRef := resp.Data.GetValue().(import4.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	// calling group API to poll for completion of task
+	taskconn := meta.(*conns.Client).PrismAPI()
+
+	// Wait for the virtual switch to be deleted
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutDelete)); errWaitTask != nil {
+		return diag.Errorf("error waiting for virtual switch (%s) to delete: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+	return nil
+}
+
+func expandVirtualSwitchClusters(pr []interface{}) []import1.Cluster {
+	if len(pr) == 0 {
+		return nil
+	}
+
+	clusters := make([]import1.Cluster, len(pr))
+	for k, v := range pr {
+		val := v.(map[string]interface{})
+		cluster := import1.NewCluster()
+
+		cluster.ExtId = utils.StringPtr(val["ext_id"].(string))
+		if vlan, ok := val["vlan_identifier"]; ok && vlan.(int) != 0 {
+			cluster.VlanIdentifier = utils.IntPtr(vlan.(int))
+		}
+		if gateway, ok := val["gateway_ip_address"]; ok && len(gateway.([]interface{})) > 0 {
+			cluster.GatewayIpAddress = expandVirtualSwitchIPv4Address(gateway)
+		}
+		if hosts, ok := val["hosts"]; ok {
+			cluster.Hosts = expandVirtualSwitchHosts(hosts.([]interface{}))
+		}
+
+		clusters[k] = *cluster
+	}
+	return clusters
+}
+
+func expandVirtualSwitchHosts(pr []interface{}) []import1.Host {
+	if len(pr) == 0 {
+		return nil
+	}
+
+	hosts := make([]import1.Host, len(pr))
+	for k, v := range pr {
+		val := v.(map[string]interface{})
+		host := import1.NewHost()
+
+		host.ExtId = utils.StringPtr(val["ext_id"].(string))
+		if hostNics, ok := val["host_nics"]; ok && len(hostNics.([]interface{})) > 0 {
+			host.HostNics = common.ExpandListOfString(hostNics.([]interface{}))
+		}
+		if bridge, ok := val["internal_bridge_name"]; ok && bridge.(string) != "" {
+			host.InternalBridgeName = utils.StringPtr(bridge.(string))
+		}
+		if ipAddress, ok := val["ip_address"]; ok && len(ipAddress.([]interface{})) > 0 {
+			host.IpAddress = expandVirtualSwitchIPv4Subnet(ipAddress)
+		}
+		if routeTable, ok := val["route_table"]; ok && routeTable.(int) != 0 {
+			host.RouteTable = utils.IntPtr(routeTable.(int))
+		}
+
+		hosts[k] = *host
+	}
+	return hosts
+}
+
+func expandVirtualSwitchIPv4Address(pr interface{}) *import3.IPv4Address {
+	prSlice, ok := pr.([]interface{})
+	if !ok || len(prSlice) == 0 || prSlice[0] == nil {
+		return nil
+	}
+	val := prSlice[0].(map[string]interface{})
+
+	ipv4 := import3.NewIPv4Address()
+	if value, ok := val["value"]; ok && value.(string) != "" {
+		ipv4.Value = utils.StringPtr(value.(string))
+	}
+	if prefix, ok := val["prefix_length"]; ok && prefix.(int) != 0 {
+		ipv4.PrefixLength = utils.IntPtr(prefix.(int))
+	}
+	return ipv4
+}
+
+func expandVirtualSwitchIPv4Subnet(pr interface{}) *import1.IPv4Subnet {
+	prSlice, ok := pr.([]interface{})
+	if !ok || len(prSlice) == 0 || prSlice[0] == nil {
+		return nil
+	}
+	val := prSlice[0].(map[string]interface{})
+
+	subnet := import1.NewIPv4Subnet()
+	if ip, ok := val["ip"]; ok {
+		subnet.Ip = expandVirtualSwitchIPv4Address(ip)
+	}
+	if prefix, ok := val["prefix_length"]; ok && prefix.(int) != 0 {
+		subnet.PrefixLength = utils.IntPtr(prefix.(int))
+	}
+	return subnet
+}
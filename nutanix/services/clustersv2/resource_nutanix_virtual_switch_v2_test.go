@@ -0,0 +1,79 @@
+package clustersv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+)
+
+const resourceNameVirtualSwitch = "nutanix_virtual_switch_v2.test"
+
+func TestAccV2NutanixVirtualSwitchResource_Basic(t *testing.T) {
+	if testVars.Clusters.VirtualSwitch.HostNicExtID == "" {
+		t.Skip("clusters.virtual_switch.host_nic_ext_id is not set in the test config")
+	}
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-vs-%d", r%10000)
+	desc := "test virtual switch description"
+	updatedDesc := "updated virtual switch description"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testVirtualSwitchConfig(name, desc, "ACTIVE_BACKUP"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVirtualSwitch, "name", name),
+					resource.TestCheckResourceAttr(resourceNameVirtualSwitch, "description", desc),
+					resource.TestCheckResourceAttr(resourceNameVirtualSwitch, "bond_mode", "ACTIVE_BACKUP"),
+					resource.TestCheckResourceAttr(resourceNameVirtualSwitch, "is_default", "false"),
+					resource.TestCheckResourceAttr(resourceNameVirtualSwitch, "clusters.0.ext_id", testVars.Clusters.VirtualSwitch.ClusterExtID),
+					resource.TestCheckResourceAttr(resourceNameVirtualSwitch, "clusters.0.hosts.0.ext_id", testVars.Clusters.VirtualSwitch.HostExtID),
+					resource.TestCheckResourceAttr(resourceNameVirtualSwitch, "clusters.0.hosts.0.host_nics.0", testVars.Clusters.VirtualSwitch.HostNicExtID),
+					resource.TestCheckResourceAttrPair("data.nutanix_virtual_switch_v2.test", "name", resourceNameVirtualSwitch, "name"),
+					resource.TestCheckResourceAttrSet("data.nutanix_virtual_switches_v2.test", "virtual_switches.#"),
+				),
+			},
+			{
+				Config: testVirtualSwitchConfig(name, updatedDesc, "NONE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVirtualSwitch, "description", updatedDesc),
+					resource.TestCheckResourceAttr(resourceNameVirtualSwitch, "bond_mode", "NONE"),
+				),
+			},
+		},
+	})
+}
+
+func testVirtualSwitchConfig(name, desc, bondMode string) string {
+	return fmt.Sprintf(`
+		resource "nutanix_virtual_switch_v2" "test" {
+			name          = "%[1]s"
+			description   = "%[2]s"
+			bond_mode     = "%[3]s"
+			is_quick_mode = true
+			clusters {
+				ext_id = "%[4]s"
+				hosts {
+					ext_id    = "%[5]s"
+					host_nics = ["%[6]s"]
+				}
+			}
+		}
+
+		data "nutanix_virtual_switch_v2" "test" {
+			ext_id = nutanix_virtual_switch_v2.test.id
+		}
+
+		data "nutanix_virtual_switches_v2" "test" {
+			filter     = "name eq '%[1]s'"
+			depends_on = [nutanix_virtual_switch_v2.test]
+		}
+`, name, desc, bondMode,
+		testVars.Clusters.VirtualSwitch.ClusterExtID,
+		testVars.Clusters.VirtualSwitch.HostExtID,
+		testVars.Clusters.VirtualSwitch.HostNicExtID)
+}
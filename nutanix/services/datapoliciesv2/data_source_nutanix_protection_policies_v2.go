@@ -44,7 +44,7 @@ func DatasourceNutanixProtectionPoliciesV2() *schema.Resource {
 }
 
 func DatasourceNutanixProtectionPoliciesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).DataPoliciesAPI
+	conn := meta.(*conns.Client).DataPoliciesAPI()
 
 	// initialize query params
 	var filter, orderBy, selects *string
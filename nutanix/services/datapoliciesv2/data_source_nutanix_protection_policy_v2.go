@@ -63,7 +63,7 @@ func DatasourceNutanixProtectionPolicyV2() *schema.Resource {
 }
 
 func DatasourceNutanixProtectionPolicyV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).DataPoliciesAPI
+	conn := meta.(*conns.Client).DataPoliciesAPI()
 
 	extID := d.Get("ext_id")
 
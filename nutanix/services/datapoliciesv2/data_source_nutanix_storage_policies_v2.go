@@ -48,7 +48,7 @@ func DataSourceNutanixStoragePoliciesV2() *schema.Resource {
 }
 
 func dataSourceNutanixStoragePoliciesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).DataPoliciesAPI
+	conn := meta.(*conns.Client).DataPoliciesAPI()
 
 	// initialize query params
 	var filter, orderBy, selects *string
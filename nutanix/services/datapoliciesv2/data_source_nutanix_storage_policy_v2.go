@@ -91,7 +91,7 @@ func DataSourceNutanixStoragePolicyV2() *schema.Resource {
 }
 
 func dataSourceNutanixStoragePolicyV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).DataPoliciesAPI
+	conn := meta.(*conns.Client).DataPoliciesAPI()
 	resp, err := conn.StoragePolicies.GetStoragePolicyById(utils.StringPtr(d.Get("ext_id").(string)))
 	if err != nil {
 		return diag.Errorf("error while reading Storage Policy: %v", err)
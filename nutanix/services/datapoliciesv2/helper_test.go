@@ -96,7 +96,7 @@ func checkAttributeLengthEqual(resourceName, attribute string, expectedLength in
 
 func testProtectionPolicyV2CheckDestroy(state *terraform.State) error {
 	conn := acc.TestAccProvider.Meta().(*conns.Client)
-	client := conn.DataPoliciesAPI.ProtectionPolicies
+	client := conn.DataPoliciesAPI().ProtectionPolicies
 
 	for _, rs := range state.RootModule().Resources {
 		if rs.Type == resourceNameProtectionPolicy {
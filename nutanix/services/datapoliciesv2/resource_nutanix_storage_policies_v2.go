@@ -8,7 +8,6 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	import3 "github.com/nutanix/ntnx-api-golang-clients/datapolicies-go-client/v4/models/common/v1/response"
@@ -112,7 +111,7 @@ func ResourceNutanixStoragePoliciesV2() *schema.Resource {
 }
 
 func ResourceNutanixStoragePoliciesV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).DataPoliciesAPI
+	conn := meta.(*conns.Client).DataPoliciesAPI()
 
 	body := &import1.StoragePolicy{}
 
@@ -179,15 +178,9 @@ func ResourceNutanixStoragePoliciesV2Create(ctx context.Context, d *schema.Resou
 	TaskRef := res.Data.GetValue().(import2.TaskReference)
 	taskUUID := TaskRef.ExtId
 
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the storage policy to be created
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING", "PENDING"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutCreate),
-	}
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
 		return diag.Errorf("error waiting for storage policy (%s) to create: %s", utils.StringValue(taskUUID), errWaitTask)
 	}
 	// Get UUID from TASK API
@@ -210,7 +203,7 @@ func ResourceNutanixStoragePoliciesV2Create(ctx context.Context, d *schema.Resou
 }
 
 func ResourceNutanixStoragePoliciesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).DataPoliciesAPI
+	conn := meta.(*conns.Client).DataPoliciesAPI()
 
 	resp, err := conn.StoragePolicies.GetStoragePolicyById(utils.StringPtr(d.Id()))
 	if err != nil {
@@ -224,7 +217,7 @@ func ResourceNutanixStoragePoliciesV2Read(ctx context.Context, d *schema.Resourc
 }
 
 func ResourceNutanixStoragePoliciesV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).DataPoliciesAPI
+	conn := meta.(*conns.Client).DataPoliciesAPI()
 	resp, err := conn.StoragePolicies.GetStoragePolicyById(utils.StringPtr(d.Id()))
 	if err != nil {
 		return diag.Errorf("error while fetching Storage Policy: %v", err)
@@ -270,7 +263,7 @@ func ResourceNutanixStoragePoliciesV2Update(ctx context.Context, d *schema.Resou
 }
 
 func ResourceNutanixStoragePoliciesV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).DataPoliciesAPI
+	conn := meta.(*conns.Client).DataPoliciesAPI()
 
 	// Fetch the e-tag
 	resp, err := conn.StoragePolicies.GetStoragePolicyById(utils.StringPtr(d.Id()))
@@ -378,7 +371,7 @@ func waitForTaskCompletion(ctx context.Context, d *schema.ResourceData, meta int
 	TaskRef := res.(interface{ GetData() interface{} }).GetData().(import2.TaskReference)
 	taskUUID := TaskRef.ExtId
 
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 
 	// Determine timeout based on operation
 	var timeout time.Duration
@@ -392,13 +385,7 @@ func waitForTaskCompletion(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	// Wait for the storage policy to be updated/deleted
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING", "PENDING"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: timeout,
-	}
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), timeout); errWaitTask != nil {
 		return diag.Errorf("error waiting for storage policy (%s) to %s: %s", utils.StringValue(taskUUID), operation, errWaitTask)
 	}
 	// Get UUID from TASK API
@@ -244,7 +244,7 @@ func SchemaForDiskRecoveryPoints() *schema.Schema {
 func DatasourceNutanixRecoveryPointV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] DatasourceNutanixRecoveryPointV2Read \n")
 
-	conn := meta.(*conns.Client).DataProtectionAPI
+	conn := meta.(*conns.Client).DataProtectionAPI()
 
 	recoveryPointExtID := d.Get("ext_id").(string)
 
@@ -54,7 +54,7 @@ func DatasourceNutanixRecoveryPointsV2() *schema.Resource {
 }
 
 func DatasourceNutanixRecoveryPointsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).DataProtectionAPI
+	conn := meta.(*conns.Client).DataProtectionAPI()
 
 	// initialize query params
 	var filter, orderBy, selectQ *string
@@ -87,7 +87,7 @@ func DatasourceNutanixVMRecoveryPointInfoV2() *schema.Resource {
 func DatasourceNutanixVMRecoveryPointInfoV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] DatasourceNutanixVMRecoveryPointInfoV2Read \n")
 
-	conn := meta.(*conns.Client).DataProtectionAPI
+	conn := meta.(*conns.Client).DataProtectionAPI()
 
 	recoveryPointExtID := d.Get("recovery_point_ext_id").(string)
 	extID := d.Get("ext_id").(string)
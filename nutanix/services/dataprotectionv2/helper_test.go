@@ -18,7 +18,7 @@ func waitForVMToBeProtected(resourceName, attributeName, desiredValue string, ma
 	return func(s *terraform.State) error {
 		var lastValue string
 		conn := acc.TestAccProvider.Meta().(*conns.Client)
-		client := conn.VmmAPI.VMAPIInstance
+		client := conn.VmmAPI().VMAPIInstance
 
 		for i := 0; i < maxRetries; i++ {
 			rs, ok := s.RootModule().Resources[resourceName]
@@ -51,9 +51,9 @@ func waitForVMToBeProtected(resourceName, attributeName, desiredValue string, ma
 
 func testCheckDestroyProtectedResourceAndCleanup(state *terraform.State) error {
 	conn := acc.TestAccProvider.Meta().(*conns.Client)
-	vmClient := conn.VmmAPI.VMAPIInstance
-	categoryClient := conn.PrismAPI.CategoriesAPIInstance
-	ppClient := conn.DataPoliciesAPI.ProtectionPolicies
+	vmClient := conn.VmmAPI().VMAPIInstance
+	categoryClient := conn.PrismAPI().CategoriesAPIInstance
+	ppClient := conn.DataPoliciesAPI().ProtectionPolicies
 
 	vmExtID := ""
 	ppExtID := ""
@@ -132,7 +132,7 @@ func testCheckDestroyProtectedResourceAndCleanupForPromoteVM(state *terraform.St
 			vmExtID := rs.Primary.Attributes["vms.0.ext_id"]
 
 			connRemote := acc.TestAccProvider2.Meta().(*conns.Client)
-			client := connRemote.VmmAPI.VMAPIInstance
+			client := connRemote.VmmAPI().VMAPIInstance
 
 			readResp, err := client.GetVmById(utils.StringPtr(vmExtID))
 
@@ -158,7 +158,7 @@ func testCheckDestroyProtectedResourceAndCleanupForPromoteVM(state *terraform.St
 func deleteRestoredVM(vmName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acc.TestAccProvider2.Meta().(*conns.Client)
-		client := conn.VmmAPI.VMAPIInstance
+		client := conn.VmmAPI().VMAPIInstance
 
 		filter := fmt.Sprintf("startswith(name, '%s')", vmName)
 
@@ -193,7 +193,7 @@ func deleteRestoredVM(vmName string) resource.TestCheckFunc {
 func deleteRestoredVg(vgName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acc.TestAccProvider2.Meta().(*conns.Client)
-		client := conn.VolumeAPI.VolumeAPIInstance
+		client := conn.VolumeAPI().VolumeAPIInstance
 		filter := fmt.Sprintf("startswith(name, '%s')", vgName)
 
 		resp, err := client.ListVolumeGroups(nil, nil, utils.StringPtr(filter), nil, nil, nil)
@@ -6,7 +6,6 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dataprotectionPrismConfig "github.com/nutanix/ntnx-api-golang-clients/dataprotection-go-client/v4/models/prism/v4/config"
 	prismConfig "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
@@ -34,7 +33,7 @@ func ResourceNutanixPromoteProtectedResourceV2() *schema.Resource {
 // This Resource is action resource and does not have any state
 // resource id is set to random UUID
 func ResourceNutanixPromoteProtectedResourceV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).DataProtectionAPI
+	conn := meta.(*conns.Client).DataProtectionAPI()
 
 	extID := d.Get("ext_id").(string)
 
@@ -46,16 +45,9 @@ func ResourceNutanixPromoteProtectedResourceV2Create(ctx context.Context, d *sch
 	taskRef := resp.Data.GetValue().(dataprotectionPrismConfig.TaskReference)
 	taskUUID := taskRef.ExtId
 
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the promote protected resource operation to complete
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutCreate),
-	}
-
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.Errorf("error waiting for promote protected resource task (%s) to complete: %s", utils.StringValue(taskUUID), err)
 	}
@@ -107,7 +107,7 @@ func DataSourceFoundationDiscoverNodes() *schema.Resource {
 // dataSourceFoundationDiscoverNodesRead performs get operation on /discover_nodes api and sets it to resource data schema appropriately
 func dataSourceFoundationDiscoverNodesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// create foundation client connection
-	conn := meta.(*conns.Client).FoundationClientAPI
+	conn := meta.(*conns.Client).FoundationClientAPI()
 
 	resp, err := conn.Networking.DiscoverNodes(ctx)
 	if err != nil {
@@ -25,7 +25,7 @@ func DataSourceFoundationHypervisorIsos() *schema.Resource {
 
 func dataSourceFoundationHypervisorIsosRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get the client connection
-	conn := meta.(*conns.Client).FoundationClientAPI
+	conn := meta.(*conns.Client).FoundationClientAPI()
 
 	resp, err := conn.FileManagement.ListHypervisorISOs(ctx)
 	if err != nil {
@@ -97,7 +97,7 @@ func DataSourceNodeNetworkDetails() *schema.Resource {
 // dataSourceNodeNetworkDetailsRead will get the node network details and set to schema appropriately
 func dataSourceNodeNetworkDetailsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// get foundation client api
-	conn := meta.(*conns.Client).FoundationClientAPI
+	conn := meta.(*conns.Client).FoundationClientAPI()
 
 	v, ok := d.GetOk("ipv6_addresses")
 	if !ok && len(v.([]interface{})) == 0 {
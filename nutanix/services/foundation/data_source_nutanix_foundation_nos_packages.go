@@ -30,7 +30,7 @@ func DataSourceFoundationNOSPackages() *schema.Resource {
 // dataSourceFoundationNOSPackagesRead method to perform read operation on /enumerate_nos_packages api
 func dataSourceFoundationNOSPackagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// get Foundation api client
-	conn := meta.(*conns.Client).FoundationClientAPI
+	conn := meta.(*conns.Client).FoundationClientAPI()
 
 	var resp *foundation.ListNOSPackagesResponse
 	resp, err := conn.FileManagement.ListNOSPackages(ctx)
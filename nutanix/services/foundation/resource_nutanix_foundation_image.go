@@ -52,7 +52,7 @@ func ResourceNutanixFoundationImage() *schema.Resource {
 // resourceNutanixFoundationImageCreate creates a image as per installer type, filename and source path
 func resourceNutanixFoundationImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// create connection
-	conn := meta.(*conns.Client).FoundationClientAPI
+	conn := meta.(*conns.Client).FoundationClientAPI()
 
 	fileName, ok := d.GetOk("filename")
 	if !ok {
@@ -87,7 +87,7 @@ func resourceNutanixFoundationImageRead(ctx context.Context, d *schema.ResourceD
 // resourceNutanixFoundationImageDelete deletes the existing image
 func resourceNutanixFoundationImageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// create foundation client
-	conn := meta.(*conns.Client).FoundationClientAPI
+	conn := meta.(*conns.Client).FoundationClientAPI()
 	installerType := d.Get("installer_type").(string)
 	fileName := d.Get("filename").(string)
 
@@ -751,7 +751,7 @@ func ResourceFoundationImageNodes() *schema.Resource {
 
 func resourceFoundationImageNodesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// create connection
-	conn := meta.(*conns.Client).FoundationClientAPI
+	conn := meta.(*conns.Client).FoundationClientAPI()
 	// Prepare request
 	request := &foundation.ImageNodesInput{}
 	xsmasterlabel, ok := d.GetOk("xs_master_label")
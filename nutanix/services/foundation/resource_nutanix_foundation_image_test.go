@@ -25,7 +25,7 @@ func GetResourceState(s *terraform.State, key string) map[string]string {
 
 func testAccCheckNosImageExists(filename string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acc.TestAccProvider.Meta().(*conns.Client).FoundationClientAPI
+		conn := acc.TestAccProvider.Meta().(*conns.Client).FoundationClientAPI()
 		ctx := context.TODO()
 		resp, err := conn.FileManagement.ListNOSPackages(ctx)
 		if err != nil {
@@ -43,7 +43,7 @@ func testAccCheckNosImageExists(filename string) resource.TestCheckFunc {
 
 func testAccCheckNosImageDestroy(filename string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acc.TestAccProvider.Meta().(*conns.Client).FoundationClientAPI
+		conn := acc.TestAccProvider.Meta().(*conns.Client).FoundationClientAPI()
 		ctx := context.TODO()
 		resp, err := conn.FileManagement.ListNOSPackages(ctx)
 		if err != nil {
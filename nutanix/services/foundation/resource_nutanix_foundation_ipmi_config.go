@@ -88,7 +88,7 @@ func ResourceNutanixFoundationIPMIConfig() *schema.Resource {
 
 func resourceFoundationIPMIConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// get foundation client
-	conn := meta.(*conns.Client).FoundationClientAPI
+	conn := meta.(*conns.Client).FoundationClientAPI()
 
 	//api input spec
 	inpSpec := &foundation.IPMIConfigAPIInput{}
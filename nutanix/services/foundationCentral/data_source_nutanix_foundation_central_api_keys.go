@@ -38,7 +38,7 @@ func DataSourceNutanixFCAPIKeys() *schema.Resource {
 }
 
 func dataSourceNutanixFCAPIKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).FoundationCentral
+	conn := meta.(*conns.Client).FoundationCentral()
 
 	if uuid, uuidok := d.GetOk("key_uuid"); uuidok {
 		resp, err := conn.Service.GetAPIKey(ctx, uuid.(string))
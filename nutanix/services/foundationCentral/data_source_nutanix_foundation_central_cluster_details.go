@@ -404,7 +404,7 @@ func DataSourceNutanixFCClusterDetails() *schema.Resource {
 }
 
 func dataSourceNutanixFCClusterDetailsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).FoundationCentral
+	conn := meta.(*conns.Client).FoundationCentral()
 	req := foundation_central.CreateClusterResponse{}
 
 	clusteruuid, ok := d.GetOk("imaged_cluster_uuid")
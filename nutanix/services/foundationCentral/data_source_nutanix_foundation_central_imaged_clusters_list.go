@@ -456,7 +456,7 @@ func DataSourceNutanixFCImagedClustersList() *schema.Resource {
 }
 
 func dataSourceNutanixFCImagedClustersListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).FoundationCentral
+	conn := meta.(*conns.Client).FoundationCentral()
 
 	req := &fc.ImagedClustersListInput{}
 	if len, lenok := d.GetOk("length"); lenok {
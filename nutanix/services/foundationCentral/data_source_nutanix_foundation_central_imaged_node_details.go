@@ -161,7 +161,7 @@ func DataSourceFCImagedNodeDetails() *schema.Resource {
 }
 
 func dataSourceFCImagedNodeDetailsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).FoundationCentral
+	conn := meta.(*conns.Client).FoundationCentral()
 	req := fc.ImagedNodeDetailsInput{}
 
 	nodeUUID, ok := d.GetOk("imaged_node_uuid")
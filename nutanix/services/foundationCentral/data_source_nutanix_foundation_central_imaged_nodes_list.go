@@ -217,7 +217,7 @@ func DataSourceNutanixFCImagedNodesList() *schema.Resource {
 }
 
 func dataSourceNutanixFCImagedNodesListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).FoundationCentral
+	conn := meta.(*conns.Client).FoundationCentral()
 	req := fc.ImagedNodesListInput{}
 
 	if len, lenok := d.GetOk("length"); lenok {
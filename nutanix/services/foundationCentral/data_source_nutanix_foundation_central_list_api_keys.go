@@ -78,7 +78,7 @@ func DataSourceNutanixFCListAPIKeys() *schema.Resource {
 }
 
 func dataSourceNutanixFCListAPIKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).FoundationCentral
+	conn := meta.(*conns.Client).FoundationCentral()
 
 	req := &fc.ListMetadataInput{}
 	length, lok := d.GetOk("length")
@@ -43,7 +43,7 @@ func ResourceNutanixFCAPIKeys() *schema.Resource {
 }
 
 func resourceNutanixFCAPIKeysCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).FoundationCentral
+	conn := meta.(*conns.Client).FoundationCentral()
 	req := &fc.CreateAPIKeysInput{}
 
 	alias, ok := d.GetOk("alias")
@@ -61,7 +61,7 @@ func resourceNutanixFCAPIKeysCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceNutanixFCAPIKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).FoundationCentral
+	conn := meta.(*conns.Client).FoundationCentral()
 	resp, err := conn.Service.GetAPIKey(ctx, d.Id())
 	if err != nil {
 		if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
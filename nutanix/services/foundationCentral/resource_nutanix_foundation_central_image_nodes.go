@@ -616,7 +616,7 @@ func ResourceNutanixFCImageCluster() *schema.Resource {
 }
 
 func resourceNutanixFCImageClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).FoundationCentral
+	conn := meta.(*conns.Client).FoundationCentral()
 	resp, err := conn.Service.GetImagedCluster(ctx, d.Id())
 	if err != nil {
 		diag.FromErr(err)
@@ -829,7 +829,7 @@ func expandNodesList(d *schema.ResourceData) []*fc.Node {
 
 func resourceNutanixFCImageClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
-	conn := meta.(*conns.Client).FoundationCentral
+	conn := meta.(*conns.Client).FoundationCentral()
 	req := fc.CreateClusterInput{}
 
 	clusterExternalIP, ok := d.GetOk("cluster_external_ip")
@@ -976,7 +976,7 @@ func resourceNutanixFCImageClusterUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceNutanixFCImageClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).FoundationCentral
+	conn := meta.(*conns.Client).FoundationCentral()
 	log.Printf("[DEBUG] Deleting Cluster: %s, %s", d.Get("cluster_name").(string), d.Id())
 	err := conn.Service.DeleteCluster(ctx, d.Id())
 	if err != nil {
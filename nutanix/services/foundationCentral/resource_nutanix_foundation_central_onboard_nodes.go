@@ -53,7 +53,7 @@ func ResourceNutanixFCOnboardNodes() *schema.Resource {
 }
 
 func resourceNutanixFCOnboardNodesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).FoundationCentral
+	conn := meta.(*conns.Client).FoundationCentral()
 	resp, err := conn.Service.GetImagedNode(ctx, d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -90,7 +90,7 @@ func resourceNutanixFCOnboardNodesCreate(ctx context.Context, d *schema.Resource
 	serial := d.Get("node_serial")
 
 	// Get client connection
-	conn := meta.(*conns.Client).FoundationCentral
+	conn := meta.(*conns.Client).FoundationCentral()
 	hwManagers, err := conn.Service.ListHardwareManagers(ctx)
 	if err != nil {
 		return diag.FromErr(err)
@@ -134,7 +134,7 @@ func resourceNutanixFCOnboardNodesUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceNutanixFCOnboardNodesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).FoundationCentral
+	conn := meta.(*conns.Client).FoundationCentral()
 	log.Printf("[DEBUG] Deleting onboarded node: %s, %s", d.Get("node_serial").(string), d.Id())
 	err := conn.Service.DeleteOnboardNode(ctx, d.Id())
 	if err != nil {
@@ -282,7 +282,7 @@ func DataSourceNutanixAccessControlPolicies() *schema.Resource {
 
 func dataSourceNutanixAccessControlPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 	req := &prism.DSMetadata{}
 
 	metadata, filtersOk := d.GetOk("metadata")
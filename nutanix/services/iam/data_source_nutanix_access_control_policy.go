@@ -237,7 +237,7 @@ func DataSourceNutanixAccessControlPolicy() *schema.Resource {
 
 func dataSourceNutanixAccessControlPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	id, iok := d.GetOk("access_control_policy_id")
 	name, nOk := d.GetOk("access_control_policy_name")
@@ -96,7 +96,7 @@ func DataSourceNutanixPermission() *schema.Resource {
 
 func dataSourceNutanixPermissionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	permissionID, iok := d.GetOk("permission_id")
 	permissionName, rnOk := d.GetOk("permission_name")
@@ -139,7 +139,7 @@ func dataSourceNutanixPermissionsRead(ctx context.Context, d *schema.ResourceDat
 	log.Printf("[DEBUG] Reading Permissions: %s", d.Id())
 
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	resp, err := conn.V3.ListAllPermission("")
 	if err != nil {
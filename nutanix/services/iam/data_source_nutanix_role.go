@@ -89,7 +89,7 @@ func DataSourceNutanixRole() *schema.Resource {
 
 func dataSourceNutanixRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	accessID, iok := d.GetOk("role_id")
 	roleName, rnOk := d.GetOk("role_name")
@@ -134,7 +134,7 @@ func DataSourceNutanixRoles() *schema.Resource {
 
 func dataSourceNutanixRolesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	req := &v3.DSMetadata{}
 
@@ -183,7 +183,7 @@ func dataSourceNutanixUserRead(ctx context.Context, d *schema.ResourceData, meta
 	log.Printf("[DEBUG] Reading User: %s", d.Id())
 
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	uuid, iok := d.GetOk("user_id")
 	name, nok := d.GetOk("user_name")
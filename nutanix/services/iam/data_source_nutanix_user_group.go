@@ -155,7 +155,7 @@ func dataSourceNutanixUserGroupRead(ctx context.Context, d *schema.ResourceData,
 	log.Printf("[DEBUG] Reading Group: %s", d.Id())
 
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	uuid, iok := d.GetOk("user_group_id")
 	name, nok := d.GetOk("user_group_name")
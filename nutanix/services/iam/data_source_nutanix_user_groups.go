@@ -190,7 +190,7 @@ func dataSourceNutanixUserGroupsRead(ctx context.Context, d *schema.ResourceData
 	log.Printf("[DEBUG] Reading User Groups: %s", d.Id())
 
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	req := &v3.DSMetadata{}
 
@@ -227,7 +227,7 @@ func dataSourceNutanixUsersRead(ctx context.Context, d *schema.ResourceData, met
 	log.Printf("[DEBUG] Reading User: %s", d.Id())
 
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	req := &v3.DSMetadata{}
 
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/spf13/cast"
 	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
 	v3 "github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v3/prism"
 	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
@@ -22,6 +23,7 @@ func ResourceNutanixRole() *schema.Resource {
 		ReadContext:   resourceNutanixRoleRead,
 		UpdateContext: resourceNutanixRoleUpdate,
 		DeleteContext: resourceNutanixRoleDelete,
+		CustomizeDiff: common.DefaultCategoriesDiff("categories"),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -135,7 +137,7 @@ func ResourceNutanixRole() *schema.Resource {
 }
 
 func resourceNutanixRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	request := &v3.Role{}
 	spec := &v3.RoleSpec{}
@@ -198,7 +200,7 @@ func resourceNutanixRoleCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceNutanixRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	id := d.Id()
 	resp, err := conn.V3.GetRole(id)
@@ -253,7 +255,7 @@ func resourceNutanixRoleRead(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceNutanixRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	request := &v3.Role{}
 	metadata := &v3.Metadata{}
@@ -333,7 +335,7 @@ func resourceNutanixRoleUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceNutanixRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	resp, err := conn.V3.DeleteRole(d.Id())
 	if err != nil {
@@ -117,7 +117,7 @@ func testAccCheckNutanixRoleDestroy(s *terraform.State) error {
 		if rs.Type != "nutanix_role" {
 			continue
 		}
-		if _, err := resourceNutanixRoleExists(conn.API, rs.Primary.ID); err != nil {
+		if _, err := resourceNutanixRoleExists(conn.API(), rs.Primary.ID); err != nil {
 			if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
 				return nil
 			}
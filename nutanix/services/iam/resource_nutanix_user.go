@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
 	v3 "github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v3/prism"
 	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
@@ -32,6 +33,7 @@ func ResourceNutanixUser() *schema.Resource {
 		ReadContext:   resourceNutanixUserRead,
 		UpdateContext: resourceNutanixUserUpdate,
 		DeleteContext: resourceNutanixUserDelete,
+		CustomizeDiff: common.DefaultCategoriesDiff("categories"),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -212,7 +214,7 @@ func ResourceNutanixUser() *schema.Resource {
 
 func resourceNutanixUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Creating User: %s", d.Get("name").(string))
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	request := &v3.UserIntentInput{}
 
@@ -269,7 +271,7 @@ func resourceNutanixUserRead(ctx context.Context, d *schema.ResourceData, meta i
 	log.Printf("[DEBUG] Reading User: %s", d.Id())
 
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 	uuid := d.Id()
 
 	// Make request to the API
@@ -333,7 +335,7 @@ func resourceNutanixUserRead(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceNutanixUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	// get state
 	request := &v3.UserIntentInput{}
@@ -420,7 +422,7 @@ func resourceNutanixUserUpdate(ctx context.Context, d *schema.ResourceData, meta
 func resourceNutanixUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Deleting User: %s", d.Get("display_name").(string))
 
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	UUID := d.Id()
 
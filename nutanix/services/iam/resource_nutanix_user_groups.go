@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
 	v3 "github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v3/prism"
 	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
@@ -26,6 +27,7 @@ func ResourceNutanixUserGroups() *schema.Resource {
 		ReadContext:   resourceNutanixUserGroupsRead,
 		UpdateContext: resourceNutanixUserGroupsUpdate,
 		DeleteContext: resourceNutanixUserGroupsDelete,
+		CustomizeDiff: common.DefaultCategoriesDiff("categories"),
 		Schema: map[string]*schema.Schema{
 			"directory_service_user_group": {
 				Type:          schema.TypeList,
@@ -101,7 +103,7 @@ func ResourceNutanixUserGroups() *schema.Resource {
 }
 
 func resourceNutanixUserGroupsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	request := &v3.UserGroupIntentInput{}
 	spec := &v3.UserGroupSpec{}
@@ -156,7 +158,7 @@ func resourceNutanixUserGroupsCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceNutanixUserGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	resp, err := conn.V3.GetUserGroup(d.Id())
 	if err != nil {
@@ -197,7 +199,7 @@ func resourceNutanixUserGroupsRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceNutanixUserGroupsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	request := &v3.UserGroupIntentInput{}
 	spec := &v3.UserGroupSpec{}
@@ -280,7 +282,7 @@ func resourceNutanixUserGroupsUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceNutanixUserGroupsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 	log.Printf("[DEBUG] Deleting User Group: %s", d.Id())
 	resp, err := conn.V3.DeleteUserGroup(ctx, d.Id())
 	if err != nil {
@@ -74,7 +74,7 @@ func testAccCheckNutanixUserGroupsDestroy(s *terraform.State) error {
 		if rs.Type != "nutanix_user_groups" {
 			continue
 		}
-		if _, err := conn.API.V3.GetUser(rs.Primary.ID); err != nil {
+		if _, err := conn.API().V3.GetUser(rs.Primary.ID); err != nil {
 			if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
 				return nil
 			}
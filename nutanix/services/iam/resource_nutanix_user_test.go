@@ -79,7 +79,7 @@ func testAccCheckNutanixUserDestroy(s *terraform.State) error {
 		if rs.Type != "nutanix_user" {
 			continue
 		}
-		if _, err := conn.API.V3.GetUser(rs.Primary.ID); err != nil {
+		if _, err := conn.API().V3.GetUser(rs.Primary.ID); err != nil {
 			if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
 				return nil
 			}
@@ -119,7 +119,7 @@ func DatasourceNutanixAuthorizationPoliciesV2() *schema.Resource {
 }
 
 func DatasourceNutanixAuthorizationPoliciesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 
 	// initialize query params
 	var filter, orderBy, selects, expand *string
@@ -89,7 +89,7 @@ func DatasourceNutanixAuthorizationPolicyV2() *schema.Resource {
 
 func DatasourceNutanixAuthorizationPolicyV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] DatasourceNutanixAuthorizationPolicyV2Read \n")
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 
 	extID := d.Get("ext_id")
 	resp, err := conn.AuthAPIInstance.GetAuthorizationPolicyById(utils.StringPtr(extID.(string)))
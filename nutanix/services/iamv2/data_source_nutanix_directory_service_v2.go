@@ -138,7 +138,7 @@ func DatasourceNutanixDirectoryServiceV2() *schema.Resource {
 }
 
 func DatasourceNutanixDirectoryServiceV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 
 	extID := d.Get("ext_id")
 	resp, err := conn.DirectoryServiceAPIInstance.GetDirectoryServiceById(utils.StringPtr(extID.(string)))
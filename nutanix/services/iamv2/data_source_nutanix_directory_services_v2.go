@@ -168,7 +168,7 @@ func DatasourceNutanixDirectoryServicesV2() *schema.Resource {
 }
 
 func DatasourceNutanixDirectoryServicesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 
 	// initialize query params
 	var filter, orderBy, selects *string
@@ -51,7 +51,7 @@ func DatasourceNutanixEntitiesV2() *schema.Resource {
 }
 
 func DatasourceNutanixEntitiesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 
 	var page, limit *int
 	var filter, orderBy, selectParam *string
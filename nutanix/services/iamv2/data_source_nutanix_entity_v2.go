@@ -148,7 +148,7 @@ func DatasourceNutanixEntityV2() *schema.Resource {
 }
 
 func DatasourceNutanixEntityV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 
 	extID := d.Get("ext_id").(string)
 
@@ -78,7 +78,7 @@ func DatasourceNutanixOperationV2() *schema.Resource {
 }
 
 func DatasourceNutanixOperationV4Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 
 	extID := d.Get("ext_id")
 
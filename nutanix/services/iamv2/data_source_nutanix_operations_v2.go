@@ -107,7 +107,7 @@ func DatasourceNutanixOperationsV2() *schema.Resource {
 }
 
 func DatasourceNutanixOperationsV4Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 
 	// initialize query params
 	var filter, orderBy, selects *string
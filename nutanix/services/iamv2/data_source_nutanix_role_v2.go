@@ -120,7 +120,7 @@ func DatasourceNutanixRoleV2() *schema.Resource {
 }
 
 func DatasourceNutanixRoleV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 
 	roleExtID := d.Get("ext_id").(string)
 
@@ -151,7 +151,7 @@ func DatasourceNutanixRolesV2() *schema.Resource {
 }
 
 func DatasourceNutanixRolesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 
 	// initialize query params
 	var filter, orderBy, selects *string
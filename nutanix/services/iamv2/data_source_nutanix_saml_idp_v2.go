@@ -103,7 +103,7 @@ func DatasourceNutanixSamlIDPV2() *schema.Resource {
 }
 
 func DatasourceNutanixSamlIDPV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 
 	extID := d.Get("ext_id")
 
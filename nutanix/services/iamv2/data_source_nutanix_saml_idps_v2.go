@@ -133,7 +133,7 @@ func DatasourceNutanixSamlIDPsV2() *schema.Resource {
 }
 
 func DatasourceNutanixSamlIDPsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 
 	// initialize query params
 	var filter, orderBy, selects *string
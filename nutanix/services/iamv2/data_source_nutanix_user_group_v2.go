@@ -57,7 +57,7 @@ func DatasourceNutanixUserGroupV2() *schema.Resource {
 }
 
 func DatasourceNutanixUserGroupV4Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 
 	extID := d.Get("ext_id")
 	resp, err := conn.UserGroupsAPIInstance.GetUserGroupById(utils.StringPtr(extID.(string)))
@@ -117,7 +117,7 @@ func DatasourceNutanixUserKeyV2() *schema.Resource {
 
 func dataSourceNutanixUserKeyV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 
 	var userExtID *string
 	if v, ok := d.GetOk("user_ext_id"); ok {
@@ -52,7 +52,7 @@ func DatasourceNutanixUserKeysV2() *schema.Resource {
 
 func DataSourceNutanixUserKeysV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 	var userExtID *string
 	if v, ok := d.GetOk("user_ext_id"); ok {
 		userExtID = utils.StringPtr(v.(string))
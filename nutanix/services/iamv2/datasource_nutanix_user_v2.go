@@ -173,7 +173,7 @@ func DatasourceNutanixUserV2() *schema.Resource {
 }
 
 func datasourceNutanixUserV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 
 	extID := d.Get("ext_id")
 
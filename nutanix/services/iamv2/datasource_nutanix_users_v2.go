@@ -204,7 +204,7 @@ func DatasourceNutanixUsersV2() *schema.Resource {
 }
 
 func datasourceNutanixUsersV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 
 	// initialize query params
 	var filter, orderBy, selects *string
@@ -49,13 +49,13 @@ func testAccCheckNutanixUserDestroy(s *terraform.State) error {
 			continue
 		}
 
-		if _, err := conn.API.V3.GetUser(rs.Primary.ID); err != nil {
+		if _, err := conn.API().V3.GetUser(rs.Primary.ID); err != nil {
 			if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
 				return nil
 			}
 			return err
 		}
-		_, err := conn.API.V3.DeleteUser(rs.Primary.ID)
+		_, err := conn.API().V3.DeleteUser(rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -72,7 +72,7 @@ func testAccCheckNutanixDirectoryServicesV2Destroy(s *terraform.State) error {
 			continue
 		}
 		fmt.Printf("Checking directory service : %s", rs.Primary.ID)
-		readResp, errRead := conn.IamAPI.DirectoryServiceAPIInstance.GetDirectoryServiceById(utils.StringPtr(rs.Primary.ID))
+		readResp, errRead := conn.IamAPI().DirectoryServiceAPIInstance.GetDirectoryServiceById(utils.StringPtr(rs.Primary.ID))
 		if errRead != nil {
 			if strings.Contains(fmt.Sprint(errRead), "Directory service not found") {
 				return nil
@@ -80,13 +80,13 @@ func testAccCheckNutanixDirectoryServicesV2Destroy(s *terraform.State) error {
 			return errRead
 		}
 		// get etag value from read response to pass in update request If-Match header, Required for update request
-		etagValue := conn.IamAPI.DirectoryServiceAPIInstance.ApiClient.GetEtag(readResp)
+		etagValue := conn.IamAPI().DirectoryServiceAPIInstance.ApiClient.GetEtag(readResp)
 		headers := make(map[string]interface{})
 		headers["If-Match"] = utils.StringPtr(etagValue)
 
 		fmt.Println("Deleting directory service")
 
-		if _, err := conn.IamAPI.DirectoryServiceAPIInstance.DeleteDirectoryServiceById(utils.StringPtr(rs.Primary.ID), headers); err != nil {
+		if _, err := conn.IamAPI().DirectoryServiceAPIInstance.DeleteDirectoryServiceById(utils.StringPtr(rs.Primary.ID), headers); err != nil {
 			if strings.Contains(fmt.Sprint(err), "Directory service not found") {
 				return nil
 			}
@@ -104,7 +104,7 @@ func testAccCheckNutanixUserGroupsV2Destroy(s *terraform.State) error {
 			continue
 		}
 
-		readResp, errRead := conn.IamAPI.UserGroupsAPIInstance.GetUserGroupById(utils.StringPtr(rs.Primary.ID))
+		readResp, errRead := conn.IamAPI().UserGroupsAPIInstance.GetUserGroupById(utils.StringPtr(rs.Primary.ID))
 		if errRead != nil {
 			if strings.Contains(fmt.Sprint(errRead), "the requested user group does not exist") {
 				return nil
@@ -113,11 +113,11 @@ func testAccCheckNutanixUserGroupsV2Destroy(s *terraform.State) error {
 		}
 
 		// get etag value from read response to pass in update request If-Match header, Required for update request
-		etagValue := conn.IamAPI.DirectoryServiceAPIInstance.ApiClient.GetEtag(readResp)
+		etagValue := conn.IamAPI().DirectoryServiceAPIInstance.ApiClient.GetEtag(readResp)
 		headers := make(map[string]interface{})
 		headers["If-Match"] = utils.StringPtr(etagValue)
 
-		if _, err := conn.IamAPI.UserGroupsAPIInstance.DeleteUserGroupById(utils.StringPtr(rs.Primary.ID), headers); err != nil {
+		if _, err := conn.IamAPI().UserGroupsAPIInstance.DeleteUserGroupById(utils.StringPtr(rs.Primary.ID), headers); err != nil {
 			if strings.Contains(fmt.Sprint(err), "the requested user group does not exist") {
 				return nil
 			}
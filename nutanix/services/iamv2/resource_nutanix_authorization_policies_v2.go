@@ -133,7 +133,7 @@ func ResourceNutanixAuthPoliciesV2() *schema.Resource {
 
 func ResourceNutanixAuthPoliciesV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Creating Authorization Policy")
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 	input := &import1.AuthorizationPolicy{}
 	log.Printf("[DEBUG] Creating Authorization Policy")
 
@@ -192,7 +192,7 @@ func ResourceNutanixAuthPoliciesV2Create(ctx context.Context, d *schema.Resource
 
 func ResourceNutanixAuthPoliciesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Reading Authorization Policy")
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 
 	resp, err := conn.AuthAPIInstance.GetAuthorizationPolicyById(utils.StringPtr(d.Id()))
 	if err != nil {
@@ -247,7 +247,7 @@ func ResourceNutanixAuthPoliciesV2Read(ctx context.Context, d *schema.ResourceDa
 
 func ResourceNutanixAuthPoliciesV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Updating Authorization Policy")
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 	updatedSpec := import1.AuthorizationPolicy{}
 
 	resp, err := conn.AuthAPIInstance.GetAuthorizationPolicyById(utils.StringPtr(d.Id()))
@@ -314,7 +314,7 @@ func ResourceNutanixAuthPoliciesV2Update(ctx context.Context, d *schema.Resource
 
 func ResourceNutanixAuthPoliciesV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Deleting Authorization Policy")
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 
 	readResp, err := conn.AuthAPIInstance.GetAuthorizationPolicyById(utils.StringPtr(d.Id()))
 	if err != nil {
@@ -127,7 +127,7 @@ func ResourceNutanixRolesV2() *schema.Resource {
 }
 
 func ResourceNutanixRolesV4Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 	body := &iamConfig.Role{}
 
 	if extID, ok := d.GetOk("ext_id"); ok {
@@ -162,7 +162,7 @@ func ResourceNutanixRolesV4Create(ctx context.Context, d *schema.ResourceData, m
 }
 
 func ResourceNutanixRolesV4Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 
 	resp, err := conn.RolesAPIInstance.GetRoleById(utils.StringPtr(d.Id()))
 	if err != nil {
@@ -261,7 +261,7 @@ func ResourceNutanixRolesV4Read(ctx context.Context, d *schema.ResourceData, met
 }
 
 func ResourceNutanixRolesV4Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 
 	extID := utils.StringPtr(d.Id())
 
@@ -312,7 +312,7 @@ func ResourceNutanixRolesV4Update(ctx context.Context, d *schema.ResourceData, m
 }
 
 func ResourceNutanixRolesV4Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 
 	readResp, err := conn.RolesAPIInstance.GetRoleById(utils.StringPtr(d.Id()))
 	if err != nil {
@@ -77,7 +77,7 @@ func ResourceNutanixUserGroupsV2() *schema.Resource {
 }
 
 func ResourceNutanixUserGroupsV4Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 	input := &import1.UserGroup{}
 
 	if gType, ok := d.GetOk("group_type"); ok {
@@ -119,7 +119,7 @@ func ResourceNutanixUserGroupsV4Create(ctx context.Context, d *schema.ResourceDa
 }
 
 func ResourceNutanixUserGroupsV4Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 	resp, err := conn.UserGroupsAPIInstance.GetUserGroupById(utils.StringPtr(d.Id()))
 	if err != nil {
 		return diag.Errorf("error while fetching user groups: %v", err)
@@ -164,7 +164,7 @@ func ResourceNutanixUserGroupsV4Update(ctx context.Context, d *schema.ResourceDa
 }
 
 func ResourceNutanixUserGroupsV4Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 
 	readResp, err := conn.UserGroupsAPIInstance.GetUserGroupById(utils.StringPtr(d.Id()))
 	if err != nil {
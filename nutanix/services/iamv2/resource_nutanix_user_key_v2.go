@@ -158,7 +158,7 @@ func ResourceNutanixUserKeyV2() *schema.Resource {
 }
 
 func resourceNutanixUserKeyV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 	spec := &import1.Key{}
 
 	var creationType = map[string]import1.CreationType{
@@ -238,7 +238,7 @@ func resourceNutanixUserKeyV2Create(ctx context.Context, d *schema.ResourceData,
 
 func resourceNutanixUserKeyV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 
 	var userExtID *string
 	if v, ok := d.GetOk("user_ext_id"); ok {
@@ -343,7 +343,7 @@ func resourceNutanixUserKeyV2Update(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceNutanixUserKeyV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 
 	var userExtID *string
 	if v, ok := d.GetOk("user_ext_id"); ok {
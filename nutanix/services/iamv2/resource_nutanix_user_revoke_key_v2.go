@@ -62,7 +62,7 @@ func ResourceNutanixUserRevokeKeyV2() *schema.Resource {
 }
 
 func resourceNutanixUserRevokeKeyV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 
 	var userExtID *string
 	if v, ok := d.GetOk("user_ext_id"); ok {
@@ -201,7 +201,7 @@ func ResourceNutanixUserV2() *schema.Resource {
 }
 
 func resourceNutanixUserV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 
 	spec := &import1.User{}
 
@@ -299,7 +299,7 @@ func resourceNutanixUserV2Create(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceNutanixUserV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 
 	resp, err := conn.UsersAPIInstance.GetUserById(utils.StringPtr(d.Id()))
 	if err != nil {
@@ -379,7 +379,7 @@ func resourceNutanixUserV2Read(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceNutanixUserV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).IamAPI
+	conn := meta.(*conns.Client).IamAPI()
 
 	// get Resp
 	getResp, er := conn.UsersAPIInstance.GetUserById(utils.StringPtr(d.Id()))
@@ -84,7 +84,7 @@ func DatasourceNutanixLcmConfigV2() *schema.Resource {
 }
 
 func DatasourceNutanixLcmConfigV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).LcmAPI
+	conn := meta.(*conns.Client).LcmAPI()
 	clusterExtID := d.Get("x_cluster_id").(string)
 	var clusterID *string
 	if clusterExtID != "" {
@@ -44,7 +44,7 @@ func DatasourceNutanixLcmEntitiesV2() *schema.Resource {
 }
 
 func DatasourceNutanixLcmEntitiesV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).LcmAPI
+	conn := meta.(*conns.Client).LcmAPI()
 
 	// initialize query params
 	var filter, orderBy, selects *string
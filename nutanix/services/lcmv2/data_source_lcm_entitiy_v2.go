@@ -119,7 +119,7 @@ func DatasourceNutanixLcmEntityV2() *schema.Resource {
 }
 
 func DatasourceNutanixLcmEntityV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).LcmAPI
+	conn := meta.(*conns.Client).LcmAPI()
 	extID := d.Get("ext_id").(string)
 
 	resp, err := conn.LcmEntitiesAPIInstance.GetEntityById(utils.StringPtr(extID))
@@ -77,7 +77,7 @@ func DatasourceNutanixLcmStatusV2() *schema.Resource {
 }
 
 func DatasourceNutanixLcmStatusV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).LcmAPI
+	conn := meta.(*conns.Client).LcmAPI()
 
 	var clusterID *string
 	if id := d.Get("x_cluster_id").(string); id != "" {
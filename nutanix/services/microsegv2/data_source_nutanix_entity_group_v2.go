@@ -57,7 +57,7 @@ func DatasourceNutanixEntityGroupV2() *schema.Resource {
 }
 
 func DatasourceNutanixEntityGroupV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).MicroSegAPI
+	conn := meta.(*conns.Client).MicroSegAPI()
 
 	extID := d.Get("ext_id").(string)
 
@@ -89,7 +89,7 @@ func schemaForEntityGroupListElem() *schema.Resource {
 }
 
 func DatasourceNutanixEntityGroupsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).MicroSegAPI
+	conn := meta.(*conns.Client).MicroSegAPI()
 
 	var page, limit *int
 	var filter, orderBy, selectVal *string
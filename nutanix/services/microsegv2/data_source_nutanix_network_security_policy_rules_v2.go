@@ -145,7 +145,7 @@ func ruleSpecSchema() map[string]*schema.Schema {
 }
 
 func DataSourceNutanixNetworkSecurityPolicyRulesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).MicroSegAPI
+	conn := meta.(*conns.Client).MicroSegAPI()
 
 	policyExtID := d.Get("policy_ext_id").(string)
 	var page, limit *int
@@ -13,7 +13,7 @@ const resourceNameEntityGroupV2 = "nutanix_entity_group_v2.test"
 
 func testEntityGroupV2CheckDestroy(state *terraform.State) error {
 	conn := acc.TestAccProvider.Meta().(*conns.Client)
-	api := conn.MicroSegAPI.EntityGroupsAPIInstance
+	api := conn.MicroSegAPI().EntityGroupsAPIInstance
 
 	for _, rs := range state.RootModule().Resources {
 		if rs.Type == "nutanix_entity_group_v2" {
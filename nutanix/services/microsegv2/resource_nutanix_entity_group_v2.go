@@ -6,7 +6,6 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	import2 "github.com/nutanix/ntnx-api-golang-clients/microseg-go-client/v4/models/microseg/v4/config"
 	prismMicroseg "github.com/nutanix/ntnx-api-golang-clients/microseg-go-client/v4/models/prism/v4/config"
@@ -31,7 +30,7 @@ func ResourceNutanixEntityGroupV2() *schema.Resource {
 }
 
 func ResourceNutanixEntityGroupV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).MicroSegAPI
+	conn := meta.(*conns.Client).MicroSegAPI()
 
 	body := import2.NewEntityGroup()
 	body.Name = utils.StringPtr(d.Get("name").(string))
@@ -62,14 +61,8 @@ func ResourceNutanixEntityGroupV2Create(ctx context.Context, d *schema.ResourceD
 	}
 	taskUUID := taskRef.ExtId
 
-	taskConn := meta.(*conns.Client).PrismAPI
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskConn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutCreate),
-	}
-	if _, errWait := stateConf.WaitForStateContext(ctx); errWait != nil {
+	taskConn := meta.(*conns.Client).PrismAPI()
+	if _, errWait := common.WaitForTask(ctx, taskConn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWait != nil {
 		return diag.Errorf("error waiting for Entity Group create: %s", errWait)
 	}
 
@@ -89,7 +82,7 @@ func ResourceNutanixEntityGroupV2Create(ctx context.Context, d *schema.ResourceD
 }
 
 func ResourceNutanixEntityGroupV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).MicroSegAPI
+	conn := meta.(*conns.Client).MicroSegAPI()
 
 	resp, err := conn.EntityGroupsAPIInstance.GetEntityGroupById(utils.StringPtr(d.Id()))
 	if err != nil {
@@ -117,7 +110,7 @@ func ResourceNutanixEntityGroupV2Read(ctx context.Context, d *schema.ResourceDat
 }
 
 func ResourceNutanixEntityGroupV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).MicroSegAPI
+	conn := meta.(*conns.Client).MicroSegAPI()
 
 	body := import2.NewEntityGroup()
 	body.Name = utils.StringPtr(d.Get("name").(string))
@@ -152,14 +145,8 @@ func ResourceNutanixEntityGroupV2Update(ctx context.Context, d *schema.ResourceD
 	}
 	taskUUID := taskRef.ExtId
 
-	taskConn := meta.(*conns.Client).PrismAPI
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskConn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutUpdate),
-	}
-	if _, errWait := stateConf.WaitForStateContext(ctx); errWait != nil {
+	taskConn := meta.(*conns.Client).PrismAPI()
+	if _, errWait := common.WaitForTask(ctx, taskConn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutUpdate)); errWait != nil {
 		return diag.Errorf("error waiting for Entity Group update: %s", errWait)
 	}
 
@@ -167,7 +154,7 @@ func ResourceNutanixEntityGroupV2Update(ctx context.Context, d *schema.ResourceD
 }
 
 func ResourceNutanixEntityGroupV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).MicroSegAPI
+	conn := meta.(*conns.Client).MicroSegAPI()
 
 	resp, err := conn.EntityGroupsAPIInstance.DeleteEntityGroupById(utils.StringPtr(d.Id()))
 	if err != nil {
@@ -180,14 +167,8 @@ func ResourceNutanixEntityGroupV2Delete(ctx context.Context, d *schema.ResourceD
 	}
 	taskUUID := taskRef.ExtId
 
-	taskConn := meta.(*conns.Client).PrismAPI
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskConn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutDelete),
-	}
-	if _, errWait := stateConf.WaitForStateContext(ctx); errWait != nil {
+	taskConn := meta.(*conns.Client).PrismAPI()
+	if _, errWait := common.WaitForTask(ctx, taskConn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutDelete)); errWait != nil {
 		return diag.Errorf("error waiting for Entity Group delete: %s", errWait)
 	}
 
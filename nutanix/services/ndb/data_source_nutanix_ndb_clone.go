@@ -159,7 +159,7 @@ func DataSourceNutanixNDBClone() *schema.Resource {
 }
 
 func dataSourceNutanixNDBCloneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	cloneID, ok := d.GetOk("clone_id")
 	cloneName, cok := d.GetOk("clone_name")
@@ -165,7 +165,7 @@ func DataSourceNutanixNDBClones() *schema.Resource {
 }
 
 func dataSourceNutanixNDBClonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	filterParams := &era.FilterParams{}
 	if filter, fok := d.GetOk("filters"); fok {
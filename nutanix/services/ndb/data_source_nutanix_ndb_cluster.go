@@ -172,7 +172,7 @@ func DataSourceNutanixEraCluster() *schema.Resource {
 }
 
 func dataSourceNutanixEraClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	clusterID, iok := d.GetOk("cluster_id")
 	clusterName, nok := d.GetOk("cluster_name")
@@ -172,7 +172,7 @@ func DataSourceNutanixEraClusters() *schema.Resource {
 }
 
 func dataSourceNutanixEraClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	resp, err := conn.Service.ListClusters(ctx)
 	if err != nil {
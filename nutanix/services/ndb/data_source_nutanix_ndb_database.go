@@ -115,7 +115,7 @@ func DataSourceNutanixEraDatabase() *schema.Resource {
 }
 
 func dataSourceNutanixEraDatabaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 	dUUID, ok := d.GetOk("database_id")
 	if !ok {
 		return diag.Errorf("please provide `database_id`")
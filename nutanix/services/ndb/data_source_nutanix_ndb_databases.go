@@ -126,7 +126,7 @@ func DataSourceNutanixEraDatabases() *schema.Resource {
 }
 
 func dataSourceNutanixEraDatabaseIntancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 	var resp *era.ListDatabaseInstance
 	var err error
 	if dbEng, ok := d.GetOk("database_type"); ok {
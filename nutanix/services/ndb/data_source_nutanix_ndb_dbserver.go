@@ -311,7 +311,7 @@ func DataSourceNutanixNDBDBServer() *schema.Resource {
 }
 
 func dataSourceNutanixNDBDBServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	filterReq := &era.DBServerFilterRequest{}
 
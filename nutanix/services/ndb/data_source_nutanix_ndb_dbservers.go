@@ -295,7 +295,7 @@ func DataSourceNutanixNDBDBServers() *schema.Resource {
 }
 
 func dataSourceNutanixNDBDBServersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	resp, err := conn.Service.ListDBServerVM(ctx)
 	if err != nil {
@@ -122,7 +122,7 @@ func DataSourceNutanixNDBMaintenanceWindow() *schema.Resource {
 }
 
 func dataSourceNutanixNDBMaintenanceWindowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	maintainenanceWindowID := d.Get("id")
 
@@ -131,7 +131,7 @@ func DataSourceNutanixNDBMaintenanceWindows() *schema.Resource {
 }
 
 func dataSourceNutanixNDBMaintenanceWindowsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	resp, err := conn.Service.ListMaintenanceWindow(ctx)
 	if err != nil {
@@ -144,7 +144,7 @@ func DataSourceNutanixEraNetwork() *schema.Resource {
 }
 
 func dataSourceNutanixEraNetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	name, nok := d.GetOk("name")
 	networkID, iok := d.GetOk("id")
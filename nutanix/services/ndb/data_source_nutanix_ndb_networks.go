@@ -151,7 +151,7 @@ func DataSourceNutanixEraNetworks() *schema.Resource {
 }
 
 func dataSourceNutanixEraNetworksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	resp, err := conn.Service.ListNetwork(ctx)
 	if err != nil {
@@ -290,7 +290,7 @@ func DataSourceNutanixEraProfile() *schema.Resource {
 }
 
 func dataSourceNutanixEraProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	engine := ""
 	profileType := ""
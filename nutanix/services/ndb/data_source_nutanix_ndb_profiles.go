@@ -292,7 +292,7 @@ func DataSourceNutanixEraProfiles() *schema.Resource {
 }
 
 func dataSourceNutanixEraProfilesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	engine := ""
 	profileType := ""
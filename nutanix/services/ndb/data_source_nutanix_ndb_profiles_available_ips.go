@@ -66,7 +66,7 @@ func DataSourceNutanixNDBProfileAvailableIPs() *schema.Resource {
 }
 
 func dataSourceNutanixNDBProfileAvailableIPsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	profileID := ""
 	if ID, ok := d.GetOk("profile_id"); ok {
@@ -95,7 +95,7 @@ func DataSourceNutanixEraSLA() *schema.Resource {
 }
 
 func dataSourceNutanixEraSLARead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	slaID, iok := d.GetOk("sla_id")
 	slaName, nok := d.GetOk("sla_name")
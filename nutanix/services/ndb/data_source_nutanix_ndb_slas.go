@@ -95,7 +95,7 @@ func DataSourceNutanixEraSLAs() *schema.Resource {
 }
 
 func dataSourceNutanixEraSLAsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	resp, err := conn.Service.ListSLA(ctx)
 	if err != nil {
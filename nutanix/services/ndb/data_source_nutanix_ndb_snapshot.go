@@ -195,7 +195,7 @@ func DataSourceNutanixNDBSnapshot() *schema.Resource {
 }
 
 func dataSourceNutanixNDBSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	snapID := ""
 	if snapshotID, ok := d.GetOk("snapshot_id"); ok {
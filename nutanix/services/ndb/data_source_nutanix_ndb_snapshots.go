@@ -192,7 +192,7 @@ func DataSourceNutanixNDBSnapshots() *schema.Resource {
 }
 
 func dataSourceNutanixNDBSnapshotsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	tmsID := ""
 	if filter, ok := d.GetOk("filters"); ok {
@@ -61,7 +61,7 @@ func DataSourceNutanixNDBTag() *schema.Resource {
 }
 
 func dataSourceNutanixNDBTagRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	tagID, iok := d.GetOk("id")
 	tagName, nok := d.GetOk("name")
@@ -76,7 +76,7 @@ func DataSourceNutanixNDBTags() *schema.Resource {
 }
 
 func dataSourceNutanixNDBTagsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	entityType := ""
 	if entity, eok := d.GetOk("entity_type"); eok {
@@ -401,7 +401,7 @@ func DataSourceNutanixNDBTimeMachine() *schema.Resource {
 }
 
 func dataSourceNutanixNDBTimeMachineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	tmsID, tok := d.GetOk("time_machine_id")
 	tmsName, tnOk := d.GetOk("time_machine_name")
@@ -923,7 +923,7 @@ func DataSourceNutanixNDBTmsCapability() *schema.Resource {
 }
 
 func dataSourceNutanixNDBTmsCapabilityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	tmsID := d.Get("time_machine_id")
 	resp, er := conn.Service.TimeMachineCapability(ctx, tmsID.(string))
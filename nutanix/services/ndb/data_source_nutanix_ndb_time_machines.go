@@ -20,7 +20,7 @@ func DataSourceNutanixNDBTimeMachines() *schema.Resource {
 }
 
 func dataSourceNutanixNDBTimeMachinesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	// call tms API
 	resp, err := conn.Service.ListTimeMachines(ctx)
@@ -40,7 +40,7 @@ func ResourceNutanixNDBAuthorizeDBServer() *schema.Resource {
 }
 
 func resourceNutanixNDBAuthorizeDBServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 	req := make([]*string, 0)
 
 	tmsID, tok := d.GetOk("time_machine_id")
@@ -95,7 +95,7 @@ func resourceNutanixNDBAuthorizeDBServerUpdate(ctx context.Context, d *schema.Re
 }
 
 func resourceNutanixNDBAuthorizeDBServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	tmsID, tok := d.GetOk("time_machine_id")
 	tmsName, tnOk := d.GetOk("time_machine_name")
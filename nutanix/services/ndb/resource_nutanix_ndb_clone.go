@@ -368,7 +368,7 @@ func ResourceNutanixNDBClone() *schema.Resource {
 }
 
 func resourceNutanixNDBCloneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 	req := &era.CloneRequest{}
 
 	tmsID, tok := d.GetOk("time_machine_id")
@@ -432,7 +432,7 @@ func resourceNutanixNDBCloneCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceNutanixNDBCloneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	filterParams := &era.FilterParams{}
 	filterParams.Detailed = "true"
@@ -542,7 +542,7 @@ func resourceNutanixNDBCloneRead(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceNutanixNDBCloneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 	dbID := d.Id()
 
 	name := ""
@@ -583,7 +583,7 @@ func resourceNutanixNDBCloneUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceNutanixNDBCloneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 	if conn == nil {
 		return diag.Errorf("era is nil")
 	}
@@ -49,7 +49,7 @@ func ResourceNutanixNDBCloneRefresh() *schema.Resource {
 }
 
 func resourceNutanixNDBCloneRefreshCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	req := &era.CloneRefreshInput{}
 	cloneID := ""
@@ -262,7 +262,7 @@ func ResourceNutanixNDBCluster() *schema.Resource {
 }
 
 func resourceNutanixNDBClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	req := &era.ClusterIntentInput{}
 
@@ -356,7 +356,7 @@ func resourceNutanixNDBClusterCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceNutanixNDBClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	// check if d.Id() is nil
 	if d.Id() == "" {
@@ -458,7 +458,7 @@ func resourceNutanixNDBClusterRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceNutanixNDBClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	req := &era.ClusterUpdateInput{}
 
@@ -507,7 +507,7 @@ func resourceNutanixNDBClusterUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceNutanixNDBClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	req := &era.DeleteClusterInput{
 		DeleteRemoteSites: false,
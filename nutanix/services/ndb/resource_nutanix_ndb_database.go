@@ -464,7 +464,7 @@ func ResourceDatabaseInstance() *schema.Resource {
 }
 
 func createDatabaseInstance(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	// check for resource schema validation
 	er := schemaValidation("ndb_provision_database", d)
@@ -539,7 +539,7 @@ func buildEraRequest(d *schema.ResourceData) (*era.ProvisionDatabaseRequest, err
 }
 
 func readDatabaseInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*conns.Client).Era
+	c := m.(*conns.Client).Era()
 	if c == nil {
 		return diag.Errorf("era is nil")
 	}
@@ -660,7 +660,7 @@ func readDatabaseInstance(ctx context.Context, d *schema.ResourceData, m interfa
 }
 
 func updateDatabaseInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*conns.Client).Era
+	c := m.(*conns.Client).Era()
 	if c == nil {
 		return diag.Errorf("era is nil")
 	}
@@ -702,7 +702,7 @@ func updateDatabaseInstance(ctx context.Context, d *schema.ResourceData, m inter
 }
 
 func deleteDatabaseInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	conn := m.(*conns.Client).Era
+	conn := m.(*conns.Client).Era()
 	if conn == nil {
 		return diag.Errorf("era is nil")
 	}
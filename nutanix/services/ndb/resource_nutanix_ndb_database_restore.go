@@ -157,7 +157,7 @@ func ResourceNutanixNDBDatabaseRestore() *schema.Resource {
 }
 
 func resourceNutanixNDBDatabaseRestoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 	req := &era.DatabaseRestoreRequest{}
 
 	databaseID := ""
@@ -161,7 +161,7 @@ func ResourceNutanixNDBScaleDatabase() *schema.Resource {
 }
 
 func resourceNutanixNDBScaleDatabaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	req := &era.DatabaseScale{}
 	dbUUID := ""
@@ -208,7 +208,7 @@ func ResourceNutanixNDBDatabaseSnapshot() *schema.Resource {
 }
 
 func resourceNutanixNDBDatabaseSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	req := &era.DatabaseSnapshotRequest{}
 	snapshotName := ""
@@ -315,7 +315,7 @@ func resourceNutanixNDBDatabaseSnapshotCreate(ctx context.Context, d *schema.Res
 }
 
 func resourceNutanixNDBDatabaseSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	// setting the default values for Get snapshot
 	filterParams := &era.FilterParams{}
@@ -483,7 +483,7 @@ func resourceNutanixNDBDatabaseSnapshotRead(ctx context.Context, d *schema.Resou
 }
 
 func resourceNutanixNDBDatabaseSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	updateReq := &era.UpdateSnapshotRequest{}
 
@@ -512,7 +512,7 @@ func resourceNutanixNDBDatabaseSnapshotUpdate(ctx context.Context, d *schema.Res
 }
 
 func resourceNutanixNDBDatabaseSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	resp, err := conn.Service.DeleteSnapshot(ctx, d.Id())
 	if err != nil {
@@ -266,7 +266,7 @@ func ResourceNutanixNDBServerVM() *schema.Resource {
 }
 
 func resourceNutanixNDBServerVMCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	req := &era.DBServerInputRequest{}
 
@@ -312,7 +312,7 @@ func resourceNutanixNDBServerVMCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceNutanixNDBServerVMRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	// check if d.Id() is nil
 	if d.Id() == "" {
@@ -398,7 +398,7 @@ func resourceNutanixNDBServerVMRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceNutanixNDBServerVMUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	req := &era.UpdateDBServerVMRequest{}
 
@@ -474,7 +474,7 @@ func resourceNutanixNDBServerVMUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceNutanixNDBServerVMDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	req := &era.DeleteDBServerVMRequest{}
 	if delete, ok := d.GetOk("delete"); ok {
@@ -220,7 +220,7 @@ func ResourceNutanixNDBRegisterDBServer() *schema.Resource {
 }
 
 func resourceNutanixNDBRegisterDBServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	req := &era.DBServerRegisterInput{}
 
@@ -268,7 +268,7 @@ func resourceNutanixNDBRegisterDBServerRead(ctx context.Context, d *schema.Resou
 }
 
 func resourceNutanixNDBRegisterDBServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	req := &era.UpdateDBServerVMRequest{}
 
@@ -342,7 +342,7 @@ func resourceNutanixNDBRegisterDBServerUpdate(ctx context.Context, d *schema.Res
 }
 
 func resourceNutanixNDBRegisterDBServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	req := &era.DeleteDBServerVMRequest{}
 	if delete, ok := d.GetOk("delete"); ok {
@@ -117,7 +117,7 @@ func ResourceNutanixNDBLinkedDB() *schema.Resource {
 }
 
 func resourceNutanixNDBLinkedDBCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	req := &era.CreateLinkedDatabasesRequest{}
 
@@ -195,7 +195,7 @@ func resourceNutanixNDBLinkedDBCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceNutanixNDBLinkedDBRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	databaseID := d.Get("database_id")
 
@@ -268,7 +268,7 @@ func resourceNutanixNDBLinkedDBUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceNutanixNDBLinkedDBDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	dbID := d.Get("database_id")
 
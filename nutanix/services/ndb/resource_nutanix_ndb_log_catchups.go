@@ -47,7 +47,7 @@ func ResourceNutanixNDBLogCatchUps() *schema.Resource {
 }
 
 func resourceNutanixNDBLogCatchUpsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 	req := &era.LogCatchUpRequest{}
 
 	tmsID := ""
@@ -66,7 +66,7 @@ func ResourceNutanixNDBMaintenanceTask() *schema.Resource {
 }
 
 func resourceNutanixNDBMaintenanceTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	req := &era.MaintenanceTasksInput{}
 
@@ -141,7 +141,7 @@ func resourceNutanixNDBMaintenanceTaskCreate(ctx context.Context, d *schema.Reso
 }
 
 func resourceNutanixNDBMaintenanceTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 	maintenanceID := d.Get("maintenance_window_id")
 
 	// check if maintenance id is nil
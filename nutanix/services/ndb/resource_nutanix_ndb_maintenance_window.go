@@ -160,7 +160,7 @@ func ResourceNutanixNDBMaintenanceWindow() *schema.Resource {
 }
 
 func resourceNutanixNDBMaintenanceWindowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	req := &era.MaintenanceWindowInput{}
 	schedule := &era.MaintenaceSchedule{}
@@ -210,7 +210,7 @@ func resourceNutanixNDBMaintenanceWindowCreate(ctx context.Context, d *schema.Re
 }
 
 func resourceNutanixNDBMaintenanceWindowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	// check if d.Id() is nil
 	if d.Id() == "" {
@@ -275,7 +275,7 @@ func resourceNutanixNDBMaintenanceWindowRead(ctx context.Context, d *schema.Reso
 }
 
 func resourceNutanixNDBMaintenanceWindowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	req := &era.MaintenanceWindowInput{}
 	sch := &era.MaintenaceSchedule{}
@@ -347,7 +347,7 @@ func resourceNutanixNDBMaintenanceWindowUpdate(ctx context.Context, d *schema.Re
 }
 
 func resourceNutanixNDBMaintenanceWindowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	resp, err := conn.Service.DeleteMaintenanceWindow(ctx, d.Id())
 	if err != nil {
@@ -154,7 +154,7 @@ func ResourceNutanixNDBNetwork() *schema.Resource {
 }
 
 func resourceNutanixNDBNetworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	req := &era.NetworkIntentInput{}
 
@@ -235,7 +235,7 @@ func resourceNutanixNDBNetworkCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceNutanixNDBNetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	// check if d.Id() is nil
 	if d.Id() == "" {
@@ -282,7 +282,7 @@ func resourceNutanixNDBNetworkRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceNutanixNDBNetworkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	updateReq := &era.NetworkIntentInput{}
 
@@ -354,7 +354,7 @@ func resourceNutanixNDBNetworkUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceNutanixNDBNetworkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	resp, err := conn.Service.DeleteNetwork(ctx, d.Id())
 	if err != nil {
@@ -550,7 +550,7 @@ func ResourceNutanixNDBProfile() *schema.Resource {
 }
 
 func resourceNutanixNDBProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	req := &era.ProfileRequest{}
 	softwareProfile := false
@@ -729,7 +729,7 @@ func resourceNutanixNDBProfileCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceNutanixNDBProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	// profile filter spec
 	profileFilter := &era.ProfileFilter{}
@@ -797,7 +797,7 @@ func resourceNutanixNDBProfileRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceNutanixNDBProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	req := &era.ProfileRequest{}
 
@@ -908,7 +908,7 @@ func resourceNutanixNDBProfileUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceNutanixNDBProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	resp, err := conn.Service.DeleteProfile(ctx, d.Id())
 	if err != nil {
@@ -1113,7 +1113,7 @@ func expandNetworkHAInstance(ctx context.Context, meta interface{}, ps []interfa
 				})
 
 				// call the cluster API to fetch cluster id
-				conn := meta.(*conns.Client).Era
+				conn := meta.(*conns.Client).Era()
 				resp, _ := conn.Service.GetCluster(ctx, "", vl.(string))
 
 				prop = append(prop, &era.ProfileProperties{
@@ -1131,7 +1131,7 @@ func expandNetworkHAInstance(ctx context.Context, meta interface{}, ps []interfa
 					Value: utils.StringPtr(vl.(string)),
 				})
 
-				conn := meta.(*conns.Client).Era
+				conn := meta.(*conns.Client).Era()
 				resp, _ := conn.Service.GetCluster(ctx, vl.(string), "")
 
 				prop = append(prop, &era.ProfileProperties{
@@ -291,7 +291,7 @@ func ResourceNutanixNDBRegisterDatabase() *schema.Resource {
 }
 
 func resourceNutanixNDBRegisterDatabaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	log.Println("Creating the request!!!")
 	req, err := buildReisterDBRequest(d)
@@ -343,7 +343,7 @@ func resourceNutanixNDBRegisterDatabaseUpdate(ctx context.Context, d *schema.Res
 }
 
 func resourceNutanixNDBRegisterDatabaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 	if conn == nil {
 		return diag.Errorf("era is nil")
 	}
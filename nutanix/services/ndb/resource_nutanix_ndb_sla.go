@@ -97,7 +97,7 @@ func ResourceNutanixNDBSla() *schema.Resource {
 }
 
 func resourceNutanixNDBSlaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	req := &era.SLAIntentInput{}
 
@@ -138,7 +138,7 @@ func resourceNutanixNDBSlaCreate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceNutanixNDBSlaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	// get the sla
 
@@ -218,7 +218,7 @@ func resourceNutanixNDBSlaRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceNutanixNDBSlaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 	req := &era.SLAIntentInput{}
 	// get the current sla
 
@@ -275,7 +275,7 @@ func resourceNutanixNDBSlaUpdate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceNutanixNDBSlaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	resp, err := conn.Service.DeleteSLA(ctx, d.Id())
 	if err != nil {
@@ -192,7 +192,7 @@ func ResourceNutanixNDBSoftwareVersionProfile() *schema.Resource {
 }
 
 func resourceNutanixNDBSoftwareVersionProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	req := &era.ProfileRequest{}
 	profileID := ""
@@ -299,7 +299,7 @@ func resourceNutanixNDBSoftwareVersionProfileCreate(ctx context.Context, d *sche
 }
 
 func resourceNutanixNDBSoftwareVersionProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	// Get Profile Version API
 	profileVersionID := d.Get("profile_id")
@@ -358,7 +358,7 @@ func resourceNutanixNDBSoftwareVersionProfileRead(ctx context.Context, d *schema
 }
 
 func resourceNutanixNDBSoftwareVersionProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	req := &era.ProfileRequest{}
 
@@ -408,7 +408,7 @@ func resourceNutanixNDBSoftwareVersionProfileUpdate(ctx context.Context, d *sche
 }
 
 func resourceNutanixNDBSoftwareVersionProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 	profileID := d.Get("profile_id")
 	resp, err := conn.Service.DeleteProfileVersion(ctx, profileID.(string), d.Id())
 	if err != nil {
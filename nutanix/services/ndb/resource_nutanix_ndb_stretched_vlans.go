@@ -139,7 +139,7 @@ func ResourceNutanixNDBStretchedVlan() *schema.Resource {
 }
 
 func resourceNutanixNDBStretchedVlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	req := &era.StretchedVlansInput{}
 
@@ -175,7 +175,7 @@ func resourceNutanixNDBStretchedVlanCreate(ctx context.Context, d *schema.Resour
 }
 
 func resourceNutanixNDBStretchedVlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	// check if d.Id() is nil
 	if d.Id() == "" {
@@ -205,7 +205,7 @@ func resourceNutanixNDBStretchedVlanRead(ctx context.Context, d *schema.Resource
 }
 
 func resourceNutanixNDBStretchedVlanUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	updateReq := &era.StretchedVlansInput{}
 	metadata := &era.StretchedVlanMetadata{}
@@ -282,7 +282,7 @@ func resourceNutanixNDBStretchedVlanUpdate(ctx context.Context, d *schema.Resour
 }
 
 func resourceNutanixNDBStretchedVlanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	resp, err := conn.Service.DeleteStretchedVlan(ctx, d.Id())
 	if err != nil {
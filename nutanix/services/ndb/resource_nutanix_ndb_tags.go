@@ -71,7 +71,7 @@ func ResourceNutanixNDBTags() *schema.Resource {
 }
 
 func resourceNutanixNDBTagsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	req := &era.CreateTagsInput{}
 	tagName := ""
@@ -118,7 +118,7 @@ func resourceNutanixNDBTagsCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceNutanixNDBTagsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	// check if d.Id() is nil
 	if d.Id() == "" {
@@ -164,7 +164,7 @@ func resourceNutanixNDBTagsRead(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceNutanixNDBTagsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	updateReq := &era.GetTagsResponse{}
 
@@ -209,7 +209,7 @@ func resourceNutanixNDBTagsUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceNutanixNDBTagsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	resp, err := conn.Service.DeleteTags(ctx, d.Id())
 	if err != nil {
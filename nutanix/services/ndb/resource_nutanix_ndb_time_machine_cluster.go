@@ -85,7 +85,7 @@ func ResourceNutanixNDBTmsCluster() *schema.Resource {
 }
 
 func resourceNutanixNDBTmsClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	req := &era.TmsClusterIntentInput{}
 
@@ -119,7 +119,7 @@ func resourceNutanixNDBTmsClusterCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceNutanixNDBTmsClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	tmsID := d.Get("time_machine_id")
 	clsID := d.Get("nx_cluster_id")
@@ -176,7 +176,7 @@ func resourceNutanixNDBTmsClusterRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceNutanixNDBTmsClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	updateReq := &era.TmsClusterIntentInput{}
 
@@ -212,7 +212,7 @@ func resourceNutanixNDBTmsClusterUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceNutanixNDBTmsClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).Era
+	conn := meta.(*conns.Client).Era()
 
 	req := &era.DeleteTmsClusterInput{
 		DeleteReplicatedSnapshots:         utils.BoolPtr(true),
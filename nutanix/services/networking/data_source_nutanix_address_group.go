@@ -52,7 +52,7 @@ func DataSourceNutanixAddressGroup() *schema.Resource {
 }
 
 func dataSourceNutanixAddressGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	if uuid, uuidOk := d.GetOk("uuid"); uuidOk {
 		group, reqErr := conn.V3.GetAddressGroup(uuid.(string))
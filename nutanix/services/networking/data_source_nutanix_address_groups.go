@@ -128,7 +128,7 @@ func DataSourceNutanixAddressGroups() *schema.Resource {
 
 func dataSourceNutanixAddressGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 	req := &v3.DSMetadata{}
 
 	metadata, filtersOk := d.GetOk("metadata")
@@ -135,7 +135,7 @@ func DataSourceNutanixFloatingIP() *schema.Resource {
 }
 
 func dataSourceNutanixFloatingIPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	fUUID, ok := d.GetOk("floating_ip_uuid")
 	if !ok {
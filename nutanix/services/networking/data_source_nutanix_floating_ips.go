@@ -184,7 +184,7 @@ func DataSourceNutanixFloatingIPs() *schema.Resource {
 }
 
 func dataSourceNutanixFloatingIPsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	req := &v3.DSMetadata{}
 
@@ -928,7 +928,7 @@ func dataSourceNutanixNetworkSecurityRuleRead(ctx context.Context, d *schema.Res
 	log.Printf("[DEBUG] Reading Network Security Rule: %s", d.Get("name").(string))
 
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	networkSecurityRuleID, ok := d.GetOk("network_security_rule_id")
 
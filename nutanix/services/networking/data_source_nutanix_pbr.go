@@ -363,7 +363,7 @@ func DataSourceNutanixPbr() *schema.Resource {
 }
 
 func dataSourceNutanixPbrRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	pbrUUID, ok := d.GetOk("pbr_uuid")
 	if !ok {
@@ -412,7 +412,7 @@ func DataSourceNutanixPbrs() *schema.Resource {
 }
 
 func dataSourceNutanixPbrsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	request := &v3.DSMetadata{}
 
@@ -98,7 +98,7 @@ func DataSourceNutanixServiceGroup() *schema.Resource {
 }
 
 func dataSourceNutanixServiceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	if uuid, uuidOk := d.GetOk("uuid"); uuidOk {
 		svGroup, reqErr := conn.V3.GetServiceGroup(uuid.(string))
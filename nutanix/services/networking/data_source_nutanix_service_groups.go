@@ -194,7 +194,7 @@ func flattenServiceGroups(entries []*v3.ServiceGroupListEntry) interface{} {
 }
 
 func dataSourceNutanixServiceGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	req := &v3.DSMetadata{}
 
@@ -192,7 +192,7 @@ func DataSourceNutanixStaticRoute() *schema.Resource {
 }
 
 func dataSourceNutanixStaticRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	vpc, ok := d.GetOk("vpc_reference_uuid")
 	vName, nok := d.GetOk("vpc_name")
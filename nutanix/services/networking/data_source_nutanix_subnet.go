@@ -225,7 +225,7 @@ func findSubnetByName(conn *v3.Client, name string, clientSideFilters []*client.
 
 func dataSourceNutanixSubnetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	subnetID, iok := d.GetOk("subnet_id")
 	subnetName, nok := d.GetOk("subnet_name")
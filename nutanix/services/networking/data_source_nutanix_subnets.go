@@ -239,7 +239,7 @@ func DataSourceNutanixSubnets() *schema.Resource {
 
 func dataSourceNutanixSubnetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 	req := &v3.DSMetadata{}
 
 	metadata, filtersOk := d.GetOk("metadata")
@@ -217,7 +217,7 @@ func DataSourceNutanixVPC() *schema.Resource {
 }
 
 func dataSourceNutanixVPCRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	uuid, iok := d.GetOk("vpc_uuid")
 	name, nok := d.GetOk("vpc_name")
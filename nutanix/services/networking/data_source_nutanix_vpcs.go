@@ -262,7 +262,7 @@ func DataSourceNutanixVPCs() *schema.Resource {
 }
 
 func dataSourceNutanixVPCsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	request := &v3.DSMetadata{}
 
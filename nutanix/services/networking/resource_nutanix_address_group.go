@@ -56,7 +56,7 @@ func ResourceNutanixAddressGroup() *schema.Resource {
 }
 
 func resourceNutanixAddressGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	id := d.Id()
 	response, err := conn.V3.GetAddressGroup(id)
@@ -102,7 +102,7 @@ func resourceNutanixAddressGroupUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceNutanixAddressGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	log.Printf("[Debug] Destroying the address group with the ID %s", d.Id())
 
@@ -118,7 +118,7 @@ func resourceNutanixAddressGroupRead(ctx context.Context, d *schema.ResourceData
 	log.Printf("[DEBUG] Reading AddressGroup: %s", d.Get("name").(string))
 
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	// Make request to the API
 	resp, err := conn.V3.GetAddressGroup(d.Id())
@@ -152,7 +152,7 @@ func flattenAddressEntry(group []*v3.IPAddressBlock) []map[string]interface{} {
 }
 
 func resourceNutanixAddressGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	request := &v3.AddressGroupInput{}
 	request.BlockList = make([]*v3.IPAddressBlock, 0)
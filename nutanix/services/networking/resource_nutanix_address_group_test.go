@@ -50,7 +50,7 @@ func testAccCheckNutanixAddressGroupDestroy(s *terraform.State) error {
 			continue
 		}
 		for {
-			_, err := conn.API.V3.GetVM(rs.Primary.ID)
+			_, err := conn.API().V3.GetVM(rs.Primary.ID)
 			if err != nil {
 				if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
 					return nil
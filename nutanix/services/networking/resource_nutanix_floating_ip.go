@@ -78,7 +78,7 @@ func ResourceNutanixFloatingIP() *schema.Resource {
 }
 
 func resourceNutanixFloatingIPCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	request := &v3.FIPIntentInput{}
 	spec := &v3.FloatingIPSpec{}
@@ -153,7 +153,7 @@ func resourceNutanixFloatingIPCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceNutanixFloatingIPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	resp, err := conn.V3.GetFloatingIPs(ctx, d.Id())
 	if err != nil {
@@ -190,7 +190,7 @@ func resourceNutanixFloatingIPRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceNutanixFloatingIPUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	request := &v3.FIPIntentInput{}
 	spec := &v3.FloatingIPSpec{}
@@ -308,7 +308,7 @@ func resourceNutanixFloatingIPUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceNutanixFloatingIPDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	log.Printf("[DEBUG] Deleting Floating IP: %s", d.Id())
 	resp, err := conn.V3.DeleteFloatingIP(ctx, d.Id())
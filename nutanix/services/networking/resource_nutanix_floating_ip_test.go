@@ -143,7 +143,7 @@ func testAccCheckNutanixFloatingIPDestroy(s *terraform.State) error {
 		if rs.Type != "nutanix_floating_ip" {
 			continue
 		}
-		if _, err := conn.API.V3.GetVPC(ctx, rs.Primary.ID); err != nil {
+		if _, err := conn.API().V3.GetVPC(ctx, rs.Primary.ID); err != nil {
 			if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
 				return nil
 			}
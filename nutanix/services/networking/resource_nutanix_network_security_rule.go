@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
 	v3 "github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v3/prism"
 	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
@@ -28,6 +29,7 @@ func ResourceNutanixNetworkSecurityRule() *schema.Resource {
 		ReadContext:   resourceNutanixNetworkSecurityRuleRead,
 		UpdateContext: resourceNutanixNetworkSecurityRuleUpdate,
 		DeleteContext: resourceNutanixNetworkSecurityRuleDelete,
+		CustomizeDiff: common.DefaultCategoriesDiff("categories"),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -633,7 +635,7 @@ func ResourceNutanixNetworkSecurityRule() *schema.Resource {
 
 func resourceNutanixNetworkSecurityRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	// Prepare request
 	request := &v3.NetworkSecurityRuleIntentInput{}
@@ -710,7 +712,7 @@ func resourceNutanixNetworkSecurityRuleRead(ctx context.Context, d *schema.Resou
 	log.Printf("[DEBUG] Reading Network Security Rule: %s", d.Get("name").(string))
 
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	// Make request to the API
 	resp, errNet := conn.V3.GetNetworkSecurityRule(d.Id())
@@ -870,7 +872,7 @@ func flattenNetworkRule(prefix string, rule *v3.NetworkSecurityRuleResourcesRule
 
 func resourceNutanixNetworkSecurityRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	// Prepare request
 	request := &v3.NetworkSecurityRuleIntentInput{}
@@ -983,7 +985,7 @@ func resourceNutanixNetworkSecurityRuleUpdate(ctx context.Context, d *schema.Res
 func resourceNutanixNetworkSecurityRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Deleting Network Security Rule: %s", d.Get("name").(string))
 
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	UUID := d.Id()
 
@@ -206,7 +206,7 @@ func testAccCheckNutanixNetworkSecurityRuleDestroy(s *terraform.State) error {
 			continue
 		}
 		for {
-			_, err := conn.API.V3.GetNetworkSecurityRule(rs.Primary.ID)
+			_, err := conn.API().V3.GetNetworkSecurityRule(rs.Primary.ID)
 			if err != nil {
 				if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
 					return nil
@@ -188,7 +188,7 @@ func ResourceNutanixPbr() *schema.Resource {
 }
 
 func resourceNutanixPbrCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	request := &v3.PbrIntentInput{}
 	res := &v3.PbrResources{}
@@ -251,7 +251,7 @@ func resourceNutanixPbrCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceNutanixPbrRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	// Get the PBR
 	resp, err := conn.V3.GetPBR(ctx, d.Id())
@@ -308,7 +308,7 @@ func resourceNutanixPbrRead(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceNutanixPbrUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	spec := &v3.PbrSpec{}
 	request := &v3.PbrIntentInput{}
@@ -427,7 +427,7 @@ func resourceNutanixPbrUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceNutanixPbrDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	log.Printf("[DEBUG] Deleting PBR: %s, %s", d.Get("name").(string), d.Id())
 	resp, err := conn.V3.DeletePBR(ctx, d.Id())
@@ -112,7 +112,7 @@ func IsValidProtocol(category string) bool {
 }
 
 func resourceNutanixServiceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 	id := d.Id()
 	response, err := conn.V3.GetServiceGroup(id)
 
@@ -213,7 +213,7 @@ func resourceNutanixServiceGroupRead(ctx context.Context, d *schema.ResourceData
 	log.Printf("[DEBUG] Reading ServiceGroup: %s", d.Get("name").(string))
 
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	// Make request to the API
 	resp, err := conn.V3.GetServiceGroup(d.Id())
@@ -273,7 +273,7 @@ func expandServiceEntry(d *schema.ResourceData) ([]*v3.ServiceListEntry, error)
 }
 
 func resourceNutanixServiceGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	request := &v3.ServiceGroupInput{}
 	request.ServiceList = make([]*v3.ServiceListEntry, 0)
@@ -320,7 +320,7 @@ func resourceNutanixServiceGroupCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceNutanixServiceGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	log.Printf("[Debug] Destroying the service group with the ID %s", d.Id())
 
@@ -84,7 +84,7 @@ func ResourceNutanixStaticRoute() *schema.Resource {
 }
 
 func resourceNutanixStaticRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	request := &v3.StaticRouteIntentInput{}
 	spec := &v3.StaticRouteSpec{}
@@ -168,7 +168,7 @@ func resourceNutanixStaticRouteCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceNutanixStaticRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	// Get API to read the static routes
 
@@ -189,7 +189,7 @@ func resourceNutanixStaticRouteRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceNutanixStaticRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	request := &v3.StaticRouteIntentInput{}
 	spec := &v3.StaticRouteSpec{}
@@ -9,10 +9,12 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
 	v3 "github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v3/prism"
 	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
@@ -44,7 +46,10 @@ func ResourceNutanixSubnet() *schema.Resource {
 			Update: schema.DefaultTimeout(DEFAULTWAITTIMEOUT * time.Minute),
 			Delete: schema.DefaultTimeout(DEFAULTWAITTIMEOUT * time.Minute),
 		},
-		CustomizeDiff: resourceNutanixSubnetDiff,
+		CustomizeDiff: customdiff.All(
+			resourceNutanixSubnetDiff,
+			common.DefaultCategoriesDiff("categories"),
+		),
 
 		Schema: map[string]*schema.Schema{
 			"api_version": {
@@ -223,7 +228,7 @@ func ResourceNutanixSubnet() *schema.Resource {
 }
 
 func resourceNutanixSubnetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 	request := &v3.SubnetIntentInput{}
 	spec := &v3.Subnet{}
 	metadata := &v3.Metadata{}
@@ -294,7 +299,7 @@ func resourceNutanixSubnetCreate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceNutanixSubnetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 	id := d.Id()
 	resp, err := conn.V3.GetSubnet(id)
 	if err != nil {
@@ -435,7 +440,7 @@ func resourceNutanixSubnetRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceNutanixSubnetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 	request := &v3.SubnetIntentInput{}
 	metadata := &v3.Metadata{}
 	res := &v3.SubnetResources{}
@@ -599,7 +604,7 @@ func resourceNutanixSubnetUpdate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceNutanixSubnetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	resp, err := conn.V3.DeleteSubnet(d.Id())
 	if err != nil {
@@ -318,7 +318,7 @@ func testAccCheckNutanixSubnetDestroy(s *terraform.State) error {
 		if rs.Type != "nutanix_subnet" {
 			continue
 		}
-		if _, err := resourceNutanixSubnetExists(conn.API, rs.Primary.ID); err != nil {
+		if _, err := resourceNutanixSubnetExists(conn.API(), rs.Primary.ID); err != nil {
 			if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
 				return nil
 			}
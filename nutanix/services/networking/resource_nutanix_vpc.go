@@ -142,7 +142,7 @@ func ResourceNutanixVPC() *schema.Resource {
 }
 
 func resourceNutanixVPCCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	request := &v3.VPCIntentInput{}
 	spec := &v3.VPC{}
@@ -207,7 +207,7 @@ func resourceNutanixVPCCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceNutanixVPCRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 	// Make request to the API
 	resp, err := conn.V3.GetVPC(ctx, d.Id())
 	if err != nil {
@@ -252,7 +252,7 @@ func resourceNutanixVPCRead(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceNutanixVPCUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	request := &v3.VPCIntentInput{}
 	spec := &v3.VPC{}
@@ -349,7 +349,7 @@ func resourceNutanixVPCUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceNutanixVPCDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 	log.Printf("[DEBUG] Deleting VPC: %s, %s", d.Get("name").(string), d.Id())
 	resp, err := conn.V3.DeleteVPC(ctx, d.Id())
 	if err != nil {
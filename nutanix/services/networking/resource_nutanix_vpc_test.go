@@ -144,7 +144,7 @@ func testAccCheckNutanixVpcDestroy(s *terraform.State) error {
 		if rs.Type != "nutanix_vpc" {
 			continue
 		}
-		if _, err := conn.API.V3.GetVPC(ctx, rs.Primary.ID); err != nil {
+		if _, err := conn.API().V3.GetVPC(ctx, rs.Primary.ID); err != nil {
 			if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
 				return nil
 			}
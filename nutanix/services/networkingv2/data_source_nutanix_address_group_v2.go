@@ -81,7 +81,7 @@ func DatasourceNutanixAddressGroupV2() *schema.Resource {
 }
 
 func DatasourceNutanixAddressGroupV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).MicroSegAPI
+	conn := meta.(*conns.Client).MicroSegAPI()
 
 	extID := d.Get("ext_id")
 	resp, err := conn.AddressGroupAPIInstance.GetAddressGroupById(utils.StringPtr(extID.(string)))
@@ -108,7 +108,7 @@ func DatasourceNutanixAddressGroupsV2() *schema.Resource {
 }
 
 func DatasourceNutanixAddressGroupsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).MicroSegAPI
+	conn := meta.(*conns.Client).MicroSegAPI()
 
 	// initialize query params
 	var filter, orderBy, selects *string
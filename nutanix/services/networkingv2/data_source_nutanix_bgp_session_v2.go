@@ -0,0 +1,144 @@
+package networkingv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/networking/v4/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func DataSourceNutanixBgpSessionV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceNutanixBgpSessionV2Read,
+		Schema: map[string]*schema.Schema{
+			"ext_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"local_gateway_reference": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"remote_gateway_reference": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"local_gateway_interface_ip_address": schemaForGatewayIPAddress(),
+			"dynamic_route_priority": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"should_advertise_all_externally_routable_prefixes": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"externally_routable_prefixes_to_advertise": schemaForIPSubnetPrefixes(),
+			"advertised_routes_communities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"autonomous_system_number": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"community_value": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"prepended_autonomous_system_path": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"status": schemaForNetworkingStatus(),
+			"links": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"href": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rel": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"metadata": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: DatasourceMetadataSchemaV2(),
+				},
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func DataSourceNutanixBgpSessionV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	extID := d.Get("ext_id")
+
+	resp, err := conn.BgpSessionAPIInstance.GetBgpSessionById(utils.StringPtr(extID.(string)))
+	if err != nil {
+		return diag.Errorf("error while fetching BGP session : %v", err)
+	}
+
+	getResp := resp.Data.GetValue().(import1.BgpSession)
+
+	for key, value := range flattenBgpSession(getResp) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(utils.StringValue(getResp.ExtId))
+	return nil
+}
+
+func flattenBgpSession(pr import1.BgpSession) map[string]interface{} {
+	session := make(map[string]interface{})
+
+	session["ext_id"] = utils.StringValue(pr.ExtId)
+	session["name"] = utils.StringValue(pr.Name)
+	session["description"] = utils.StringValue(pr.Description)
+	session["local_gateway_reference"] = utils.StringValue(pr.LocalGatewayReference)
+	session["remote_gateway_reference"] = utils.StringValue(pr.RemoteGatewayReference)
+	session["local_gateway_interface_ip_address"] = flattenIPAddress(pr.LocalGatewayInterfaceIpAddress)
+	session["dynamic_route_priority"] = utils.IntValue(pr.DynamicRoutePriority)
+	session["should_advertise_all_externally_routable_prefixes"] = utils.BoolValue(pr.ShouldAdvertiseAllExternallyRoutablePrefixes)
+	session["externally_routable_prefixes_to_advertise"] = flattenExternallyRoutablePrefixes(pr.ExternallyRoutablePrefixesToAdvertise)
+	session["advertised_routes_communities"] = flattenBgpCommunities(pr.AdvertisedRoutesCommunities)
+	session["prepended_autonomous_system_path"] = pr.PrependedAutonomousSystemPath
+	session["status"] = flattenNetworkingStatus(pr.Status)
+	session["links"] = flattenLinks(pr.Links)
+	session["metadata"] = flattenMetadata(pr.Metadata)
+	session["tenant_id"] = utils.StringValue(pr.TenantId)
+
+	return session
+}
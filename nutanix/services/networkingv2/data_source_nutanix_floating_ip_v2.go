@@ -163,7 +163,7 @@ func DatasourceNutanixFloatingIPV2() *schema.Resource {
 }
 
 func DatasourceNutanixFloatingIPV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).NetworkingAPI
+	conn := meta.(*conns.Client).NetworkingAPI()
 
 	extID := d.Get("ext_id")
 	resp, err := conn.FloatingIPAPIInstance.GetFloatingIpById(utils.StringPtr(extID.(string)))
@@ -191,7 +191,7 @@ func DatasourceNutanixFloatingIPsV2() *schema.Resource {
 }
 
 func datasourceNutanixFloatingIPsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).NetworkingAPI
+	conn := meta.(*conns.Client).NetworkingAPI()
 
 	// initialize query params
 	var filter, orderBy, expand *string
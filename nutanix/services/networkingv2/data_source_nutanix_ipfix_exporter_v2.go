@@ -0,0 +1,158 @@
+package networkingv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/networking/v4/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func DataSourceNutanixIPFIXExporterV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceNutanixIPFIXExporterV2Read,
+		Schema: map[string]*schema.Schema{
+			"ext_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collector_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collector_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"export_rate_limit_per_node_bps": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"export_scopes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uuid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"scope_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_family": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"links": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"href": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rel": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"metadata": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: DatasourceMetadataSchemaV2(),
+				},
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func DataSourceNutanixIPFIXExporterV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	extID := d.Get("ext_id")
+
+	resp, err := conn.IPFIXExporterAPIInstance.GetIpfixExporterById(utils.StringPtr(extID.(string)))
+	if err != nil {
+		return diag.Errorf("error while fetching IPFIX exporter : %v", err)
+	}
+
+	getResp := resp.Data.GetValue().(import1.IPFIXExporter)
+
+	for key, value := range flattenIPFIXExporter(getResp) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(utils.StringValue(getResp.ExtId))
+	return nil
+}
+
+func flattenIPFIXExporter(pr import1.IPFIXExporter) map[string]interface{} {
+	exporter := make(map[string]interface{})
+
+	exporter["ext_id"] = utils.StringValue(pr.ExtId)
+	exporter["name"] = utils.StringValue(pr.Name)
+	exporter["description"] = utils.StringValue(pr.Description)
+	exporter["collector_ip"] = utils.StringValue(pr.CollectorIp)
+	exporter["collector_port"] = utils.Int64Value(pr.CollectorPort)
+	if pr.Protocol != nil {
+		exporter["protocol"] = pr.Protocol.GetName()
+	}
+	exporter["export_rate_limit_per_node_bps"] = utils.Int64Value(pr.ExportRateLimitPerNodeBps)
+	exporter["export_scopes"] = flattenExportScopes(pr.ExportScopes)
+	exporter["links"] = flattenLinks(pr.Links)
+	exporter["metadata"] = flattenMetadata(pr.Metadata)
+	exporter["tenant_id"] = utils.StringValue(pr.TenantId)
+
+	return exporter
+}
+
+func flattenExportScopes(pr []import1.ExportScope) []map[string]interface{} {
+	if len(pr) == 0 {
+		return nil
+	}
+
+	scopes := make([]map[string]interface{}, len(pr))
+	for k, v := range pr {
+		scope := make(map[string]interface{})
+
+		scope["uuid"] = utils.StringValue(v.Uuid)
+		if v.ScopeType != nil {
+			scope["scope_type"] = v.ScopeType.GetName()
+		}
+		if v.IpFamily != nil {
+			scope["ip_family"] = v.IpFamily.GetName()
+		}
+
+		scopes[k] = scope
+	}
+	return scopes
+}
@@ -0,0 +1,131 @@
+package networkingv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/networking/v4/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func DataSourceNutanixLayer2StretchV2() *schema.Resource {
+	siteParams := &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"stretch_subnet_reference": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"connection_reference": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"pc_cluster_reference": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"default_gateway_ip_address":   schemaForGatewayIPAddress(),
+				"stretch_interface_ip_address": schemaForGatewayIPAddress(),
+				"vpn_interface_ip_address":     schemaForGatewayIPAddress(),
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: DataSourceNutanixLayer2StretchV2Read,
+		Schema: map[string]*schema.Schema{
+			"ext_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mtu": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"vni": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"local_site_params":        siteParams,
+			"remote_site_params":       siteParams,
+			"stretch_status":           schemaForStretchStatus(),
+			"high_availability_status": schemaForStretchHighAvailabilityStatus(),
+			"remote_stretch_status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address":                  schemaForGatewayIPAddress(),
+						"status":                   schemaForStretchStatus(),
+						"high_availability_status": schemaForStretchHighAvailabilityStatus(),
+					},
+				},
+			},
+			"links": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"href": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rel": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"metadata": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: DatasourceMetadataSchemaV2(),
+				},
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func DataSourceNutanixLayer2StretchV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	extID := d.Get("ext_id")
+
+	resp, err := conn.Layer2StretchAPIInstance.GetLayer2StretchById(utils.StringPtr(extID.(string)))
+	if err != nil {
+		return diag.Errorf("error while fetching layer2 stretch : %v", err)
+	}
+
+	getResp := resp.Data.GetValue().(import1.Layer2Stretch)
+
+	for key, value := range flattenLayer2Stretch(getResp) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(utils.StringValue(getResp.ExtId))
+	return nil
+}
@@ -91,7 +91,7 @@ func DataSourceNutanixNetworkFunctionV2() *schema.Resource {
 }
 
 func DataSourceNutanixNetworkFunctionV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).NetworkingAPI
+	conn := meta.(*conns.Client).NetworkingAPI()
 
 	extID := d.Get("ext_id").(string)
 	resp, err := conn.NetworkFunctionAPI.GetNetworkFunctionById(utils.StringPtr(extID))
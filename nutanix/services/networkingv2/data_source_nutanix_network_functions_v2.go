@@ -30,7 +30,7 @@ func DataSourceNutanixNetworkFunctionsV2() *schema.Resource {
 }
 
 func DataSourceNutanixNetworkFunctionsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).NetworkingAPI
+	conn := meta.(*conns.Client).NetworkingAPI()
 
 	var filter, orderBy *string
 	var page, limit *int
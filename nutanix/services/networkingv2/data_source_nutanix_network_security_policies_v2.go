@@ -46,7 +46,7 @@ func DataSourceNutanixNetworkSecurityPoliciesV2() *schema.Resource {
 }
 
 func DataSourceNutanixNetworkSecurityPoliciesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).MicroSegAPI
+	conn := meta.(*conns.Client).MicroSegAPI()
 
 	// initialize query params
 	var filter, orderBy, selects *string
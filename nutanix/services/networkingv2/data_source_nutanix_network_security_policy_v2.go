@@ -482,7 +482,7 @@ func DataSourceNutanixNetworkSecurityPolicyV2() *schema.Resource {
 }
 
 func DataSourceNutanixNetworkSecurityPolicyV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).MicroSegAPI
+	conn := meta.(*conns.Client).MicroSegAPI()
 
 	extID := d.Get("ext_id")
 
@@ -334,7 +334,7 @@ func DatasourceNutanixPbrV2() *schema.Resource {
 }
 
 func DatasourceNutanixPbrV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).NetworkingAPI
+	conn := meta.(*conns.Client).NetworkingAPI()
 
 	extID := d.Get("ext_id")
 	resp, err := conn.RoutingPolicy.GetRoutingPolicyById(utils.StringPtr(extID.(string)))
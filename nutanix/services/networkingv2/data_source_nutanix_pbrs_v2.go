@@ -359,7 +359,7 @@ func DatasourceNutanixPbrsV2() *schema.Resource {
 }
 
 func DatasourceNutanixPbrsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).NetworkingAPI
+	conn := meta.(*conns.Client).NetworkingAPI()
 
 	// initialize query params
 	var filter, orderBy *string
@@ -58,7 +58,7 @@ func DatasourceNutanixRouteTableV2() *schema.Resource {
 }
 
 func DatasourceNutanixRouteTableV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).NetworkingAPI
+	conn := meta.(*conns.Client).NetworkingAPI()
 
 	extID := d.Get("ext_id")
 	resp, err := conn.RoutesTable.GetRouteTableById(utils.StringPtr(extID.(string)))
@@ -85,7 +85,7 @@ func DatasourceNutanixRouteTablesV2() *schema.Resource {
 }
 
 func DatasourceNutanixRouteTablesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).NetworkingAPI
+	conn := meta.(*conns.Client).NetworkingAPI()
 
 	// initialize query params
 	var filter, orderBy *string
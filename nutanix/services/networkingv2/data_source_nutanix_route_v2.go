@@ -131,7 +131,7 @@ func DatasourceNutanixRouteV2() *schema.Resource {
 func DatasourceNutanixRouteV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] DatasourceNutanixRouteV2Read")
 
-	conn := meta.(*conns.Client).NetworkingAPI
+	conn := meta.(*conns.Client).NetworkingAPI()
 
 	routeTableExtID := d.Get("route_table_ext_id").(string)
 	extID := d.Get("ext_id").(string)
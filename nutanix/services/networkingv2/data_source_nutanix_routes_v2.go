@@ -48,7 +48,7 @@ func DatasourceNutanixRoutesV2() *schema.Resource {
 func DatasourceNutanixRoutesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] DatasourceNutanixRoutesV2Read")
 
-	conn := meta.(*conns.Client).NetworkingAPI
+	conn := meta.(*conns.Client).NetworkingAPI()
 
 	routeTableExtID := d.Get("route_table_ext_id").(string)
 
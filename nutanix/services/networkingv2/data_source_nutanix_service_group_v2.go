@@ -118,7 +118,7 @@ func DatasourceNutanixServiceGroupV2() *schema.Resource {
 }
 
 func DatasourceNutanixServiceGroupV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).MicroSegAPI
+	conn := meta.(*conns.Client).MicroSegAPI()
 
 	extID := d.Get("ext_id")
 
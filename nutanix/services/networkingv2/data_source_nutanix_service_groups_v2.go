@@ -147,7 +147,7 @@ func DatasourceNutanixServiceGroupsV2() *schema.Resource {
 }
 
 func DatasourceNutanixServiceGroupsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).MicroSegAPI
+	conn := meta.(*conns.Client).MicroSegAPI()
 
 	// initialize query params
 	var filter, orderBy, selects *string
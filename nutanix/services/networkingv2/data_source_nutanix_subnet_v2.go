@@ -394,7 +394,7 @@ func DataSourceNutanixSubnetV2() *schema.Resource {
 }
 
 func dataSourceNutanixSubnetV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).NetworkingAPI
+	conn := meta.(*conns.Client).NetworkingAPI()
 
 	extID := d.Get("ext_id")
 	resp, err := conn.SubnetAPIInstance.GetSubnetById(utils.StringPtr(extID.(string)))
@@ -49,7 +49,7 @@ func DataSourceNutanixSubnetsV2() *schema.Resource {
 }
 
 func dataSourceNutanixSubnetsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).NetworkingAPI
+	conn := meta.(*conns.Client).NetworkingAPI()
 
 	// initialize query params
 	var filter, orderBy, expand, selects *string
@@ -0,0 +1,220 @@
+package networkingv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/networking/v4/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func DataSourceNutanixTrafficMirrorV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceNutanixTrafficMirrorV2Read,
+		Schema: map[string]*schema.Schema{
+			"ext_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"cluster_reference_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"host_reference_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"source_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"nic_uuid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"nic_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"direction": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_up": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"destination_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"nic_uuid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"nic_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_up": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"virtual_switch_reference": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"links": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"href": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rel": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"metadata": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: DatasourceMetadataSchemaV2(),
+				},
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func DataSourceNutanixTrafficMirrorV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	extID := d.Get("ext_id")
+
+	resp, err := conn.TrafficMirrorAPIInstance.GetTrafficMirrorById(utils.StringPtr(extID.(string)))
+	if err != nil {
+		return diag.Errorf("error while fetching traffic mirror : %v", err)
+	}
+
+	getResp := resp.Data.GetValue().(import1.TrafficMirror)
+
+	for key, value := range flattenTrafficMirror(getResp) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(utils.StringValue(getResp.ExtId))
+	return nil
+}
+
+func flattenTrafficMirror(pr import1.TrafficMirror) map[string]interface{} {
+	mirror := make(map[string]interface{})
+
+	mirror["ext_id"] = utils.StringValue(pr.ExtId)
+	mirror["name"] = utils.StringValue(pr.Name)
+	mirror["description"] = utils.StringValue(pr.Description)
+	mirror["is_enabled"] = utils.BoolValue(pr.IsEnabled)
+	mirror["cluster_reference_list"] = pr.ClusterReferenceList
+	mirror["host_reference_list"] = pr.HostReferenceList
+	mirror["source_list"] = flattenTrafficMirrorSourcePorts(pr.SourceList)
+	mirror["destination_list"] = flattenTrafficMirrorPorts(pr.DestinationList)
+	mirror["virtual_switch_reference"] = utils.StringValue(pr.VirtualSwitchReference)
+	if pr.State != nil {
+		mirror["state"] = pr.State.GetName()
+	}
+	mirror["state_message"] = utils.StringValue(pr.StateMessage)
+	mirror["links"] = flattenLinks(pr.Links)
+	mirror["metadata"] = flattenMetadata(pr.Metadata)
+	mirror["tenant_id"] = utils.StringValue(pr.TenantId)
+
+	return mirror
+}
+
+func flattenTrafficMirrorSourcePorts(pr []import1.TrafficMirrorSourcePort) []map[string]interface{} {
+	if len(pr) == 0 {
+		return nil
+	}
+
+	ports := make([]map[string]interface{}, len(pr))
+	for k, v := range pr {
+		port := make(map[string]interface{})
+
+		port["nic_uuid"] = utils.StringValue(v.NicUuid)
+		if v.NicType != nil {
+			port["nic_type"] = v.NicType.GetName()
+		}
+		if v.Direction != nil {
+			port["direction"] = v.Direction.GetName()
+		}
+		port["is_up"] = utils.BoolValue(v.IsUp)
+
+		ports[k] = port
+	}
+	return ports
+}
+
+func flattenTrafficMirrorPorts(pr []import1.TrafficMirrorPort) []map[string]interface{} {
+	if len(pr) == 0 {
+		return nil
+	}
+
+	ports := make([]map[string]interface{}, len(pr))
+	for k, v := range pr {
+		port := make(map[string]interface{})
+
+		port["nic_uuid"] = utils.StringValue(v.NicUuid)
+		if v.NicType != nil {
+			port["nic_type"] = v.NicType.GetName()
+		}
+		port["is_up"] = utils.BoolValue(v.IsUp)
+
+		ports[k] = port
+	}
+	return ports
+}
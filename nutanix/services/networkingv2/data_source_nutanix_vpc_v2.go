@@ -183,7 +183,7 @@ func DataSourceNutanixVPCv2() *schema.Resource {
 }
 
 func dataSourceNutanixVPCv2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).NetworkingAPI
+	conn := meta.(*conns.Client).NetworkingAPI()
 
 	extID := d.Get("ext_id")
 	resp, err := conn.VpcAPIInstance.GetVpcById(utils.StringPtr(extID.(string)))
@@ -45,7 +45,7 @@ func DataSourceNutanixVPCsv2() *schema.Resource {
 }
 
 func dataSourceNutanixVPCsv2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).NetworkingAPI
+	conn := meta.(*conns.Client).NetworkingAPI()
 
 	// initialize query params
 	var filter, orderBy, selects *string
@@ -0,0 +1,214 @@
+package networkingv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/networking/v4/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func DataSourceNutanixVpnConnectionV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceNutanixVpnConnectionV2Read,
+		Schema: map[string]*schema.Schema{
+			"ext_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"local_gateway_reference": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"remote_gateway_reference": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"local_gateway_role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipsec_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"local_authentication_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"remote_authentication_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ike_authentication_algorithm": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ike_encryption_algorithm": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ike_lifetime_secs": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"ipsec_authentication_algorithm": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipsec_encryption_algorithm": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipsec_lifetime_secs": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"esp_pfs_dh_group_number": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"local_vti_ip":  schemaForGatewayIPAddress(),
+						"remote_vti_ip": schemaForGatewayIPAddress(),
+					},
+				},
+			},
+			"dpd_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"operation": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"interval_secs": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"timeout_secs": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"qos_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ingress_limit_mbps": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"egress_limit_mbps": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"dynamic_route_priority": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"advertised_prefixes": schemaForIPSubnetPrefixes(),
+			"learned_prefixes":    schemaForIPSubnetPrefixes(),
+			"ipsec_tunnel_status": schemaForNetworkingStatus(),
+			"ebgp_status":         schemaForNetworkingStatus(),
+			"links": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"href": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rel": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"metadata": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: DatasourceMetadataSchemaV2(),
+				},
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func DataSourceNutanixVpnConnectionV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	extID := d.Get("ext_id")
+
+	resp, err := conn.VpnConnectionAPIInstance.GetVpnConnectionById(utils.StringPtr(extID.(string)))
+	if err != nil {
+		return diag.Errorf("error while fetching VPN connection : %v", err)
+	}
+
+	getResp := resp.Data.GetValue().(import1.VpnConnection)
+
+	for key, value := range flattenVpnConnection(getResp) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(utils.StringValue(getResp.ExtId))
+	return nil
+}
+
+func flattenVpnConnection(pr import1.VpnConnection) map[string]interface{} {
+	connection := make(map[string]interface{})
+
+	ipsecConfig := flattenIpsecConfig(pr.IpsecConfig, "")
+	// the data source does not expose the pre-shared key
+	for _, v := range ipsecConfig {
+		delete(v, "pre_shared_key")
+	}
+
+	connection["ext_id"] = utils.StringValue(pr.ExtId)
+	connection["name"] = utils.StringValue(pr.Name)
+	connection["description"] = utils.StringValue(pr.Description)
+	connection["local_gateway_reference"] = utils.StringValue(pr.LocalGatewayReference)
+	connection["remote_gateway_reference"] = utils.StringValue(pr.RemoteGatewayReference)
+	if pr.LocalGatewayRole != nil {
+		connection["local_gateway_role"] = pr.LocalGatewayRole.GetName()
+	}
+	connection["ipsec_config"] = ipsecConfig
+	connection["dpd_config"] = flattenDpdConfig(pr.DpdConfig)
+	connection["qos_config"] = flattenQosConfig(pr.QosConfig)
+	connection["dynamic_route_priority"] = utils.IntValue(pr.DynamicRoutePriority)
+	connection["advertised_prefixes"] = flattenExternallyRoutablePrefixes(pr.AdvertisedPrefixes)
+	connection["learned_prefixes"] = flattenExternallyRoutablePrefixes(pr.LearnedPrefixes)
+	connection["ipsec_tunnel_status"] = flattenNetworkingStatus(pr.IpsecTunnelStatus)
+	connection["ebgp_status"] = flattenNetworkingStatus(pr.EbgpStatus)
+	connection["links"] = flattenLinks(pr.Links)
+	connection["metadata"] = flattenMetadata(pr.Metadata)
+	connection["tenant_id"] = utils.StringValue(pr.TenantId)
+
+	return connection
+}
@@ -0,0 +1,95 @@
+package networkingv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/networking/v4/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func DataSourceNutanixVpnConnectionsV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceNutanixVpnConnectionsV2Read,
+		Schema: map[string]*schema.Schema{
+			"page": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"limit": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"order_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"vpn_connections": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     DataSourceNutanixVpnConnectionV2(),
+			},
+		},
+	}
+}
+
+func DataSourceNutanixVpnConnectionsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	// initialize query params
+	var filter, orderBy *string
+	var page, limit *int
+
+	if pagef, ok := d.GetOk("page"); ok {
+		page = utils.IntPtr(pagef.(int))
+	}
+	if limitf, ok := d.GetOk("limit"); ok {
+		limit = utils.IntPtr(limitf.(int))
+	}
+	if filterf, ok := d.GetOk("filter"); ok {
+		filter = utils.StringPtr(filterf.(string))
+	}
+	if order, ok := d.GetOk("order_by"); ok {
+		orderBy = utils.StringPtr(order.(string))
+	}
+
+	resp, err := conn.VpnConnectionAPIInstance.ListVpnConnections(page, limit, filter, orderBy)
+	if err != nil {
+		return diag.Errorf("error while fetching VPN connections : %v", err)
+	}
+
+	if resp.Data == nil {
+		if err := d.Set("vpn_connections", make([]interface{}, 0)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(utils.GenUUID())
+
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "🫙 No data found.",
+			Detail:   "The API returned an empty list of VPN connections.",
+		}}
+	}
+
+	getResp := resp.Data.GetValue().([]import1.VpnConnection)
+
+	connections := make([]map[string]interface{}, len(getResp))
+	for k, v := range getResp {
+		connections[k] = flattenVpnConnection(v)
+	}
+
+	if err := d.Set("vpn_connections", connections); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.UniqueId())
+	return nil
+}
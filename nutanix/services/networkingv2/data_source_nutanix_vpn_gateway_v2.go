@@ -0,0 +1,255 @@
+package networkingv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/networking/v4/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func DataSourceNutanixVpnGatewayV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceNutanixVpnGatewayV2Read,
+		Schema: map[string]*schema.Schema{
+			"ext_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_reference": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"gateway_device_vendor": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cluster_reference": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vcenter_datastore_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"should_synchronize_system_dns_servers": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"should_synchronize_system_ntp_servers": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"dns_servers": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ipv4": SchemaForValuePrefixLength(),
+									"ipv6": SchemaForValuePrefixLength(),
+								},
+							},
+						},
+						"interfaces": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"subnet_reference": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"ip_address":              schemaForGatewayIPAddress(),
+									"default_gateway_address": schemaForGatewayIPAddress(),
+									"mtu": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"mac_address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"local_services": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_address": schemaForGatewayIPAddress(),
+						"local_vpn_service": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ebgp_config": schemaForGatewayBgpConfig(),
+								},
+							},
+						},
+						"local_bgp_service": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"asn": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"vpc_reference": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"is_bgp_add_path_enabled": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"remote_services": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"remote_vpn_service": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"service_address": schemaForGatewayIPAddress(),
+									"ebgp_config":     schemaForGatewayBgpConfig(),
+								},
+							},
+						},
+						"remote_bgp_service": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address": schemaForGatewayIPAddress(),
+									"asn": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"installed_software_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"supported_software_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_active": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"vm_reference": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": schemaForNetworkingStatus(),
+			"links": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"href": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rel": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"metadata": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: DatasourceMetadataSchemaV2(),
+				},
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func DataSourceNutanixVpnGatewayV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	extID := d.Get("ext_id")
+
+	resp, err := conn.GatewayAPIInstance.GetGatewayById(utils.StringPtr(extID.(string)))
+	if err != nil {
+		return diag.Errorf("error while fetching VPN gateway : %v", err)
+	}
+
+	getResp := resp.Data.GetValue().(import1.Gateway)
+
+	for key, value := range flattenVpnGateway(getResp) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(utils.StringValue(getResp.ExtId))
+	return nil
+}
+
+func flattenVpnGateway(pr import1.Gateway) map[string]interface{} {
+	gateway := make(map[string]interface{})
+
+	localServices, remoteServices := flattenGatewayServices(pr.Services, "", "")
+
+	gateway["ext_id"] = utils.StringValue(pr.ExtId)
+	gateway["name"] = utils.StringValue(pr.Name)
+	gateway["description"] = utils.StringValue(pr.Description)
+	gateway["vpc_reference"] = utils.StringValue(pr.VpcReference)
+	gateway["gateway_device_vendor"] = utils.StringValue(pr.GatewayDeviceVendor)
+	gateway["deployment"] = flattenGatewayDeployment(pr.Deployment)
+	gateway["local_services"] = localServices
+	gateway["remote_services"] = remoteServices
+	gateway["installed_software_version"] = utils.StringValue(pr.InstalledSoftwareVersion)
+	gateway["supported_software_version"] = utils.StringValue(pr.SupportedSoftwareVersion)
+	gateway["is_active"] = utils.BoolValue(pr.IsActive)
+	gateway["vm_reference"] = utils.StringValue(pr.VmReference)
+	gateway["status"] = flattenNetworkingStatus(pr.Status)
+	gateway["links"] = flattenLinks(pr.Links)
+	gateway["metadata"] = flattenMetadata(pr.Metadata)
+	gateway["tenant_id"] = utils.StringValue(pr.TenantId)
+
+	return gateway
+}
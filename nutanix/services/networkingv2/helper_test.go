@@ -77,7 +77,7 @@ func waitForNetworkFunctionHealth(resourceName, attributeName, desiredValue stri
 			return fmt.Errorf("resource not found: %s", resourceName)
 		}
 
-		conn := acc.TestAccProvider.Meta().(*conns.Client).NetworkingAPI
+		conn := acc.TestAccProvider.Meta().(*conns.Client).NetworkingAPI()
 		var lastValue string
 
 		for i := 0; i < maxRetries; i++ {
@@ -129,8 +129,8 @@ func waitForNetworkFunctionHealth(resourceName, attributeName, desiredValue stri
 
 func testAccCheckNetworkFunctionResourcesDestroy(state *terraform.State) error {
 	conn := acc.TestAccProvider.Meta().(*conns.Client)
-	vmClient := conn.VmmAPI.VMAPIInstance
-	netClient := conn.NetworkingAPI
+	vmClient := conn.VmmAPI().VMAPIInstance
+	netClient := conn.NetworkingAPI()
 
 	for _, rs := range state.RootModule().Resources {
 		switch rs.Type {
@@ -24,6 +24,10 @@ type TestConfig struct {
 				EndIP   string `json:"end_ip"`
 			}
 		}
+		Layer2Stretch struct {
+			RemoteSubnetExtID    string `json:"remote_subnet_ext_id"`
+			RemotePcClusterExtID string `json:"remote_pc_cluster_ext_id"`
+		} `json:"layer2_stretch"`
 	} `json:"networking"`
 }
 
@@ -0,0 +1,423 @@
+package networkingv2
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/networking/v4/config"
+	import4 "github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/prism/v4/config"
+	prismConfig "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func ResourceNutanixBgpSessionV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceNutanixBgpSessionV2Create,
+		ReadContext:   ResourceNutanixBgpSessionV2Read,
+		UpdateContext: ResourceNutanixBgpSessionV2Update,
+		DeleteContext: ResourceNutanixBgpSessionV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"ext_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"local_gateway_reference": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"remote_gateway_reference": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"local_gateway_interface_ip_address": schemaForGatewayIPAddress(),
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"dynamic_route_priority": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"should_advertise_all_externally_routable_prefixes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"externally_routable_prefixes_to_advertise": schemaForIPSubnetPrefixes(),
+			"advertised_routes_communities": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"autonomous_system_number": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"community_value": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			"prepended_autonomous_system_path": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"status": schemaForNetworkingStatus(),
+			"links": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"href": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rel": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"metadata": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: DatasourceMetadataSchemaV2(),
+				},
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func ResourceNutanixBgpSessionV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	inputSpec := import1.NewBgpSession()
+
+	inputSpec.Name = utils.StringPtr(d.Get("name").(string))
+	inputSpec.LocalGatewayReference = utils.StringPtr(d.Get("local_gateway_reference").(string))
+	inputSpec.RemoteGatewayReference = utils.StringPtr(d.Get("remote_gateway_reference").(string))
+
+	if description, ok := d.GetOk("description"); ok {
+		inputSpec.Description = utils.StringPtr(description.(string))
+	}
+	if ipAddress, ok := d.GetOk("local_gateway_interface_ip_address"); ok {
+		inputSpec.LocalGatewayInterfaceIpAddress = expandGatewayIPAddress(ipAddress)
+	}
+	if password, ok := d.GetOk("password"); ok {
+		inputSpec.Password = utils.StringPtr(password.(string))
+	}
+	if priority, ok := d.GetOk("dynamic_route_priority"); ok {
+		inputSpec.DynamicRoutePriority = utils.IntPtr(priority.(int))
+	}
+	if advertiseAll, ok := d.GetOkExists("should_advertise_all_externally_routable_prefixes"); ok {
+		inputSpec.ShouldAdvertiseAllExternallyRoutablePrefixes = utils.BoolPtr(advertiseAll.(bool))
+	}
+	if prefixes, ok := d.GetOk("externally_routable_prefixes_to_advertise"); ok {
+		inputSpec.ExternallyRoutablePrefixesToAdvertise = expandIPSubnet(prefixes.([]interface{}))
+	}
+	if communities, ok := d.GetOk("advertised_routes_communities"); ok {
+		inputSpec.AdvertisedRoutesCommunities = expandBgpCommunities(communities.([]interface{}))
+	}
+	if asPath, ok := d.GetOk("prepended_autonomous_system_path"); ok {
+		inputSpec.PrependedAutonomousSystemPath = expandAutonomousSystemPath(asPath.([]interface{}))
+	}
+
+	aJSON, _ := json.MarshalIndent(inputSpec, "", " ")
+	log.Printf("[DEBUG] BGP Session create payload : %s", string(aJSON))
+
+	resp, err := conn.BgpSessionAPIInstance.CreateBgpSession(inputSpec)
+	if err != nil {
+		return diag.Errorf("error while creating BGP session : %v", err)
+	}
+
+	TaskRef := resp.Data.GetValue().(import4.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	// calling group API to poll for completion of task
+	taskconn := meta.(*conns.Client).PrismAPI()
+
+	// Wait for the BGP session to be created
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
+		return diag.Errorf("error waiting for BGP session (%s) to create: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+
+	// Get UUID from TASK API
+	taskResp, err := taskconn.TaskRefAPI.GetTaskById(taskUUID, nil)
+	if err != nil {
+		return diag.Errorf("error while fetching BGP session task: %v", err)
+	}
+	taskDetails := taskResp.Data.GetValue().(prismConfig.Task)
+	aJSON, _ = json.MarshalIndent(taskDetails, "", "  ")
+	log.Printf("[DEBUG] Create BGP Session Task Details: %s", string(aJSON))
+
+	uuid, err := common.ExtractEntityUUIDFromTask(taskDetails, utils.RelEntityTypeBgpSession, "BGP session")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(utils.StringValue(uuid))
+	return ResourceNutanixBgpSessionV2Read(ctx, d, meta)
+}
+
+func ResourceNutanixBgpSessionV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	resp, err := conn.BgpSessionAPIInstance.GetBgpSessionById(utils.StringPtr(d.Id()))
+	if err != nil {
+		return diag.Errorf("error while fetching BGP session : %v", err)
+	}
+
+	getResp := resp.Data.GetValue().(import1.BgpSession)
+
+	if err := d.Set("ext_id", getResp.ExtId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", getResp.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", getResp.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("local_gateway_reference", getResp.LocalGatewayReference); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("remote_gateway_reference", getResp.RemoteGatewayReference); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("local_gateway_interface_ip_address", flattenIPAddress(getResp.LocalGatewayInterfaceIpAddress)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("dynamic_route_priority", getResp.DynamicRoutePriority); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("should_advertise_all_externally_routable_prefixes", getResp.ShouldAdvertiseAllExternallyRoutablePrefixes); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("externally_routable_prefixes_to_advertise", flattenExternallyRoutablePrefixes(getResp.ExternallyRoutablePrefixesToAdvertise)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("advertised_routes_communities", flattenBgpCommunities(getResp.AdvertisedRoutesCommunities)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("prepended_autonomous_system_path", getResp.PrependedAutonomousSystemPath); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("status", flattenNetworkingStatus(getResp.Status)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("links", flattenLinks(getResp.Links)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("metadata", flattenMetadata(getResp.Metadata)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("tenant_id", getResp.TenantId); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func ResourceNutanixBgpSessionV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	readResp, err := conn.BgpSessionAPIInstance.GetBgpSessionById(utils.StringPtr(d.Id()))
+	if err != nil {
+		return diag.Errorf("error while fetching BGP session : %v", err)
+	}
+
+	updateSpec := readResp.Data.GetValue().(import1.BgpSession)
+	// Extract E-Tag Header
+	etagValue := conn.BgpSessionAPIInstance.ApiClient.GetEtag(readResp)
+
+	args := make(map[string]interface{})
+	args["If-Match"] = utils.StringPtr(etagValue)
+
+	if d.HasChange("name") {
+		updateSpec.Name = utils.StringPtr(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		updateSpec.Description = utils.StringPtr(d.Get("description").(string))
+	}
+	if d.HasChange("local_gateway_interface_ip_address") {
+		updateSpec.LocalGatewayInterfaceIpAddress = expandGatewayIPAddress(d.Get("local_gateway_interface_ip_address"))
+	}
+	if d.HasChange("password") {
+		updateSpec.Password = utils.StringPtr(d.Get("password").(string))
+	}
+	if d.HasChange("dynamic_route_priority") {
+		updateSpec.DynamicRoutePriority = utils.IntPtr(d.Get("dynamic_route_priority").(int))
+	}
+	if d.HasChange("should_advertise_all_externally_routable_prefixes") {
+		updateSpec.ShouldAdvertiseAllExternallyRoutablePrefixes = utils.BoolPtr(d.Get("should_advertise_all_externally_routable_prefixes").(bool))
+	}
+	if d.HasChange("externally_routable_prefixes_to_advertise") {
+		updateSpec.ExternallyRoutablePrefixesToAdvertise = expandIPSubnet(d.Get("externally_routable_prefixes_to_advertise").([]interface{}))
+	}
+	if d.HasChange("advertised_routes_communities") {
+		updateSpec.AdvertisedRoutesCommunities = expandBgpCommunities(d.Get("advertised_routes_communities").([]interface{}))
+	}
+	if d.HasChange("prepended_autonomous_system_path") {
+		updateSpec.PrependedAutonomousSystemPath = expandAutonomousSystemPath(d.Get("prepended_autonomous_system_path").([]interface{}))
+	}
+	// the expanded gateways are read only, the references identify them
+	updateSpec.LocalGateway = nil
+	updateSpec.RemoteGateway = nil
+
+	updateResp, err := conn.BgpSessionAPIInstance.UpdateBgpSessionById(utils.StringPtr(d.Id()), &updateSpec, args)
+	if err != nil {
+		return diag.Errorf("error while updating BGP session : %v", err)
+	}
+
+	TaskRef := updateResp.Data.GetValue().(import4.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	// calling group API to poll for completion of task
+	taskconn := meta.(*conns.Client).PrismAPI()
+
+	// Wait for the BGP session to be updated
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutUpdate)); errWaitTask != nil {
+		return diag.Errorf("error waiting for BGP session (%s) to update: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+	return ResourceNutanixBgpSessionV2Read(ctx, d, meta)
+}
+
+func ResourceNutanixBgpSessionV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	resp, err := conn.BgpSessionAPIInstance.DeleteBgpSessionById(utils.StringPtr(d.Id()))
+	if err != nil {
+		return diag.Errorf("error while deleting BGP session : %v", err)
+	}
+	TaskRef := resp.Data.GetValue().(import4.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	// calling group API to poll for completion of task
+	taskconn := meta.(*conns.Client).PrismAPI()
+
+	// Wait for the BGP session to be deleted
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutDelete)); errWaitTask != nil {
+		return diag.Errorf("error waiting for BGP session (%s) to delete: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+	return nil
+}
+
+// schemaForIPSubnetPrefixes matches the externally_routable_prefixes block of VPCs
+func schemaForIPSubnetPrefixes() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ipv4": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"ip": SchemaForValuePrefixLength(),
+							"prefix_length": {
+								Type:     schema.TypeInt,
+								Required: true,
+							},
+						},
+					},
+				},
+				"ipv6": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"ip": SchemaForValuePrefixLength(),
+							"prefix_length": {
+								Type:     schema.TypeInt,
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandBgpCommunities(pr []interface{}) []import1.BgpCommunity {
+	if len(pr) == 0 {
+		return nil
+	}
+	communities := make([]import1.BgpCommunity, 0)
+
+	for _, v := range pr {
+		val := v.(map[string]interface{})
+		community := import1.NewBgpCommunity()
+
+		community.AutonomousSystemNumber = utils.IntPtr(val["autonomous_system_number"].(int))
+		community.CommunityValue = utils.IntPtr(val["community_value"].(int))
+
+		communities = append(communities, *community)
+	}
+	return communities
+}
+
+func expandAutonomousSystemPath(pr []interface{}) []int64 {
+	if len(pr) == 0 {
+		return nil
+	}
+	asPath := make([]int64, 0)
+
+	for _, v := range pr {
+		asPath = append(asPath, int64(v.(int)))
+	}
+	return asPath
+}
+
+func flattenBgpCommunities(pr []import1.BgpCommunity) []map[string]interface{} {
+	if len(pr) == 0 {
+		return nil
+	}
+	communities := make([]map[string]interface{}, len(pr))
+
+	for k, v := range pr {
+		community := make(map[string]interface{})
+
+		community["autonomous_system_number"] = utils.IntValue(v.AutonomousSystemNumber)
+		community["community_value"] = utils.IntValue(v.CommunityValue)
+
+		communities[k] = community
+	}
+	return communities
+}
@@ -0,0 +1,88 @@
+package networkingv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+)
+
+const resourceNameBgpSession = "nutanix_bgp_session_v2.test"
+
+func TestAccV2NutanixBgpSessionResource_Basic(t *testing.T) {
+	r := acctest.RandInt()
+	vlanID := acctest.RandIntRange(1, 999)
+	name := fmt.Sprintf("tf-test-bgp-session-%d", r)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBgpSessionConfig(name, vlanID, 100),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameBgpSession, "name", name),
+					resource.TestCheckResourceAttr(resourceNameBgpSession, "dynamic_route_priority", "100"),
+					resource.TestCheckResourceAttrPair(resourceNameBgpSession, "remote_gateway_reference", "nutanix_vpn_gateway_v2.remote", "id"),
+					resource.TestCheckResourceAttrPair("data.nutanix_bgp_session_v2.test", "name", resourceNameBgpSession, "name"),
+					resource.TestCheckResourceAttrSet("data.nutanix_bgp_sessions_v2.test", "bgp_sessions.#"),
+				),
+			},
+			{
+				Config: testBgpSessionConfig(name, vlanID, 200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameBgpSession, "dynamic_route_priority", "200"),
+				),
+			},
+		},
+	})
+}
+
+func testBgpSessionConfig(name string, vlanID, priority int) string {
+	return testLocalGatewayBaseConfig(vlanID) + fmt.Sprintf(`
+	resource "nutanix_vpn_gateway_v2" "local" {
+		name          = "%[1]s-local"
+		vpc_reference = nutanix_vpc_v2.test.id
+		deployment {
+			cluster_reference = local.cluster0
+		}
+		local_services {
+			local_bgp_service {
+				asn           = 65001
+				vpc_reference = nutanix_vpc_v2.test.id
+			}
+		}
+	}
+
+	resource "nutanix_vpn_gateway_v2" "remote" {
+		name = "%[1]s-remote"
+		remote_services {
+			remote_bgp_service {
+				asn = 65002
+				address {
+					ipv4 {
+						value = "192.168.0.1"
+					}
+				}
+			}
+		}
+	}
+
+	resource "nutanix_bgp_session_v2" "test" {
+		name                     = "%[1]s"
+		local_gateway_reference  = nutanix_vpn_gateway_v2.local.id
+		remote_gateway_reference = nutanix_vpn_gateway_v2.remote.id
+		dynamic_route_priority   = %[2]d
+		should_advertise_all_externally_routable_prefixes = true
+	}
+
+	data "nutanix_bgp_session_v2" "test" {
+		ext_id = nutanix_bgp_session_v2.test.id
+	}
+
+	data "nutanix_bgp_sessions_v2" "test" {
+		depends_on = [nutanix_bgp_session_v2.test]
+	}
+`, name, priority)
+}
@@ -0,0 +1,289 @@
+package networkingv2
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/networking/v4/config"
+	import4 "github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/prism/v4/config"
+	prismConfig "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func ResourceNutanixIPFIXExporterV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceNutanixIPFIXExporterV2Create,
+		ReadContext:   ResourceNutanixIPFIXExporterV2Read,
+		UpdateContext: ResourceNutanixIPFIXExporterV2Update,
+		DeleteContext: ResourceNutanixIPFIXExporterV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"ext_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"collector_ip": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"collector_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"TCP", "UDP", "TLS_TCP"}, false),
+			},
+			"export_rate_limit_per_node_bps": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"export_scopes": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uuid": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"scope_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"PC", "PE"}, false),
+						},
+						"ip_family": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"V4", "V6", "BOTH"}, false),
+						},
+					},
+				},
+			},
+			"links": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"href": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rel": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"metadata": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: DatasourceMetadataSchemaV2(),
+				},
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func ResourceNutanixIPFIXExporterV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	inputSpec := import1.NewIPFIXExporter()
+
+	inputSpec.Name = utils.StringPtr(d.Get("name").(string))
+	inputSpec.CollectorIp = utils.StringPtr(d.Get("collector_ip").(string))
+	inputSpec.CollectorPort = utils.Int64Ptr(int64(d.Get("collector_port").(int)))
+	inputSpec.Protocol = common.ExpandEnum[import1.ExporterProtocol](d.Get("protocol"))
+	inputSpec.ExportScopes = expandExportScopes(d.Get("export_scopes").([]interface{}))
+
+	if description, ok := d.GetOk("description"); ok {
+		inputSpec.Description = utils.StringPtr(description.(string))
+	}
+	if rateLimit, ok := d.GetOk("export_rate_limit_per_node_bps"); ok {
+		inputSpec.ExportRateLimitPerNodeBps = utils.Int64Ptr(int64(rateLimit.(int)))
+	}
+
+	aJSON, _ := json.MarshalIndent(inputSpec, "", " ")
+	log.Printf("[DEBUG] IPFIX Exporter create payload : %s", string(aJSON))
+
+	resp, err := conn.IPFIXExporterAPIInstance.CreateIpfixExporter(inputSpec)
+	if err != nil {
+		return diag.Errorf("error while creating IPFIX exporter : %v", err)
+	}
+
+	TaskRef := resp.Data.GetValue().(import4.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	// calling group API to poll for completion of task
+	taskconn := meta.(*conns.Client).PrismAPI()
+
+	// Wait for the IPFIX exporter to be created
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
+		return diag.Errorf("error waiting for IPFIX exporter (%s) to create: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+
+	// Get UUID from TASK API
+	taskResp, err := taskconn.TaskRefAPI.GetTaskById(taskUUID, nil)
+	if err != nil {
+		return diag.Errorf("error while fetching IPFIX exporter task: %v", err)
+	}
+	taskDetails := taskResp.Data.GetValue().(prismConfig.Task)
+	aJSON, _ = json.MarshalIndent(taskDetails, "", "  ")
+	log.Printf("[DEBUG] Create IPFIX Exporter Task Details: %s", string(aJSON))
+
+	uuid, err := common.ExtractEntityUUIDFromTask(taskDetails, utils.RelEntityTypeIPFIXExporter, "IPFIX exporter")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(utils.StringValue(uuid))
+	return ResourceNutanixIPFIXExporterV2Read(ctx, d, meta)
+}
+
+func ResourceNutanixIPFIXExporterV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	resp, err := conn.IPFIXExporterAPIInstance.GetIpfixExporterById(utils.StringPtr(d.Id()))
+	if err != nil {
+		return diag.Errorf("error while fetching IPFIX exporter : %v", err)
+	}
+
+	getResp := resp.Data.GetValue().(import1.IPFIXExporter)
+
+	for key, value := range flattenIPFIXExporter(getResp) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func ResourceNutanixIPFIXExporterV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	readResp, err := conn.IPFIXExporterAPIInstance.GetIpfixExporterById(utils.StringPtr(d.Id()))
+	if err != nil {
+		return diag.Errorf("error while fetching IPFIX exporter : %v", err)
+	}
+
+	updateSpec := readResp.Data.GetValue().(import1.IPFIXExporter)
+	// Extract E-Tag Header
+	etagValue := conn.IPFIXExporterAPIInstance.ApiClient.GetEtag(readResp)
+
+	args := make(map[string]interface{})
+	args["If-Match"] = utils.StringPtr(etagValue)
+
+	if d.HasChange("name") {
+		updateSpec.Name = utils.StringPtr(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		updateSpec.Description = utils.StringPtr(d.Get("description").(string))
+	}
+	if d.HasChange("collector_ip") {
+		updateSpec.CollectorIp = utils.StringPtr(d.Get("collector_ip").(string))
+	}
+	if d.HasChange("collector_port") {
+		updateSpec.CollectorPort = utils.Int64Ptr(int64(d.Get("collector_port").(int)))
+	}
+	if d.HasChange("protocol") {
+		updateSpec.Protocol = common.ExpandEnum[import1.ExporterProtocol](d.Get("protocol"))
+	}
+	if d.HasChange("export_rate_limit_per_node_bps") {
+		updateSpec.ExportRateLimitPerNodeBps = utils.Int64Ptr(int64(d.Get("export_rate_limit_per_node_bps").(int)))
+	}
+	if d.HasChange("export_scopes") {
+		updateSpec.ExportScopes = expandExportScopes(d.Get("export_scopes").([]interface{}))
+	}
+
+	updateResp, err := conn.IPFIXExporterAPIInstance.UpdateIpfixExporterById(utils.StringPtr(d.Id()), &updateSpec, args)
+	if err != nil {
+		return diag.Errorf("error while updating IPFIX exporter : %v", err)
+	}
+
+	TaskRef := updateResp.Data.GetValue().(import4.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	// calling group API to poll for completion of task
+	taskconn := meta.(*conns.Client).PrismAPI()
+
+	// Wait for the IPFIX exporter to be updated
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutUpdate)); errWaitTask != nil {
+		return diag.Errorf("error waiting for IPFIX exporter (%s) to update: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+	return ResourceNutanixIPFIXExporterV2Read(ctx, d, meta)
+}
+
+func ResourceNutanixIPFIXExporterV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	resp, err := conn.IPFIXExporterAPIInstance.DeleteIpfixExporterById(utils.StringPtr(d.Id()))
+	if err != nil {
+		return diag.Errorf("error while deleting IPFIX exporter : %v", err)
+	}
+	TaskRef := resp.Data.GetValue().(import4.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	// calling group API to poll for completion of task
+	taskconn := meta.(*conns.Client).PrismAPI()
+
+	// Wait for the IPFIX exporter to be deleted
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutDelete)); errWaitTask != nil {
+		return diag.Errorf("error waiting for IPFIX exporter (%s) to delete: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+	return nil
+}
+
+func expandExportScopes(pr []interface{}) []import1.ExportScope {
+	if len(pr) == 0 {
+		return nil
+	}
+
+	scopes := make([]import1.ExportScope, len(pr))
+	for k, v := range pr {
+		val := v.(map[string]interface{})
+		scope := import1.NewExportScope()
+
+		if uuid, ok := val["uuid"]; ok && uuid.(string) != "" {
+			scope.Uuid = utils.StringPtr(uuid.(string))
+		}
+		if scopeType, ok := val["scope_type"]; ok && scopeType.(string) != "" {
+			scope.ScopeType = common.ExpandEnum[import1.ScopeType](scopeType)
+		}
+		if ipFamily, ok := val["ip_family"]; ok && ipFamily.(string) != "" {
+			scope.IpFamily = common.ExpandEnum[import1.IpFamily](ipFamily)
+		}
+
+		scopes[k] = *scope
+	}
+	return scopes
+}
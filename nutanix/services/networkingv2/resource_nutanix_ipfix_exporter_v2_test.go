@@ -0,0 +1,89 @@
+package networkingv2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+)
+
+const resourceNameIPFIXExporter = "nutanix_ipfix_exporter_v2.test"
+
+func TestAccV2NutanixIPFIXExporterResource_Basic(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-ipfix-exporter-%d", r)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testIPFIXExporterV2Config(name, "10.10.20.15", 4739),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameIPFIXExporter, "name", name),
+					resource.TestCheckResourceAttr(resourceNameIPFIXExporter, "collector_ip", "10.10.20.15"),
+					resource.TestCheckResourceAttr(resourceNameIPFIXExporter, "collector_port", "4739"),
+					resource.TestCheckResourceAttr(resourceNameIPFIXExporter, "protocol", "UDP"),
+					resource.TestCheckResourceAttr(resourceNameIPFIXExporter, "export_scopes.#", "1"),
+					resource.TestCheckResourceAttr(resourceNameIPFIXExporter, "export_scopes.0.scope_type", "PE"),
+					resource.TestCheckResourceAttr(resourceNameIPFIXExporter, "export_scopes.0.ip_family", "V4"),
+				),
+			},
+			{
+				Config: testIPFIXExporterV2Config(name, "10.10.20.16", 4740),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameIPFIXExporter, "collector_ip", "10.10.20.16"),
+					resource.TestCheckResourceAttr(resourceNameIPFIXExporter, "collector_port", "4740"),
+				),
+			},
+			{
+				ResourceName:      resourceNameIPFIXExporter,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixIPFIXExporterResource_InvalidCollectorPort(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-ipfix-exporter-%d", r)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testIPFIXExporterV2Config(name, "10.10.20.15", 70000),
+				ExpectError: regexp.MustCompile("expected collector_port to be a valid port number"),
+			},
+		},
+	})
+}
+
+func testIPFIXExporterV2Config(name, collectorIP string, collectorPort int) string {
+	return fmt.Sprintf(`
+		data "nutanix_clusters_v2" "clusters" {}
+
+		locals {
+			cluster0 = [
+				for cluster in data.nutanix_clusters_v2.clusters.cluster_entities :
+				cluster.ext_id if cluster.config[0].cluster_function[0] != "PRISM_CENTRAL"
+			][0]
+		}
+
+		resource "nutanix_ipfix_exporter_v2" "test" {
+			name           = "%[1]s"
+			description    = "test ipfix exporter description"
+			collector_ip   = "%[2]s"
+			collector_port = %[3]d
+			protocol       = "UDP"
+			export_scopes {
+				uuid       = local.cluster0
+				scope_type = "PE"
+				ip_family  = "V4"
+			}
+		}
+`, name, collectorIP, collectorPort)
+}
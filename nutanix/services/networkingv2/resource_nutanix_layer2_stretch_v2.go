@@ -0,0 +1,466 @@
+package networkingv2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/networking/v4/config"
+	import4 "github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/prism/v4/config"
+	prismConfig "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func ResourceNutanixLayer2StretchV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceNutanixLayer2StretchV2Create,
+		ReadContext:   ResourceNutanixLayer2StretchV2Read,
+		UpdateContext: ResourceNutanixLayer2StretchV2Update,
+		DeleteContext: ResourceNutanixLayer2StretchV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"ext_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"connection_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"VPN", "VXLAN"}, false),
+			},
+			"mtu": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"vni": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"local_site_params":        schemaForStretchSiteParams(),
+			"remote_site_params":       schemaForStretchSiteParams(),
+			"stretch_status":           schemaForStretchStatus(),
+			"high_availability_status": schemaForStretchHighAvailabilityStatus(),
+			"remote_stretch_status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address":                  schemaForGatewayIPAddress(),
+						"status":                   schemaForStretchStatus(),
+						"high_availability_status": schemaForStretchHighAvailabilityStatus(),
+					},
+				},
+			},
+			"links": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"href": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rel": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"metadata": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: DatasourceMetadataSchemaV2(),
+				},
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func ResourceNutanixLayer2StretchV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	inputSpec := import1.NewLayer2Stretch()
+
+	inputSpec.Name = utils.StringPtr(d.Get("name").(string))
+	inputSpec.LocalSiteParams = expandStretchSiteParams(d.Get("local_site_params").([]interface{}))
+	inputSpec.RemoteSiteParams = expandStretchSiteParams(d.Get("remote_site_params").([]interface{}))
+
+	if description, ok := d.GetOk("description"); ok {
+		inputSpec.Description = utils.StringPtr(description.(string))
+	}
+	if connectionType, ok := d.GetOk("connection_type"); ok {
+		inputSpec.ConnectionType = common.ExpandEnum[import1.StretchConnectionType](connectionType)
+	}
+	if mtu, ok := d.GetOk("mtu"); ok {
+		inputSpec.Mtu = utils.IntPtr(mtu.(int))
+	}
+	if vni, ok := d.GetOk("vni"); ok {
+		inputSpec.Vni = utils.IntPtr(vni.(int))
+	}
+
+	aJSON, _ := json.MarshalIndent(inputSpec, "", " ")
+	log.Printf("[DEBUG] Layer2 Stretch create payload : %s", string(aJSON))
+
+	resp, err := conn.Layer2StretchAPIInstance.CreateLayer2Stretch(inputSpec)
+	if err != nil {
+		return diag.Errorf("error while creating layer2 stretch : %v", err)
+	}
+
+	TaskRef := resp.Data.GetValue().(import4.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	// calling group API to poll for completion of task
+	taskconn := meta.(*conns.Client).PrismAPI()
+
+	// Wait for the layer2 stretch to be created
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
+		return diag.Errorf("error waiting for layer2 stretch (%s) to create: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+
+	// Get UUID from TASK API
+	taskResp, err := taskconn.TaskRefAPI.GetTaskById(taskUUID, nil)
+	if err != nil {
+		return diag.Errorf("error while fetching layer2 stretch task: %v", err)
+	}
+	taskDetails := taskResp.Data.GetValue().(prismConfig.Task)
+	aJSON, _ = json.MarshalIndent(taskDetails, "", "  ")
+	log.Printf("[DEBUG] Create Layer2 Stretch Task Details: %s", string(aJSON))
+
+	uuid, err := common.ExtractEntityUUIDFromTask(taskDetails, utils.RelEntityTypeLayer2Stretch, "Layer2 stretch")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(utils.StringValue(uuid))
+	return ResourceNutanixLayer2StretchV2Read(ctx, d, meta)
+}
+
+func ResourceNutanixLayer2StretchV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	resp, err := conn.Layer2StretchAPIInstance.GetLayer2StretchById(utils.StringPtr(d.Id()))
+	if err != nil {
+		return diag.Errorf("error while fetching layer2 stretch : %v", err)
+	}
+
+	getResp := resp.Data.GetValue().(import1.Layer2Stretch)
+
+	for key, value := range flattenLayer2Stretch(getResp) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return layer2StretchStatusWarnings(getResp)
+}
+
+func ResourceNutanixLayer2StretchV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	readResp, err := conn.Layer2StretchAPIInstance.GetLayer2StretchById(utils.StringPtr(d.Id()))
+	if err != nil {
+		return diag.Errorf("error while fetching layer2 stretch : %v", err)
+	}
+
+	updateSpec := readResp.Data.GetValue().(import1.Layer2Stretch)
+	// Extract E-Tag Header
+	etagValue := conn.Layer2StretchAPIInstance.ApiClient.GetEtag(readResp)
+
+	args := make(map[string]interface{})
+	args["If-Match"] = utils.StringPtr(etagValue)
+
+	if d.HasChange("name") {
+		updateSpec.Name = utils.StringPtr(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		updateSpec.Description = utils.StringPtr(d.Get("description").(string))
+	}
+	if d.HasChange("connection_type") {
+		updateSpec.ConnectionType = common.ExpandEnum[import1.StretchConnectionType](d.Get("connection_type"))
+	}
+	if d.HasChange("mtu") {
+		updateSpec.Mtu = utils.IntPtr(d.Get("mtu").(int))
+	}
+	if d.HasChange("vni") {
+		updateSpec.Vni = utils.IntPtr(d.Get("vni").(int))
+	}
+	if d.HasChange("local_site_params") {
+		updateSpec.LocalSiteParams = expandStretchSiteParams(d.Get("local_site_params").([]interface{}))
+	}
+	if d.HasChange("remote_site_params") {
+		updateSpec.RemoteSiteParams = expandStretchSiteParams(d.Get("remote_site_params").([]interface{}))
+	}
+	// runtime status is read only
+	updateSpec.StretchStatus = nil
+	updateSpec.RemoteStretchStatus = nil
+	updateSpec.HighAvailabilityStatus = nil
+
+	updateResp, err := conn.Layer2StretchAPIInstance.UpdateLayer2StretchById(utils.StringPtr(d.Id()), &updateSpec, args)
+	if err != nil {
+		return diag.Errorf("error while updating layer2 stretch : %v", err)
+	}
+
+	TaskRef := updateResp.Data.GetValue().(import4.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	// calling group API to poll for completion of task
+	taskconn := meta.(*conns.Client).PrismAPI()
+
+	// Wait for the layer2 stretch to be updated
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutUpdate)); errWaitTask != nil {
+		return diag.Errorf("error waiting for layer2 stretch (%s) to update: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+	return ResourceNutanixLayer2StretchV2Read(ctx, d, meta)
+}
+
+func ResourceNutanixLayer2StretchV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	resp, err := conn.Layer2StretchAPIInstance.DeleteLayer2StretchById(utils.StringPtr(d.Id()))
+	if err != nil {
+		return diag.Errorf("error while deleting layer2 stretch : %v", err)
+	}
+	TaskRef := resp.Data.GetValue().(import4.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	// calling group API to poll for completion of task
+	taskconn := meta.(*conns.Client).PrismAPI()
+
+	// Wait for the layer2 stretch to be deleted
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutDelete)); errWaitTask != nil {
+		return diag.Errorf("error waiting for layer2 stretch (%s) to delete: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+	return nil
+}
+
+func schemaForStretchSiteParams() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"stretch_subnet_reference": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"connection_reference": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"pc_cluster_reference": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"default_gateway_ip_address":   schemaForGatewayIPAddress(),
+				"stretch_interface_ip_address": schemaForGatewayIPAddress(),
+				"vpn_interface_ip_address":     schemaForGatewayIPAddress(),
+			},
+		},
+	}
+}
+
+func schemaForStretchStatus() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"detail": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"interface_state": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"tunnel_state": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"round_trip_time_millis": {
+					Type:     schema.TypeFloat,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func schemaForStretchHighAvailabilityStatus() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"state": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"error_details": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func expandStretchSiteParams(pr []interface{}) *import1.SiteParams {
+	if len(pr) == 0 || pr[0] == nil {
+		return nil
+	}
+	val := pr[0].(map[string]interface{})
+	siteParams := import1.NewSiteParams()
+
+	siteParams.StretchSubnetReference = utils.StringPtr(val["stretch_subnet_reference"].(string))
+
+	if connectionReference, ok := val["connection_reference"]; ok && connectionReference.(string) != "" {
+		siteParams.ConnectionReference = utils.StringPtr(connectionReference.(string))
+	}
+	if pcClusterReference, ok := val["pc_cluster_reference"]; ok && pcClusterReference.(string) != "" {
+		siteParams.PcClusterReference = utils.StringPtr(pcClusterReference.(string))
+	}
+	if defaultGateway, ok := val["default_gateway_ip_address"]; ok {
+		siteParams.DefaultGatewayIPAddress = expandGatewayIPAddress(defaultGateway)
+	}
+	if stretchInterface, ok := val["stretch_interface_ip_address"]; ok {
+		siteParams.StretchInterfaceIpAddress = expandGatewayIPAddress(stretchInterface)
+	}
+	if vpnInterface, ok := val["vpn_interface_ip_address"]; ok {
+		siteParams.VpnInterfaceIPAddress = expandGatewayIPAddress(vpnInterface)
+	}
+	return siteParams
+}
+
+func flattenLayer2Stretch(pr import1.Layer2Stretch) map[string]interface{} {
+	stretch := make(map[string]interface{})
+
+	stretch["ext_id"] = utils.StringValue(pr.ExtId)
+	stretch["name"] = utils.StringValue(pr.Name)
+	stretch["description"] = utils.StringValue(pr.Description)
+	if pr.ConnectionType != nil {
+		stretch["connection_type"] = pr.ConnectionType.GetName()
+	}
+	stretch["mtu"] = utils.IntValue(pr.Mtu)
+	stretch["vni"] = utils.IntValue(pr.Vni)
+	stretch["local_site_params"] = flattenStretchSiteParams(pr.LocalSiteParams)
+	stretch["remote_site_params"] = flattenStretchSiteParams(pr.RemoteSiteParams)
+	stretch["stretch_status"] = flattenStretchStatus(pr.StretchStatus)
+	stretch["high_availability_status"] = flattenStretchHighAvailabilityStatus(pr.HighAvailabilityStatus)
+
+	remoteStatus := make([]map[string]interface{}, len(pr.RemoteStretchStatus))
+	for k, v := range pr.RemoteStretchStatus {
+		remoteStatus[k] = map[string]interface{}{
+			"address":                  flattenIPAddress(v.Address),
+			"status":                   flattenStretchStatus(v.Status),
+			"high_availability_status": flattenStretchHighAvailabilityStatus(v.HighAvailabilityStatus),
+		}
+	}
+	stretch["remote_stretch_status"] = remoteStatus
+
+	stretch["links"] = flattenLinks(pr.Links)
+	stretch["metadata"] = flattenMetadata(pr.Metadata)
+	stretch["tenant_id"] = utils.StringValue(pr.TenantId)
+
+	return stretch
+}
+
+func flattenStretchSiteParams(pr *import1.SiteParams) []map[string]interface{} {
+	if pr == nil {
+		return nil
+	}
+	siteParams := make(map[string]interface{})
+
+	siteParams["stretch_subnet_reference"] = utils.StringValue(pr.StretchSubnetReference)
+	siteParams["connection_reference"] = utils.StringValue(pr.ConnectionReference)
+	siteParams["pc_cluster_reference"] = utils.StringValue(pr.PcClusterReference)
+	siteParams["default_gateway_ip_address"] = flattenIPAddress(pr.DefaultGatewayIPAddress)
+	siteParams["stretch_interface_ip_address"] = flattenIPAddress(pr.StretchInterfaceIpAddress)
+	siteParams["vpn_interface_ip_address"] = flattenIPAddress(pr.VpnInterfaceIPAddress)
+
+	return []map[string]interface{}{siteParams}
+}
+
+func flattenStretchStatus(pr *import1.StretchStatus) []map[string]interface{} {
+	if pr == nil {
+		return nil
+	}
+	status := make(map[string]interface{})
+
+	status["detail"] = utils.StringValue(pr.Detail)
+	if pr.InterfaceState != nil {
+		status["interface_state"] = pr.InterfaceState.GetName()
+	}
+	if pr.TunnelState != nil {
+		status["tunnel_state"] = pr.TunnelState.GetName()
+	}
+	if pr.RoundTripTimeMillis != nil {
+		status["round_trip_time_millis"] = float64(*pr.RoundTripTimeMillis)
+	}
+
+	return []map[string]interface{}{status}
+}
+
+func flattenStretchHighAvailabilityStatus(pr *import1.HighAvailabilityStatus) []map[string]interface{} {
+	if pr == nil {
+		return nil
+	}
+	status := make(map[string]interface{})
+
+	if pr.State != nil {
+		status["state"] = pr.State.GetName()
+	}
+	status["error_details"] = utils.StringValue(pr.ErrorDetails)
+
+	return []map[string]interface{}{status}
+}
+
+// layer2StretchStatusWarnings surfaces a stretch that is not up during refresh, so it shows up at plan time
+func layer2StretchStatusWarnings(pr import1.Layer2Stretch) diag.Diagnostics {
+	if pr.StretchStatus == nil {
+		return nil
+	}
+	var diags diag.Diagnostics
+	states := map[string]*import1.State{
+		"interface": pr.StretchStatus.InterfaceState,
+		"tunnel":    pr.StretchStatus.TunnelState,
+	}
+	for _, kind := range []string{"interface", "tunnel"} {
+		if state := states[kind]; state != nil && *state != import1.STATE_UP {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("layer2 stretch %s: %s state is %s", utils.StringValue(pr.Name), kind, state.GetName()),
+				Detail:   utils.StringValue(pr.StretchStatus.Detail),
+			})
+		}
+	}
+	return diags
+}
@@ -0,0 +1,74 @@
+package networkingv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+)
+
+const resourceNameLayer2Stretch = "nutanix_layer2_stretch_v2.test"
+
+func TestAccV2NutanixLayer2StretchResource_Basic(t *testing.T) {
+	if testVars.Networking.Layer2Stretch.RemoteSubnetExtID == "" {
+		t.Skip("networking.layer2_stretch.remote_subnet_ext_id is not set in the test config")
+	}
+	r := acctest.RandInt()
+	vlanID := acctest.RandIntRange(1, 999)
+	name := fmt.Sprintf("tf-test-l2-stretch-%d", r)
+	desc := "test layer2 stretch description"
+	updatedDesc := "updated layer2 stretch description"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testLayer2StretchConfig(name, desc, vlanID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameLayer2Stretch, "name", name),
+					resource.TestCheckResourceAttr(resourceNameLayer2Stretch, "description", desc),
+					resource.TestCheckResourceAttr(resourceNameLayer2Stretch, "connection_type", "VPN"),
+					resource.TestCheckResourceAttrPair(resourceNameLayer2Stretch, "local_site_params.0.connection_reference", "nutanix_vpn_connection_v2.test", "id"),
+					resource.TestCheckResourceAttr(resourceNameLayer2Stretch, "remote_site_params.0.stretch_subnet_reference", testVars.Networking.Layer2Stretch.RemoteSubnetExtID),
+					resource.TestCheckResourceAttrSet(resourceNameLayer2Stretch, "stretch_status.#"),
+					resource.TestCheckResourceAttrPair("data.nutanix_layer2_stretch_v2.test", "name", resourceNameLayer2Stretch, "name"),
+					resource.TestCheckResourceAttrSet("data.nutanix_layer2_stretches_v2.test", "layer2_stretches.#"),
+				),
+			},
+			{
+				Config: testLayer2StretchConfig(name, updatedDesc, vlanID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameLayer2Stretch, "description", updatedDesc),
+				),
+			},
+		},
+	})
+}
+
+func testLayer2StretchConfig(name, desc string, vlanID int) string {
+	return testVpnConnectionConfig(name, vlanID, 30) + fmt.Sprintf(`
+	resource "nutanix_layer2_stretch_v2" "test" {
+		name            = "%[1]s"
+		description     = "%[2]s"
+		connection_type = "VPN"
+		local_site_params {
+			stretch_subnet_reference = nutanix_subnet_v2.test.id
+			connection_reference     = nutanix_vpn_connection_v2.test.id
+		}
+		remote_site_params {
+			stretch_subnet_reference = "%[3]s"
+			pc_cluster_reference     = "%[4]s"
+		}
+	}
+
+	data "nutanix_layer2_stretch_v2" "test" {
+		ext_id = nutanix_layer2_stretch_v2.test.id
+	}
+
+	data "nutanix_layer2_stretches_v2" "test" {
+		depends_on = [nutanix_layer2_stretch_v2.test]
+	}
+`, name, desc, testVars.Networking.Layer2Stretch.RemoteSubnetExtID, testVars.Networking.Layer2Stretch.RemotePcClusterExtID)
+}
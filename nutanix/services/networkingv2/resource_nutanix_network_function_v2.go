@@ -5,12 +5,10 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	import1 "github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/networking/v4/config"
 	import4 "github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/prism/v4/config"
-	prismConfig "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
 	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
 	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
 	"github.com/terraform-providers/terraform-provider-nutanix/utils"
@@ -135,7 +133,7 @@ func ResourceNutanixNetworkFunctionV2() *schema.Resource {
 }
 
 func ResourceNutanixNetworkFunctionV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).NetworkingAPI
+	conn := meta.(*conns.Client).NetworkingAPI()
 
 	inputSpec := import1.NetworkFunction{}
 
@@ -178,21 +176,14 @@ func ResourceNutanixNetworkFunctionV2Create(ctx context.Context, d *schema.Resou
 	}
 	taskUUID := taskRef.ExtId
 
-	taskconn := meta.(*conns.Client).PrismAPI
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutCreate),
-	}
-
-	taskRaw, errWait := stateConf.WaitForStateContext(ctx)
+	taskconn := meta.(*conns.Client).PrismAPI()
+	taskDetails, errWait := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate))
 	if errWait != nil {
 		return diag.Errorf("error waiting for network function (%s) to create: %s", utils.StringValue(taskUUID), errWait)
 	}
 
-	if taskDetails, ok := taskRaw.(prismConfig.Task); ok {
-		uuid, errUUID := common.ExtractEntityUUIDFromTask(taskDetails, utils.RelEntityTypeNetworkFunction, "Network function")
+	if taskDetails != nil {
+		uuid, errUUID := common.ExtractEntityUUIDFromTask(*taskDetails, utils.RelEntityTypeNetworkFunction, "Network function")
 		if errUUID == nil && uuid != nil && utils.StringValue(uuid) != "" {
 			d.SetId(utils.StringValue(uuid))
 			_ = d.Set("ext_id", utils.StringValue(uuid))
@@ -216,7 +207,7 @@ func ResourceNutanixNetworkFunctionV2Create(ctx context.Context, d *schema.Resou
 }
 
 func ResourceNutanixNetworkFunctionV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).NetworkingAPI
+	conn := meta.(*conns.Client).NetworkingAPI()
 
 	resp, err := conn.NetworkFunctionAPI.GetNetworkFunctionById(utils.StringPtr(d.Id()))
 	if err != nil {
@@ -275,7 +266,7 @@ func ResourceNutanixNetworkFunctionV2Read(ctx context.Context, d *schema.Resourc
 }
 
 func ResourceNutanixNetworkFunctionV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).NetworkingAPI
+	conn := meta.(*conns.Client).NetworkingAPI()
 
 	resp, err := conn.NetworkFunctionAPI.GetNetworkFunctionById(utils.StringPtr(d.Id()))
 	if err != nil {
@@ -348,15 +339,8 @@ func ResourceNutanixNetworkFunctionV2Update(ctx context.Context, d *schema.Resou
 	}
 	taskUUID := taskRef.ExtId
 
-	taskconn := meta.(*conns.Client).PrismAPI
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutUpdate),
-	}
-
-	if _, errWait := stateConf.WaitForStateContext(ctx); errWait != nil {
+	taskconn := meta.(*conns.Client).PrismAPI()
+	if _, errWait := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutUpdate)); errWait != nil {
 		return diag.Errorf("error waiting for network function (%s) to update: %s", utils.StringValue(taskUUID), errWait)
 	}
 
@@ -364,7 +348,7 @@ func ResourceNutanixNetworkFunctionV2Update(ctx context.Context, d *schema.Resou
 }
 
 func ResourceNutanixNetworkFunctionV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).NetworkingAPI
+	conn := meta.(*conns.Client).NetworkingAPI()
 
 	resp, err := conn.NetworkFunctionAPI.DeleteNetworkFunctionById(utils.StringPtr(d.Id()))
 	if err != nil {
@@ -378,15 +362,8 @@ func ResourceNutanixNetworkFunctionV2Delete(ctx context.Context, d *schema.Resou
 	}
 	taskUUID := taskRef.ExtId
 
-	taskconn := meta.(*conns.Client).PrismAPI
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutDelete),
-	}
-
-	if _, errWait := stateConf.WaitForStateContext(ctx); errWait != nil {
+	taskconn := meta.(*conns.Client).PrismAPI()
+	if _, errWait := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutDelete)); errWait != nil {
 		return diag.Errorf("error waiting for network function (%s) to delete: %s", utils.StringValue(taskUUID), errWait)
 	}
 
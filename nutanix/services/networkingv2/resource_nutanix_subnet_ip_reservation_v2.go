@@ -0,0 +1,298 @@
+package networkingv2
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/common/v1/config"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/networking/v4/config"
+	import4 "github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/prism/v4/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v4/networking"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+const reservedIpsPageLimit = 100
+
+// ResourceNutanixSubnetIPReservationV2 reserves IP addresses in an IPAM managed
+// subnet for consumers that are not VMs. The addresses are released on destroy.
+func ResourceNutanixSubnetIPReservationV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceNutanixSubnetIPReservationV2Create,
+		ReadContext:   ResourceNutanixSubnetIPReservationV2Read,
+		DeleteContext: ResourceNutanixSubnetIPReservationV2Delete,
+		Schema: map[string]*schema.Schema{
+			"subnet_ext_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"reserve_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"IP_ADDRESS_COUNT", "IP_ADDRESS_RANGE", "IP_ADDRESS_LIST"}, false),
+			},
+			"ip_count": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IntAtLeast(1),
+				ConflictsWith: []string{"ip_addresses"},
+			},
+			"start_ip_address": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"ip_addresses"},
+				Elem:          schemaForReservedIPAddress(),
+			},
+			"ip_addresses": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     schemaForReservedIPAddress(),
+			},
+			"client_context": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"reserved_ips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+// schemaForReservedIPAddress is an IPv4 address to reserve. The reserved IPs API only reports IPv4
+// addresses, so IPv6 reservations could not be tracked and released on destroy.
+func schemaForReservedIPAddress() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"ipv4": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsIPv4Address,
+						},
+						"prefix_length": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceNutanixSubnetIPReservationV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	subnetExtID := d.Get("subnet_ext_id").(string)
+	reserveType := d.Get("reserve_type").(string)
+
+	inputSpec := import1.NewIpReserveSpec()
+	inputSpec.ReserveType = common.ExpandEnum[import1.ReserveType](reserveType)
+
+	switch reserveType {
+	case "IP_ADDRESS_COUNT":
+		count, ok := d.GetOk("ip_count")
+		if !ok {
+			return diag.Errorf("ip_count is required when reserve_type is IP_ADDRESS_COUNT")
+		}
+		inputSpec.Count = utils.Int64Ptr(int64(count.(int)))
+	case "IP_ADDRESS_RANGE":
+		count, ok := d.GetOk("ip_count")
+		startIP, okStart := d.GetOk("start_ip_address")
+		if !ok || !okStart {
+			return diag.Errorf("start_ip_address and ip_count are required when reserve_type is IP_ADDRESS_RANGE")
+		}
+		inputSpec.Count = utils.Int64Ptr(int64(count.(int)))
+		inputSpec.StartIpAddress = expandIPAddressMap(startIP)
+	case "IP_ADDRESS_LIST":
+		ipAddresses, ok := d.GetOk("ip_addresses")
+		if !ok {
+			return diag.Errorf("ip_addresses is required when reserve_type is IP_ADDRESS_LIST")
+		}
+		inputSpec.IpAddresses = expandIPAddress(ipAddresses.([]interface{}))
+	}
+
+	// the client context tags the reservation, so the addresses it hands out can be told
+	// apart from those reserved by other consumers of the subnet
+	clientContext := utils.GenUUID()
+	if v, ok := d.GetOk("client_context"); ok {
+		clientContext = v.(string)
+	}
+	inputSpec.ClientContext = utils.StringPtr(clientContext)
+
+	// addresses already reserved with the same client context do not belong to this reservation
+	existingIps, err := listSubnetReservedIps(conn, subnetExtID, clientContext)
+	if err != nil {
+		return diag.Errorf("error while fetching reserved IPs of subnet (%s) : %v", subnetExtID, err)
+	}
+
+	aJSON, _ := json.MarshalIndent(inputSpec, "", "  ")
+	log.Printf("[DEBUG] Subnet IP reservation payload : %s", string(aJSON))
+
+	resp, err := conn.IPReservationAPIInstance.ReserveIpsBySubnetId(utils.StringPtr(subnetExtID), inputSpec)
+	if err != nil {
+		return diag.Errorf("error while reserving IPs in subnet (%s) : %v", subnetExtID, err)
+	}
+
+	TaskRef := resp.Data.GetValue().(import4.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	// calling group API to poll for completion of task
+	taskconn := meta.(*conns.Client).PrismAPI()
+
+	// Wait for the IPs to be reserved
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
+		return diag.Errorf("error waiting for IP reservation (%s) to complete: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+
+	reservedIps, err := listSubnetReservedIps(conn, subnetExtID, clientContext)
+	if err != nil {
+		return diag.Errorf("error while fetching reserved IPs of subnet (%s) : %v", subnetExtID, err)
+	}
+
+	ips := make([]string, 0)
+	for ip := range reservedIps {
+		if !existingIps[ip] {
+			ips = append(ips, ip)
+		}
+	}
+	sort.Strings(ips)
+	if len(ips) == 0 {
+		return diag.Errorf("IP reservation task (%s) completed but no reserved IPs were found in subnet (%s)", utils.StringValue(taskUUID), subnetExtID)
+	}
+	log.Printf("[DEBUG] Reserved IPs in subnet %s : %v", subnetExtID, ips)
+
+	if err := d.Set("client_context", clientContext); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("reserved_ips", ips); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.GenUUID())
+	return ResourceNutanixSubnetIPReservationV2Read(ctx, d, meta)
+}
+
+func ResourceNutanixSubnetIPReservationV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	subnetExtID := d.Get("subnet_ext_id").(string)
+
+	reservedIps, err := listSubnetReservedIps(conn, subnetExtID, d.Get("client_context").(string))
+	if err != nil {
+		return diag.Errorf("error while fetching reserved IPs of subnet (%s) : %v", subnetExtID, err)
+	}
+
+	// keep the addresses of this reservation that are still reserved on the subnet
+	ips := make([]string, 0)
+	for _, ip := range d.Get("reserved_ips").([]interface{}) {
+		if reservedIps[ip.(string)] {
+			ips = append(ips, ip.(string))
+		}
+	}
+
+	if len(ips) == 0 {
+		log.Printf("[DEBUG] No reserved IPs of this reservation left in subnet %s, removing it from state", subnetExtID)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("reserved_ips", ips); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func ResourceNutanixSubnetIPReservationV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	subnetExtID := d.Get("subnet_ext_id").(string)
+
+	inputSpec := import1.NewIpUnreserveSpec()
+	inputSpec.UnreserveType = import1.UNRESERVETYPE_IP_ADDRESS_LIST.Ref()
+	inputSpec.ClientContext = utils.StringPtr(d.Get("client_context").(string))
+
+	reservedIps := d.Get("reserved_ips").([]interface{})
+	inputSpec.IpAddresses = make([]config.IPAddress, len(reservedIps))
+	for k, v := range reservedIps {
+		ipAddress := config.NewIPAddress()
+		ipAddress.Ipv4 = config.NewIPv4Address()
+		ipAddress.Ipv4.Value = utils.StringPtr(v.(string))
+		inputSpec.IpAddresses[k] = *ipAddress
+	}
+
+	aJSON, _ := json.MarshalIndent(inputSpec, "", "  ")
+	log.Printf("[DEBUG] Subnet IP unreservation payload : %s", string(aJSON))
+
+	resp, err := conn.IPReservationAPIInstance.UnreserveIpsBySubnetId(utils.StringPtr(subnetExtID), inputSpec)
+	if err != nil {
+		return diag.Errorf("error while releasing IPs in subnet (%s) : %v", subnetExtID, err)
+	}
+
+	TaskRef := resp.Data.GetValue().(import4.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	// calling group API to poll for completion of task
+	taskconn := meta.(*conns.Client).PrismAPI()
+
+	// Wait for the IPs to be released
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutDelete)); errWaitTask != nil {
+		return diag.Errorf("error waiting for IP release (%s) to complete: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+	return nil
+}
+
+// listSubnetReservedIps returns the IPv4 addresses reserved on the subnet with the given
+// client context, walking through all the pages of the list API.
+func listSubnetReservedIps(conn *networking.Client, subnetExtID, clientContext string) (map[string]bool, error) {
+	reservedIps := make(map[string]bool)
+
+	for page := 0; ; page++ {
+		resp, err := conn.IPReservationAPIInstance.ListReservedIpsBySubnetId(utils.StringPtr(subnetExtID),
+			utils.IntPtr(page), utils.IntPtr(reservedIpsPageLimit), nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Data == nil {
+			return reservedIps, nil
+		}
+
+		ips, ok := resp.Data.GetValue().([]import1.ReservedIp)
+		if !ok {
+			return reservedIps, nil
+		}
+		for _, ip := range ips {
+			if utils.StringValue(ip.ClientContext) == clientContext && ip.Ipv4Address != nil {
+				reservedIps[*ip.Ipv4Address] = true
+			}
+		}
+		if len(ips) < reservedIpsPageLimit {
+			return reservedIps, nil
+		}
+	}
+}
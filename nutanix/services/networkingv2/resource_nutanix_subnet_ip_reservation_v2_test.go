@@ -0,0 +1,130 @@
+package networkingv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/services/networkingv2"
+)
+
+const (
+	resourceNameIPReservationCount = "nutanix_subnet_ip_reservation_v2.count"
+	resourceNameIPReservationList  = "nutanix_subnet_ip_reservation_v2.list"
+)
+
+func TestSubnetIPReservationV2_rejectsIPv6(t *testing.T) {
+	address := func(version, value string) []interface{} {
+		return []interface{}{map[string]interface{}{
+			version: []interface{}{map[string]interface{}{"value": value}},
+		}}
+	}
+
+	cases := []struct {
+		name    string
+		config  map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name: "ipv4 list",
+			config: map[string]interface{}{
+				"subnet_ext_id": "subnet",
+				"reserve_type":  "IP_ADDRESS_LIST",
+				"ip_addresses":  address("ipv4", "10.0.0.10"),
+			},
+		},
+		{
+			name: "ipv6 list",
+			config: map[string]interface{}{
+				"subnet_ext_id": "subnet",
+				"reserve_type":  "IP_ADDRESS_LIST",
+				"ip_addresses":  address("ipv6", "fd00::10"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "ipv6 range start",
+			config: map[string]interface{}{
+				"subnet_ext_id":    "subnet",
+				"reserve_type":     "IP_ADDRESS_RANGE",
+				"ip_count":         2,
+				"start_ip_address": address("ipv6", "fd00::10"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "ipv6 value as ipv4",
+			config: map[string]interface{}{
+				"subnet_ext_id": "subnet",
+				"reserve_type":  "IP_ADDRESS_LIST",
+				"ip_addresses":  address("ipv4", "fd00::10"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := networkingv2.ResourceNutanixSubnetIPReservationV2().Validate(terraform.NewResourceConfigRaw(tc.config))
+			if diags.HasError() != tc.wantErr {
+				t.Errorf("Validate() = %v, wantErr %v", diags, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestAccV2NutanixSubnetIPReservationResource_Basic(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-subnet-ip-reservation-%d", r)
+	desc := "test subnet ip reservation description"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testSubnetV2ConfigWithIPPool(name, desc) + testSubnetIPReservationV2Config(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceNameIPReservationCount, "subnet_ext_id", resourceNameSubnet, "id"),
+					resource.TestCheckResourceAttr(resourceNameIPReservationCount, "reserve_type", "IP_ADDRESS_COUNT"),
+					resource.TestCheckResourceAttr(resourceNameIPReservationCount, "reserved_ips.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceNameIPReservationCount, "client_context"),
+					resource.TestCheckResourceAttr(resourceNameIPReservationList, "reserve_type", "IP_ADDRESS_LIST"),
+					resource.TestCheckResourceAttr(resourceNameIPReservationList, "client_context", "tf-test-load-balancer"),
+					resource.TestCheckResourceAttr(resourceNameIPReservationList, "reserved_ips.#", "2"),
+					resource.TestCheckResourceAttr(resourceNameIPReservationList, "reserved_ips.0", "192.168.0.29"),
+					resource.TestCheckResourceAttr(resourceNameIPReservationList, "reserved_ips.1", "192.168.0.30"),
+				),
+			},
+		},
+	})
+}
+
+func testSubnetIPReservationV2Config() string {
+	return `
+		resource "nutanix_subnet_ip_reservation_v2" "count" {
+			subnet_ext_id = nutanix_subnet_v2.test.id
+			reserve_type  = "IP_ADDRESS_COUNT"
+			ip_count      = 2
+		}
+
+		resource "nutanix_subnet_ip_reservation_v2" "list" {
+			subnet_ext_id  = nutanix_subnet_v2.test.id
+			reserve_type   = "IP_ADDRESS_LIST"
+			client_context = "tf-test-load-balancer"
+			ip_addresses {
+				ipv4 {
+					value = "192.168.0.29"
+				}
+			}
+			ip_addresses {
+				ipv4 {
+					value = "192.168.0.30"
+				}
+			}
+			depends_on = [nutanix_subnet_ip_reservation_v2.count]
+		}
+`
+}
@@ -0,0 +1,357 @@
+package networkingv2
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/networking/v4/config"
+	import4 "github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/prism/v4/config"
+	prismConfig "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+var trafficMirrorNicTypes = []string{"HOST_NIC", "VIRTUAL_NIC"}
+
+func ResourceNutanixTrafficMirrorV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceNutanixTrafficMirrorV2Create,
+		ReadContext:   ResourceNutanixTrafficMirrorV2Read,
+		UpdateContext: ResourceNutanixTrafficMirrorV2Update,
+		DeleteContext: ResourceNutanixTrafficMirrorV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"ext_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"is_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"cluster_reference_list": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"host_reference_list": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"source_list": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 4,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"nic_uuid": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"nic_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(trafficMirrorNicTypes, false),
+						},
+						"direction": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"INGRESS", "EGRESS", "BIDIRECTIONAL"}, false),
+						},
+						"is_up": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"destination_list": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"nic_uuid": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"nic_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(trafficMirrorNicTypes, false),
+						},
+						"is_up": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"virtual_switch_reference": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"links": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"href": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rel": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"metadata": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: DatasourceMetadataSchemaV2(),
+				},
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func ResourceNutanixTrafficMirrorV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	inputSpec := import1.NewTrafficMirror()
+
+	inputSpec.Name = utils.StringPtr(d.Get("name").(string))
+	inputSpec.SourceList = expandTrafficMirrorSourcePorts(d.Get("source_list").([]interface{}))
+	inputSpec.DestinationList = expandTrafficMirrorPorts(d.Get("destination_list").([]interface{}))
+
+	if description, ok := d.GetOk("description"); ok {
+		inputSpec.Description = utils.StringPtr(description.(string))
+	}
+	if isEnabled, ok := d.GetOk("is_enabled"); ok {
+		inputSpec.IsEnabled = utils.BoolPtr(isEnabled.(bool))
+	}
+	if clusters, ok := d.GetOk("cluster_reference_list"); ok {
+		inputSpec.ClusterReferenceList = common.ExpandListOfString(clusters.([]interface{}))
+	}
+	if hosts, ok := d.GetOk("host_reference_list"); ok {
+		inputSpec.HostReferenceList = common.ExpandListOfString(hosts.([]interface{}))
+	}
+	if virtualSwitch, ok := d.GetOk("virtual_switch_reference"); ok {
+		inputSpec.VirtualSwitchReference = utils.StringPtr(virtualSwitch.(string))
+	}
+
+	aJSON, _ := json.MarshalIndent(inputSpec, "", " ")
+	log.Printf("[DEBUG] Traffic Mirror create payload : %s", string(aJSON))
+
+	resp, err := conn.TrafficMirrorAPIInstance.CreateTrafficMirror(inputSpec)
+	if err != nil {
+		return diag.Errorf("error while creating traffic mirror : %v", err)
+	}
+
+	TaskRef := resp.Data.GetValue().(import4.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	// calling group API to poll for completion of task
+	taskconn := meta.(*conns.Client).PrismAPI()
+
+	// Wait for the traffic mirror to be created
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
+		return diag.Errorf("error waiting for traffic mirror (%s) to create: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+
+	// Get UUID from TASK API
+	taskResp, err := taskconn.TaskRefAPI.GetTaskById(taskUUID, nil)
+	if err != nil {
+		return diag.Errorf("error while fetching traffic mirror task: %v", err)
+	}
+	taskDetails := taskResp.Data.GetValue().(prismConfig.Task)
+	aJSON, _ = json.MarshalIndent(taskDetails, "", "  ")
+	log.Printf("[DEBUG] Create Traffic Mirror Task Details: %s", string(aJSON))
+
+	uuid, err := common.ExtractEntityUUIDFromTask(taskDetails, utils.RelEntityTypeTrafficMirror, "Traffic mirror")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(utils.StringValue(uuid))
+	return ResourceNutanixTrafficMirrorV2Read(ctx, d, meta)
+}
+
+func ResourceNutanixTrafficMirrorV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	resp, err := conn.TrafficMirrorAPIInstance.GetTrafficMirrorById(utils.StringPtr(d.Id()))
+	if err != nil {
+		return diag.Errorf("error while fetching traffic mirror : %v", err)
+	}
+
+	getResp := resp.Data.GetValue().(import1.TrafficMirror)
+
+	for key, value := range flattenTrafficMirror(getResp) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func ResourceNutanixTrafficMirrorV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	readResp, err := conn.TrafficMirrorAPIInstance.GetTrafficMirrorById(utils.StringPtr(d.Id()))
+	if err != nil {
+		return diag.Errorf("error while fetching traffic mirror : %v", err)
+	}
+
+	updateSpec := readResp.Data.GetValue().(import1.TrafficMirror)
+	// Extract E-Tag Header
+	etagValue := conn.TrafficMirrorAPIInstance.ApiClient.GetEtag(readResp)
+
+	args := make(map[string]interface{})
+	args["If-Match"] = utils.StringPtr(etagValue)
+
+	if d.HasChange("name") {
+		updateSpec.Name = utils.StringPtr(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		updateSpec.Description = utils.StringPtr(d.Get("description").(string))
+	}
+	if d.HasChange("is_enabled") {
+		updateSpec.IsEnabled = utils.BoolPtr(d.Get("is_enabled").(bool))
+	}
+	if d.HasChange("cluster_reference_list") {
+		updateSpec.ClusterReferenceList = common.ExpandListOfString(d.Get("cluster_reference_list").([]interface{}))
+	}
+	if d.HasChange("host_reference_list") {
+		updateSpec.HostReferenceList = common.ExpandListOfString(d.Get("host_reference_list").([]interface{}))
+	}
+	if d.HasChange("source_list") {
+		updateSpec.SourceList = expandTrafficMirrorSourcePorts(d.Get("source_list").([]interface{}))
+	}
+	if d.HasChange("destination_list") {
+		updateSpec.DestinationList = expandTrafficMirrorPorts(d.Get("destination_list").([]interface{}))
+	}
+	if d.HasChange("virtual_switch_reference") {
+		updateSpec.VirtualSwitchReference = utils.StringPtr(d.Get("virtual_switch_reference").(string))
+	}
+	// runtime state is read only
+	updateSpec.State = nil
+	updateSpec.StateMessage = nil
+
+	updateResp, err := conn.TrafficMirrorAPIInstance.UpdateTrafficMirrorById(utils.StringPtr(d.Id()), &updateSpec, args)
+	if err != nil {
+		return diag.Errorf("error while updating traffic mirror : %v", err)
+	}
+
+	TaskRef := updateResp.Data.GetValue().(import4.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	// calling group API to poll for completion of task
+	taskconn := meta.(*conns.Client).PrismAPI()
+
+	// Wait for the traffic mirror to be updated
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutUpdate)); errWaitTask != nil {
+		return diag.Errorf("error waiting for traffic mirror (%s) to update: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+	return ResourceNutanixTrafficMirrorV2Read(ctx, d, meta)
+}
+
+func ResourceNutanixTrafficMirrorV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	resp, err := conn.TrafficMirrorAPIInstance.DeleteTrafficMirrorById(utils.StringPtr(d.Id()))
+	if err != nil {
+		return diag.Errorf("error while deleting traffic mirror : %v", err)
+	}
+	TaskRef := resp.Data.GetValue().(import4.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	// calling group API to poll for completion of task
+	taskconn := meta.(*conns.Client).PrismAPI()
+
+	// Wait for the traffic mirror to be deleted
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutDelete)); errWaitTask != nil {
+		return diag.Errorf("error waiting for traffic mirror (%s) to delete: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+	return nil
+}
+
+func expandTrafficMirrorSourcePorts(pr []interface{}) []import1.TrafficMirrorSourcePort {
+	if len(pr) == 0 {
+		return nil
+	}
+
+	ports := make([]import1.TrafficMirrorSourcePort, len(pr))
+	for k, v := range pr {
+		val := v.(map[string]interface{})
+		port := import1.NewTrafficMirrorSourcePort()
+
+		port.NicUuid = utils.StringPtr(val["nic_uuid"].(string))
+		port.NicType = common.ExpandEnum[import1.TrafficMirrorPortNicType](val["nic_type"])
+		port.Direction = common.ExpandEnum[import1.TrafficMirrorSourcePortDirection](val["direction"])
+
+		ports[k] = *port
+	}
+	return ports
+}
+
+func expandTrafficMirrorPorts(pr []interface{}) []import1.TrafficMirrorPort {
+	if len(pr) == 0 {
+		return nil
+	}
+
+	ports := make([]import1.TrafficMirrorPort, len(pr))
+	for k, v := range pr {
+		val := v.(map[string]interface{})
+		port := import1.NewTrafficMirrorPort()
+
+		port.NicUuid = utils.StringPtr(val["nic_uuid"].(string))
+		port.NicType = common.ExpandEnum[import1.TrafficMirrorPortNicType](val["nic_type"])
+
+		ports[k] = *port
+	}
+	return ports
+}
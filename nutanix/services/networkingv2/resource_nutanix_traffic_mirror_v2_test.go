@@ -0,0 +1,112 @@
+package networkingv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+)
+
+const resourceNameTrafficMirror = "nutanix_traffic_mirror_v2.test"
+
+func TestAccV2NutanixTrafficMirrorResource_Basic(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-traffic-mirror-%d", r)
+	desc := "test traffic mirror description"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testTrafficMirrorV2Config(r, name, desc, "INGRESS"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameTrafficMirror, "name", name),
+					resource.TestCheckResourceAttr(resourceNameTrafficMirror, "description", desc),
+					resource.TestCheckResourceAttr(resourceNameTrafficMirror, "is_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceNameTrafficMirror, "source_list.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceNameTrafficMirror, "source_list.0.nic_uuid", "nutanix_virtual_machine_v2.source", "nics.0.ext_id"),
+					resource.TestCheckResourceAttr(resourceNameTrafficMirror, "source_list.0.nic_type", "VIRTUAL_NIC"),
+					resource.TestCheckResourceAttr(resourceNameTrafficMirror, "source_list.0.direction", "INGRESS"),
+					resource.TestCheckResourceAttr(resourceNameTrafficMirror, "destination_list.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceNameTrafficMirror, "destination_list.0.nic_uuid", "nutanix_virtual_machine_v2.destination", "nics.0.ext_id"),
+					resource.TestCheckResourceAttrSet(resourceNameTrafficMirror, "state"),
+				),
+			},
+			{
+				Config: testTrafficMirrorV2Config(r, name, "updated description", "BIDIRECTIONAL"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameTrafficMirror, "name", name),
+					resource.TestCheckResourceAttr(resourceNameTrafficMirror, "description", "updated description"),
+					resource.TestCheckResourceAttr(resourceNameTrafficMirror, "source_list.0.direction", "BIDIRECTIONAL"),
+				),
+			},
+			{
+				ResourceName:      resourceNameTrafficMirror,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testTrafficMirrorV2Config(r int, name, desc, direction string) string {
+	return testSubnetV2Config(fmt.Sprintf("tf-test-subnet-mirror-%d", r), "subnet of the mirrored VMs") + fmt.Sprintf(`
+		resource "nutanix_virtual_machine_v2" "source" {
+			name                 = "tf-test-mirror-source-%[1]d"
+			num_cores_per_socket = 1
+			num_sockets          = 1
+			cluster {
+				ext_id = local.cluster0
+			}
+			nics {
+				nic_network_info {
+					virtual_ethernet_nic_network_info {
+						nic_type = "NORMAL_NIC"
+						subnet {
+							ext_id = nutanix_subnet_v2.test.id
+						}
+					}
+				}
+			}
+			power_state = "ON"
+		}
+
+		resource "nutanix_virtual_machine_v2" "destination" {
+			name                 = "tf-test-mirror-destination-%[1]d"
+			num_cores_per_socket = 1
+			num_sockets          = 1
+			cluster {
+				ext_id = local.cluster0
+			}
+			nics {
+				nic_network_info {
+					virtual_ethernet_nic_network_info {
+						nic_type = "NORMAL_NIC"
+						subnet {
+							ext_id = nutanix_subnet_v2.test.id
+						}
+					}
+				}
+			}
+			power_state = "ON"
+		}
+
+		resource "nutanix_traffic_mirror_v2" "test" {
+			name                   = "%[2]s"
+			description            = "%[3]s"
+			is_enabled             = true
+			cluster_reference_list = [local.cluster0]
+			source_list {
+				nic_uuid  = nutanix_virtual_machine_v2.source.nics.0.ext_id
+				nic_type  = "VIRTUAL_NIC"
+				direction = "%[4]s"
+			}
+			destination_list {
+				nic_uuid = nutanix_virtual_machine_v2.destination.nics.0.ext_id
+				nic_type = "VIRTUAL_NIC"
+			}
+		}
+`, r, name, desc, direction)
+}
@@ -0,0 +1,584 @@
+package networkingv2
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/networking/v4/config"
+	import4 "github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/prism/v4/config"
+	prismConfig "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func ResourceNutanixVpnConnectionV2() *schema.Resource {
+	authenticationAlgorithms := []string{"MD5", "SHA1", "SHA256", "SHA384", "SHA512"}
+	encryptionAlgorithms := []string{"AES128", "AES256", "TRIPLE_DES", "AES256GCM128"}
+
+	return &schema.Resource{
+		CreateContext: ResourceNutanixVpnConnectionV2Create,
+		ReadContext:   ResourceNutanixVpnConnectionV2Read,
+		UpdateContext: ResourceNutanixVpnConnectionV2Update,
+		DeleteContext: ResourceNutanixVpnConnectionV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"ext_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"local_gateway_reference": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"remote_gateway_reference": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"local_gateway_role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"INITIATOR", "ACCEPTOR"}, false),
+			},
+			"ipsec_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pre_shared_key": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+						"local_authentication_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"remote_authentication_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"ike_authentication_algorithm": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(authenticationAlgorithms, false),
+						},
+						"ike_encryption_algorithm": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(encryptionAlgorithms, false),
+						},
+						"ike_lifetime_secs": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"ipsec_authentication_algorithm": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(authenticationAlgorithms, false),
+						},
+						"ipsec_encryption_algorithm": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(encryptionAlgorithms, false),
+						},
+						"ipsec_lifetime_secs": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"esp_pfs_dh_group_number": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntInSlice([]int{14, 19, 20}),
+						},
+						"local_vti_ip":  schemaForGatewayIPAddress(),
+						"remote_vti_ip": schemaForGatewayIPAddress(),
+					},
+				},
+			},
+			"dpd_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"operation": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"RESTART", "CLEAR", "HOLD"}, false),
+						},
+						"interval_secs": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"timeout_secs": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"qos_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ingress_limit_mbps": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"egress_limit_mbps": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"dynamic_route_priority": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"advertised_prefixes": schemaForIPSubnetPrefixes(),
+			"learned_prefixes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ipv4": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ip": SchemaForValuePrefixLength(),
+									"prefix_length": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"ipv6": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ip": SchemaForValuePrefixLength(),
+									"prefix_length": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"ipsec_tunnel_status": schemaForNetworkingStatus(),
+			"ebgp_status":         schemaForNetworkingStatus(),
+			"links": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"href": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rel": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"metadata": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: DatasourceMetadataSchemaV2(),
+				},
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func ResourceNutanixVpnConnectionV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	inputSpec := import1.NewVpnConnection()
+
+	inputSpec.Name = utils.StringPtr(d.Get("name").(string))
+	inputSpec.LocalGatewayReference = utils.StringPtr(d.Get("local_gateway_reference").(string))
+	inputSpec.RemoteGatewayReference = utils.StringPtr(d.Get("remote_gateway_reference").(string))
+	inputSpec.LocalGatewayRole = common.ExpandEnum[import1.GatewayRole](d.Get("local_gateway_role"))
+	inputSpec.IpsecConfig = expandIpsecConfig(d.Get("ipsec_config").([]interface{}))
+
+	if description, ok := d.GetOk("description"); ok {
+		inputSpec.Description = utils.StringPtr(description.(string))
+	}
+	if dpdConfig, ok := d.GetOk("dpd_config"); ok {
+		inputSpec.DpdConfig = expandDpdConfig(dpdConfig.([]interface{}))
+	}
+	if qosConfig, ok := d.GetOk("qos_config"); ok {
+		inputSpec.QosConfig = expandQosConfig(qosConfig.([]interface{}))
+	}
+	if priority, ok := d.GetOk("dynamic_route_priority"); ok {
+		inputSpec.DynamicRoutePriority = utils.IntPtr(priority.(int))
+	}
+	if prefixes, ok := d.GetOk("advertised_prefixes"); ok {
+		inputSpec.AdvertisedPrefixes = expandIPSubnet(prefixes.([]interface{}))
+	}
+
+	resp, err := conn.VpnConnectionAPIInstance.CreateVpnConnection(inputSpec)
+	if err != nil {
+		return diag.Errorf("error while creating VPN connection : %v", err)
+	}
+
+	TaskRef := resp.Data.GetValue().(import4.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	// calling group API to poll for completion of task
+	taskconn := meta.(*conns.Client).PrismAPI()
+
+	// Wait for the VPN connection to be created
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
+		return diag.Errorf("error waiting for VPN connection (%s) to create: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+
+	// Get UUID from TASK API
+	taskResp, err := taskconn.TaskRefAPI.GetTaskById(taskUUID, nil)
+	if err != nil {
+		return diag.Errorf("error while fetching VPN connection task: %v", err)
+	}
+	taskDetails := taskResp.Data.GetValue().(prismConfig.Task)
+	aJSON, _ := json.MarshalIndent(taskDetails, "", "  ")
+	log.Printf("[DEBUG] Create VPN Connection Task Details: %s", string(aJSON))
+
+	uuid, err := common.ExtractEntityUUIDFromTask(taskDetails, utils.RelEntityTypeVpnConnection, "VPN connection")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(utils.StringValue(uuid))
+	return ResourceNutanixVpnConnectionV2Read(ctx, d, meta)
+}
+
+func ResourceNutanixVpnConnectionV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	resp, err := conn.VpnConnectionAPIInstance.GetVpnConnectionById(utils.StringPtr(d.Id()))
+	if err != nil {
+		return diag.Errorf("error while fetching VPN connection : %v", err)
+	}
+
+	getResp := resp.Data.GetValue().(import1.VpnConnection)
+
+	// the pre-shared key is never returned by the API, keep the configured one
+	preSharedKey := d.Get("ipsec_config.0.pre_shared_key").(string)
+
+	if err := d.Set("ext_id", getResp.ExtId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", getResp.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", getResp.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("local_gateway_reference", getResp.LocalGatewayReference); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("remote_gateway_reference", getResp.RemoteGatewayReference); err != nil {
+		return diag.FromErr(err)
+	}
+	if getResp.LocalGatewayRole != nil {
+		if err := d.Set("local_gateway_role", getResp.LocalGatewayRole.GetName()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := d.Set("ipsec_config", flattenIpsecConfig(getResp.IpsecConfig, preSharedKey)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("dpd_config", flattenDpdConfig(getResp.DpdConfig)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("qos_config", flattenQosConfig(getResp.QosConfig)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("dynamic_route_priority", getResp.DynamicRoutePriority); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("advertised_prefixes", flattenExternallyRoutablePrefixes(getResp.AdvertisedPrefixes)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("learned_prefixes", flattenExternallyRoutablePrefixes(getResp.LearnedPrefixes)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ipsec_tunnel_status", flattenNetworkingStatus(getResp.IpsecTunnelStatus)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ebgp_status", flattenNetworkingStatus(getResp.EbgpStatus)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("links", flattenLinks(getResp.Links)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("metadata", flattenMetadata(getResp.Metadata)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("tenant_id", getResp.TenantId); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func ResourceNutanixVpnConnectionV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	readResp, err := conn.VpnConnectionAPIInstance.GetVpnConnectionById(utils.StringPtr(d.Id()))
+	if err != nil {
+		return diag.Errorf("error while fetching VPN connection : %v", err)
+	}
+
+	updateSpec := readResp.Data.GetValue().(import1.VpnConnection)
+	// Extract E-Tag Header
+	etagValue := conn.VpnConnectionAPIInstance.ApiClient.GetEtag(readResp)
+
+	args := make(map[string]interface{})
+	args["If-Match"] = utils.StringPtr(etagValue)
+
+	if d.HasChange("name") {
+		updateSpec.Name = utils.StringPtr(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		updateSpec.Description = utils.StringPtr(d.Get("description").(string))
+	}
+	// the pre-shared key is required on every update as the API does not return it
+	updateSpec.IpsecConfig = expandIpsecConfig(d.Get("ipsec_config").([]interface{}))
+	if d.HasChange("dpd_config") {
+		updateSpec.DpdConfig = expandDpdConfig(d.Get("dpd_config").([]interface{}))
+	}
+	if d.HasChange("qos_config") {
+		updateSpec.QosConfig = expandQosConfig(d.Get("qos_config").([]interface{}))
+	}
+	if d.HasChange("dynamic_route_priority") {
+		updateSpec.DynamicRoutePriority = utils.IntPtr(d.Get("dynamic_route_priority").(int))
+	}
+	if d.HasChange("advertised_prefixes") {
+		updateSpec.AdvertisedPrefixes = expandIPSubnet(d.Get("advertised_prefixes").([]interface{}))
+	}
+
+	updateResp, err := conn.VpnConnectionAPIInstance.UpdateVpnConnectionById(utils.StringPtr(d.Id()), &updateSpec, args)
+	if err != nil {
+		return diag.Errorf("error while updating VPN connection : %v", err)
+	}
+
+	TaskRef := updateResp.Data.GetValue().(import4.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	// calling group API to poll for completion of task
+	taskconn := meta.(*conns.Client).PrismAPI()
+
+	// Wait for the VPN connection to be updated
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutUpdate)); errWaitTask != nil {
+		return diag.Errorf("error waiting for VPN connection (%s) to update: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+	return ResourceNutanixVpnConnectionV2Read(ctx, d, meta)
+}
+
+func ResourceNutanixVpnConnectionV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	resp, err := conn.VpnConnectionAPIInstance.DeleteVpnConnectionById(utils.StringPtr(d.Id()))
+	if err != nil {
+		return diag.Errorf("error while deleting VPN connection : %v", err)
+	}
+	TaskRef := resp.Data.GetValue().(import4.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	// calling group API to poll for completion of task
+	taskconn := meta.(*conns.Client).PrismAPI()
+
+	// Wait for the VPN connection to be deleted
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutDelete)); errWaitTask != nil {
+		return diag.Errorf("error waiting for VPN connection (%s) to delete: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+	return nil
+}
+
+func expandIpsecConfig(pr []interface{}) *import1.IpsecConfig {
+	if len(pr) == 0 || pr[0] == nil {
+		return nil
+	}
+	val := pr[0].(map[string]interface{})
+	ipsecConfig := import1.NewIpsecConfig()
+
+	ipsecConfig.PreSharedKey = utils.StringPtr(val["pre_shared_key"].(string))
+
+	if localID, ok := val["local_authentication_id"]; ok && localID.(string) != "" {
+		ipsecConfig.LocalAuthenticationId = utils.StringPtr(localID.(string))
+	}
+	if remoteID, ok := val["remote_authentication_id"]; ok && remoteID.(string) != "" {
+		ipsecConfig.RemoteAuthenticationId = utils.StringPtr(remoteID.(string))
+	}
+	if algorithm, ok := val["ike_authentication_algorithm"]; ok {
+		ipsecConfig.IkeAuthenticationAlgorithm = common.ExpandEnum[import1.AuthenticationAlgorithm](algorithm)
+	}
+	if algorithm, ok := val["ike_encryption_algorithm"]; ok {
+		ipsecConfig.IkeEncryptionAlgorithm = common.ExpandEnum[import1.EncryptionAlgorithm](algorithm)
+	}
+	if lifetime, ok := val["ike_lifetime_secs"]; ok && lifetime.(int) > 0 {
+		ipsecConfig.IkeLifetimeSecs = utils.Int64Ptr(int64(lifetime.(int)))
+	}
+	if algorithm, ok := val["ipsec_authentication_algorithm"]; ok {
+		ipsecConfig.IpsecAuthenticationAlgorithm = common.ExpandEnum[import1.AuthenticationAlgorithm](algorithm)
+	}
+	if algorithm, ok := val["ipsec_encryption_algorithm"]; ok {
+		ipsecConfig.IpsecEncryptionAlgorithm = common.ExpandEnum[import1.EncryptionAlgorithm](algorithm)
+	}
+	if lifetime, ok := val["ipsec_lifetime_secs"]; ok && lifetime.(int) > 0 {
+		ipsecConfig.IpsecLifetimeSecs = utils.Int64Ptr(int64(lifetime.(int)))
+	}
+	if dhGroup, ok := val["esp_pfs_dh_group_number"]; ok && dhGroup.(int) > 0 {
+		ipsecConfig.EspPfsDhGroupNumber = utils.IntPtr(dhGroup.(int))
+	}
+	if localVtiIP, ok := val["local_vti_ip"]; ok {
+		ipsecConfig.LocalVtiIp = expandGatewayIPAddress(localVtiIP)
+	}
+	if remoteVtiIP, ok := val["remote_vti_ip"]; ok {
+		ipsecConfig.RemoteVtiIp = expandGatewayIPAddress(remoteVtiIP)
+	}
+	return ipsecConfig
+}
+
+func expandDpdConfig(pr []interface{}) *import1.DpdConfig {
+	if len(pr) == 0 || pr[0] == nil {
+		return nil
+	}
+	val := pr[0].(map[string]interface{})
+	dpdConfig := import1.NewDpdConfig()
+
+	if operation, ok := val["operation"]; ok {
+		dpdConfig.Operation = common.ExpandEnum[import1.DpdOperation](operation)
+	}
+	if interval, ok := val["interval_secs"]; ok && interval.(int) > 0 {
+		dpdConfig.IntervalSecs = utils.Int64Ptr(int64(interval.(int)))
+	}
+	if timeout, ok := val["timeout_secs"]; ok && timeout.(int) > 0 {
+		dpdConfig.TimeoutSecs = utils.Int64Ptr(int64(timeout.(int)))
+	}
+	return dpdConfig
+}
+
+func expandQosConfig(pr []interface{}) *import1.QosConfig {
+	if len(pr) == 0 || pr[0] == nil {
+		return nil
+	}
+	val := pr[0].(map[string]interface{})
+	qosConfig := import1.NewQosConfig()
+
+	if ingress, ok := val["ingress_limit_mbps"]; ok && ingress.(int) > 0 {
+		qosConfig.IngressLimitMbps = utils.Int64Ptr(int64(ingress.(int)))
+	}
+	if egress, ok := val["egress_limit_mbps"]; ok && egress.(int) > 0 {
+		qosConfig.EgressLimitMbps = utils.Int64Ptr(int64(egress.(int)))
+	}
+	return qosConfig
+}
+
+func flattenIpsecConfig(pr *import1.IpsecConfig, preSharedKey string) []map[string]interface{} {
+	if pr == nil {
+		return nil
+	}
+	ipsecConfig := make(map[string]interface{})
+
+	ipsecConfig["pre_shared_key"] = preSharedKey
+	ipsecConfig["local_authentication_id"] = utils.StringValue(pr.LocalAuthenticationId)
+	ipsecConfig["remote_authentication_id"] = utils.StringValue(pr.RemoteAuthenticationId)
+	if pr.IkeAuthenticationAlgorithm != nil {
+		ipsecConfig["ike_authentication_algorithm"] = pr.IkeAuthenticationAlgorithm.GetName()
+	}
+	if pr.IkeEncryptionAlgorithm != nil {
+		ipsecConfig["ike_encryption_algorithm"] = pr.IkeEncryptionAlgorithm.GetName()
+	}
+	ipsecConfig["ike_lifetime_secs"] = utils.Int64Value(pr.IkeLifetimeSecs)
+	if pr.IpsecAuthenticationAlgorithm != nil {
+		ipsecConfig["ipsec_authentication_algorithm"] = pr.IpsecAuthenticationAlgorithm.GetName()
+	}
+	if pr.IpsecEncryptionAlgorithm != nil {
+		ipsecConfig["ipsec_encryption_algorithm"] = pr.IpsecEncryptionAlgorithm.GetName()
+	}
+	ipsecConfig["ipsec_lifetime_secs"] = utils.Int64Value(pr.IpsecLifetimeSecs)
+	ipsecConfig["esp_pfs_dh_group_number"] = utils.IntValue(pr.EspPfsDhGroupNumber)
+	ipsecConfig["local_vti_ip"] = flattenIPAddress(pr.LocalVtiIp)
+	ipsecConfig["remote_vti_ip"] = flattenIPAddress(pr.RemoteVtiIp)
+
+	return []map[string]interface{}{ipsecConfig}
+}
+
+func flattenDpdConfig(pr *import1.DpdConfig) []map[string]interface{} {
+	if pr == nil {
+		return nil
+	}
+	dpdConfig := make(map[string]interface{})
+
+	if pr.Operation != nil {
+		dpdConfig["operation"] = pr.Operation.GetName()
+	}
+	dpdConfig["interval_secs"] = utils.Int64Value(pr.IntervalSecs)
+	dpdConfig["timeout_secs"] = utils.Int64Value(pr.TimeoutSecs)
+
+	return []map[string]interface{}{dpdConfig}
+}
+
+func flattenQosConfig(pr *import1.QosConfig) []map[string]interface{} {
+	if pr == nil {
+		return nil
+	}
+	qosConfig := make(map[string]interface{})
+
+	qosConfig["ingress_limit_mbps"] = utils.Int64Value(pr.IngressLimitMbps)
+	qosConfig["egress_limit_mbps"] = utils.Int64Value(pr.EgressLimitMbps)
+
+	return []map[string]interface{}{qosConfig}
+}
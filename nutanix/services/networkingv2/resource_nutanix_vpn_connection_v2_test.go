@@ -0,0 +1,104 @@
+package networkingv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+)
+
+const resourceNameVpnConnection = "nutanix_vpn_connection_v2.test"
+
+func TestAccV2NutanixVpnConnectionResource_Basic(t *testing.T) {
+	r := acctest.RandInt()
+	vlanID := acctest.RandIntRange(1, 999)
+	name := fmt.Sprintf("tf-test-vpn-connection-%d", r)
+	updatedName := fmt.Sprintf("tf-test-vpn-connection-updated-%d", r)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testVpnConnectionConfig(name, vlanID, 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVpnConnection, "name", name),
+					resource.TestCheckResourceAttr(resourceNameVpnConnection, "local_gateway_role", "INITIATOR"),
+					resource.TestCheckResourceAttr(resourceNameVpnConnection, "ipsec_config.0.ike_encryption_algorithm", "AES256"),
+					resource.TestCheckResourceAttr(resourceNameVpnConnection, "dpd_config.0.interval_secs", "30"),
+					resource.TestCheckResourceAttrPair(resourceNameVpnConnection, "local_gateway_reference", "nutanix_vpn_gateway_v2.local", "id"),
+					resource.TestCheckResourceAttrPair("data.nutanix_vpn_connection_v2.test", "name", resourceNameVpnConnection, "name"),
+					resource.TestCheckResourceAttrSet("data.nutanix_vpn_connections_v2.test", "vpn_connections.#"),
+				),
+			},
+			{
+				Config: testVpnConnectionConfig(updatedName, vlanID, 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVpnConnection, "name", updatedName),
+					resource.TestCheckResourceAttr(resourceNameVpnConnection, "dpd_config.0.interval_secs", "60"),
+				),
+			},
+		},
+	})
+}
+
+func testVpnConnectionConfig(name string, vlanID, dpdInterval int) string {
+	return testLocalGatewayBaseConfig(vlanID) + fmt.Sprintf(`
+	resource "nutanix_vpn_gateway_v2" "local" {
+		name          = "%[1]s-local"
+		vpc_reference = nutanix_vpc_v2.test.id
+		deployment {
+			cluster_reference = local.cluster0
+		}
+		local_services {
+			local_vpn_service {
+				ebgp_config {
+					asn = 65001
+				}
+			}
+		}
+	}
+
+	resource "nutanix_vpn_gateway_v2" "remote" {
+		name = "%[1]s-remote"
+		remote_services {
+			remote_vpn_service {
+				service_address {
+					ipv4 {
+						value = "203.0.113.10"
+					}
+				}
+				ebgp_config {
+					asn = 65002
+				}
+			}
+		}
+	}
+
+	resource "nutanix_vpn_connection_v2" "test" {
+		name                     = "%[1]s"
+		local_gateway_reference  = nutanix_vpn_gateway_v2.local.id
+		remote_gateway_reference = nutanix_vpn_gateway_v2.remote.id
+		local_gateway_role       = "INITIATOR"
+		ipsec_config {
+			pre_shared_key             = "tf-test-shared-secret"
+			ike_encryption_algorithm   = "AES256"
+			ipsec_encryption_algorithm = "AES256"
+		}
+		dpd_config {
+			operation     = "RESTART"
+			interval_secs = %[2]d
+			timeout_secs  = 120
+		}
+	}
+
+	data "nutanix_vpn_connection_v2" "test" {
+		ext_id = nutanix_vpn_connection_v2.test.id
+	}
+
+	data "nutanix_vpn_connections_v2" "test" {
+		depends_on = [nutanix_vpn_connection_v2.test]
+	}
+`, name, dpdInterval)
+}
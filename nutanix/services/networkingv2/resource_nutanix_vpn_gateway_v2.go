@@ -0,0 +1,746 @@
+package networkingv2
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/common/v1/config"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/networking/v4/config"
+	import4 "github.com/nutanix/ntnx-api-golang-clients/networking-go-client/v4/models/prism/v4/config"
+	prismConfig "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func ResourceNutanixVpnGatewayV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceNutanixVpnGatewayV2Create,
+		ReadContext:   ResourceNutanixVpnGatewayV2Read,
+		UpdateContext: ResourceNutanixVpnGatewayV2Update,
+		DeleteContext: ResourceNutanixVpnGatewayV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"ext_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"vpc_reference": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"gateway_device_vendor": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"deployment": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cluster_reference": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"vcenter_datastore_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"should_synchronize_system_dns_servers": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"should_synchronize_system_ntp_servers": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"dns_servers": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ipv4": SchemaForValuePrefixLength(),
+									"ipv6": SchemaForValuePrefixLength(),
+								},
+							},
+						},
+						"interfaces": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"subnet_reference": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"ip_address":              schemaForGatewayIPAddress(),
+									"default_gateway_address": schemaForGatewayIPAddress(),
+									"mtu": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+									"mac_address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"local_services": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"local_services", "remote_services"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_address": schemaForGatewayIPAddress(),
+						"local_vpn_service": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ebgp_config": schemaForGatewayBgpConfig(),
+								},
+							},
+						},
+						"local_bgp_service": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"asn": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+									"vpc_reference": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"is_bgp_add_path_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"remote_services": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"local_services", "remote_services"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"remote_vpn_service": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"service_address": schemaForGatewayIPAddress(),
+									"ebgp_config":     schemaForGatewayBgpConfig(),
+								},
+							},
+						},
+						"remote_bgp_service": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address": schemaForGatewayIPAddress(),
+									"asn": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"installed_software_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"supported_software_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_active": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"vm_reference": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": schemaForNetworkingStatus(),
+			"links": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"href": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rel": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"metadata": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: DatasourceMetadataSchemaV2(),
+				},
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func ResourceNutanixVpnGatewayV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	inputSpec := import1.NewGateway()
+
+	if name, ok := d.GetOk("name"); ok {
+		inputSpec.Name = utils.StringPtr(name.(string))
+	}
+	if description, ok := d.GetOk("description"); ok {
+		inputSpec.Description = utils.StringPtr(description.(string))
+	}
+	if vpcReference, ok := d.GetOk("vpc_reference"); ok {
+		inputSpec.VpcReference = utils.StringPtr(vpcReference.(string))
+	}
+	if vendor, ok := d.GetOk("gateway_device_vendor"); ok {
+		inputSpec.GatewayDeviceVendor = utils.StringPtr(vendor.(string))
+	}
+	if deployment, ok := d.GetOk("deployment"); ok {
+		inputSpec.Deployment = expandGatewayDeployment(deployment.([]interface{}))
+	}
+	services, err := expandGatewayServices(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	inputSpec.Services = services
+
+	aJSON, _ := json.MarshalIndent(inputSpec, "", " ")
+	log.Printf("[DEBUG] VPN Gateway create payload : %s", string(aJSON))
+
+	resp, err := conn.GatewayAPIInstance.CreateGateway(inputSpec)
+	if err != nil {
+		return diag.Errorf("error while creating VPN gateway : %v", err)
+	}
+
+	TaskRef := resp.Data.GetValue().(import4.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	// calling group API to poll for completion of task
+	taskconn := meta.(*conns.Client).PrismAPI()
+
+	// Wait for the gateway to be created
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
+		return diag.Errorf("error waiting for VPN gateway (%s) to create: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+
+	// Get UUID from TASK API
+	taskResp, err := taskconn.TaskRefAPI.GetTaskById(taskUUID, nil)
+	if err != nil {
+		return diag.Errorf("error while fetching VPN gateway task: %v", err)
+	}
+	taskDetails := taskResp.Data.GetValue().(prismConfig.Task)
+	aJSON, _ = json.MarshalIndent(taskDetails, "", "  ")
+	log.Printf("[DEBUG] Create VPN Gateway Task Details: %s", string(aJSON))
+
+	uuid, err := common.ExtractEntityUUIDFromTask(taskDetails, utils.RelEntityTypeGateway, "VPN gateway")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(utils.StringValue(uuid))
+	return ResourceNutanixVpnGatewayV2Read(ctx, d, meta)
+}
+
+func ResourceNutanixVpnGatewayV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	resp, err := conn.GatewayAPIInstance.GetGatewayById(utils.StringPtr(d.Id()))
+	if err != nil {
+		return diag.Errorf("error while fetching VPN gateway : %v", err)
+	}
+
+	getResp := resp.Data.GetValue().(import1.Gateway)
+
+	// BGP passwords are never returned by the API, keep the configured ones
+	localPassword := d.Get("local_services.0.local_vpn_service.0.ebgp_config.0.password").(string)
+	remotePassword := d.Get("remote_services.0.remote_vpn_service.0.ebgp_config.0.password").(string)
+	localServices, remoteServices := flattenGatewayServices(getResp.Services, localPassword, remotePassword)
+
+	if err := d.Set("ext_id", getResp.ExtId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", getResp.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", getResp.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("vpc_reference", getResp.VpcReference); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("gateway_device_vendor", getResp.GatewayDeviceVendor); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("deployment", flattenGatewayDeployment(getResp.Deployment)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("local_services", localServices); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("remote_services", remoteServices); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("installed_software_version", getResp.InstalledSoftwareVersion); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("supported_software_version", getResp.SupportedSoftwareVersion); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("is_active", getResp.IsActive); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("vm_reference", getResp.VmReference); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("status", flattenNetworkingStatus(getResp.Status)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("links", flattenLinks(getResp.Links)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("metadata", flattenMetadata(getResp.Metadata)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("tenant_id", getResp.TenantId); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func ResourceNutanixVpnGatewayV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	readResp, err := conn.GatewayAPIInstance.GetGatewayById(utils.StringPtr(d.Id()))
+	if err != nil {
+		return diag.Errorf("error while fetching VPN gateway : %v", err)
+	}
+
+	updateSpec := readResp.Data.GetValue().(import1.Gateway)
+	// Extract E-Tag Header
+	etagValue := conn.GatewayAPIInstance.ApiClient.GetEtag(readResp)
+
+	args := make(map[string]interface{})
+	args["If-Match"] = utils.StringPtr(etagValue)
+
+	if d.HasChange("name") {
+		updateSpec.Name = utils.StringPtr(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		updateSpec.Description = utils.StringPtr(d.Get("description").(string))
+	}
+	if d.HasChange("gateway_device_vendor") {
+		updateSpec.GatewayDeviceVendor = utils.StringPtr(d.Get("gateway_device_vendor").(string))
+	}
+	if d.HasChanges("local_services", "remote_services") {
+		services, err := expandGatewayServices(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		updateSpec.Services = services
+	}
+
+	aJSON, _ := json.MarshalIndent(updateSpec, "", " ")
+	log.Printf("[DEBUG] VPN Gateway update payload : %s", string(aJSON))
+
+	updateResp, err := conn.GatewayAPIInstance.UpdateGatewayById(utils.StringPtr(d.Id()), &updateSpec, args)
+	if err != nil {
+		return diag.Errorf("error while updating VPN gateway : %v", err)
+	}
+
+	TaskRef := updateResp.Data.GetValue().(import4.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	// calling group API to poll for completion of task
+	taskconn := meta.(*conns.Client).PrismAPI()
+
+	// Wait for the gateway to be updated
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutUpdate)); errWaitTask != nil {
+		return diag.Errorf("error waiting for VPN gateway (%s) to update: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+	return ResourceNutanixVpnGatewayV2Read(ctx, d, meta)
+}
+
+func ResourceNutanixVpnGatewayV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).NetworkingAPI()
+
+	resp, err := conn.GatewayAPIInstance.DeleteGatewayById(utils.StringPtr(d.Id()))
+	if err != nil {
+		return diag.Errorf("error while deleting VPN gateway : %v", err)
+	}
+	TaskRef := resp.Data.GetValue().(import4.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	// calling group API to poll for completion of task
+	taskconn := meta.(*conns.Client).PrismAPI()
+
+	// Wait for the gateway to be deleted
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutDelete)); errWaitTask != nil {
+		return diag.Errorf("error waiting for VPN gateway (%s) to delete: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+	return nil
+}
+
+func schemaForGatewayIPAddress() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ipv4": SchemaForValuePrefixLength(),
+				"ipv6": SchemaForValuePrefixLength(),
+			},
+		},
+	}
+}
+
+func schemaForGatewayBgpConfig() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"asn": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"password": {
+					Type:      schema.TypeString,
+					Optional:  true,
+					Sensitive: true,
+				},
+				"should_redistribute_routes": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func schemaForNetworkingStatus() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"state": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"message": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// expandGatewayIPAddress is expandIPAddressMap that tolerates an empty list
+func expandGatewayIPAddress(pr interface{}) *config.IPAddress {
+	if prI, ok := pr.([]interface{}); !ok || len(prI) == 0 || prI[0] == nil {
+		return nil
+	}
+	return expandIPAddressMap(pr)
+}
+
+func expandGatewayDeployment(pr []interface{}) *import1.GatewayDeployment {
+	if len(pr) == 0 || pr[0] == nil {
+		return nil
+	}
+	val := pr[0].(map[string]interface{})
+	deployment := import1.NewGatewayDeployment()
+
+	if clusterReference, ok := val["cluster_reference"]; ok && clusterReference.(string) != "" {
+		deployment.ClusterReference = utils.StringPtr(clusterReference.(string))
+	}
+	if datastore, ok := val["vcenter_datastore_name"]; ok && datastore.(string) != "" {
+		deployment.VcenterDatastoreName = utils.StringPtr(datastore.(string))
+	}
+	if syncDNS, ok := val["should_synchronize_system_dns_servers"]; ok {
+		deployment.ShouldSynchronizeSystemDnsServers = utils.BoolPtr(syncDNS.(bool))
+	}
+	if syncNTP, ok := val["should_synchronize_system_ntp_servers"]; ok {
+		deployment.ShouldSynchronizeSystemNtpServers = utils.BoolPtr(syncNTP.(bool))
+	}
+	if dnsServers, ok := val["dns_servers"]; ok && len(dnsServers.([]interface{})) > 0 {
+		deployment.DnsServers = expandIPAddress(dnsServers.([]interface{}))
+	}
+	if interfaces, ok := val["interfaces"]; ok && len(interfaces.([]interface{})) > 0 {
+		interfacesList := make([]import1.GatewayInterface, 0)
+		for _, v := range interfaces.([]interface{}) {
+			intfVal := v.(map[string]interface{})
+			intf := import1.NewGatewayInterface()
+
+			if subnetReference, ok := intfVal["subnet_reference"]; ok && subnetReference.(string) != "" {
+				intf.SubnetReference = utils.StringPtr(subnetReference.(string))
+			}
+			if ipAddress, ok := intfVal["ip_address"]; ok {
+				intf.IpAddress = expandGatewayIPAddress(ipAddress)
+			}
+			if defaultGateway, ok := intfVal["default_gateway_address"]; ok {
+				intf.DefaultGatewayAddress = expandGatewayIPAddress(defaultGateway)
+			}
+			if mtu, ok := intfVal["mtu"]; ok && mtu.(int) > 0 {
+				intf.Mtu = utils.IntPtr(mtu.(int))
+			}
+			interfacesList = append(interfacesList, *intf)
+		}
+		deployment.Interfaces = interfacesList
+	}
+	return deployment
+}
+
+func expandGatewayBgpConfig(pr interface{}) *import1.BgpConfig {
+	prI, ok := pr.([]interface{})
+	if !ok || len(prI) == 0 || prI[0] == nil {
+		return nil
+	}
+	val := prI[0].(map[string]interface{})
+	bgpConfig := import1.NewBgpConfig()
+
+	if asn, ok := val["asn"]; ok && asn.(int) > 0 {
+		bgpConfig.Asn = utils.Int64Ptr(int64(asn.(int)))
+	}
+	if password, ok := val["password"]; ok && password.(string) != "" {
+		bgpConfig.Password = utils.StringPtr(password.(string))
+	}
+	if redistribute, ok := val["should_redistribute_routes"]; ok {
+		bgpConfig.ShouldRedistributeRoutes = utils.BoolPtr(redistribute.(bool))
+	}
+	return bgpConfig
+}
+
+func expandGatewayServices(d *schema.ResourceData) (*import1.OneOfGatewayServices, error) {
+	services := import1.NewOneOfGatewayServices()
+
+	if localServices, ok := d.GetOk("local_services"); ok && len(localServices.([]interface{})) > 0 {
+		val := localServices.([]interface{})[0].(map[string]interface{})
+		local := import1.NewLocalNetworkServices()
+
+		if serviceAddress, ok := val["service_address"]; ok {
+			local.ServiceAddress = expandGatewayIPAddress(serviceAddress)
+		}
+		if vpnService, ok := val["local_vpn_service"]; ok && len(vpnService.([]interface{})) > 0 {
+			local.LocalVpnService = import1.NewLocalVpnService()
+			if vpnVal, ok := vpnService.([]interface{})[0].(map[string]interface{}); ok {
+				local.LocalVpnService.EbgpConfig = expandGatewayBgpConfig(vpnVal["ebgp_config"])
+			}
+		}
+		if bgpService, ok := val["local_bgp_service"]; ok && len(bgpService.([]interface{})) > 0 {
+			bgpVal := bgpService.([]interface{})[0].(map[string]interface{})
+			local.LocalBgpService = import1.NewLocalBgpService()
+			local.LocalBgpService.Asn = utils.Int64Ptr(int64(bgpVal["asn"].(int)))
+			if vpcReference, ok := bgpVal["vpc_reference"]; ok && vpcReference.(string) != "" {
+				local.LocalBgpService.VpcReference = utils.StringPtr(vpcReference.(string))
+			}
+			if addPath, ok := bgpVal["is_bgp_add_path_enabled"]; ok {
+				local.LocalBgpService.IsBgpAddPathEnabled = utils.BoolPtr(addPath.(bool))
+			}
+		}
+		if err := services.SetValue(*local); err != nil {
+			return nil, err
+		}
+		return services, nil
+	}
+
+	if remoteServices, ok := d.GetOk("remote_services"); ok && len(remoteServices.([]interface{})) > 0 {
+		val := remoteServices.([]interface{})[0].(map[string]interface{})
+		remote := import1.NewRemoteNetworkServices()
+
+		if vpnService, ok := val["remote_vpn_service"]; ok && len(vpnService.([]interface{})) > 0 {
+			vpnVal := vpnService.([]interface{})[0].(map[string]interface{})
+			remote.RemoteVpnService = import1.NewRemoteVpnService()
+			remote.RemoteVpnService.ServiceAddress = expandGatewayIPAddress(vpnVal["service_address"])
+			remote.RemoteVpnService.EbgpConfig = expandGatewayBgpConfig(vpnVal["ebgp_config"])
+		}
+		if bgpService, ok := val["remote_bgp_service"]; ok && len(bgpService.([]interface{})) > 0 {
+			bgpVal := bgpService.([]interface{})[0].(map[string]interface{})
+			remote.RemoteBgpService = import1.NewRemoteBgpService()
+			remote.RemoteBgpService.Address = expandGatewayIPAddress(bgpVal["address"])
+			remote.RemoteBgpService.Asn = utils.Int64Ptr(int64(bgpVal["asn"].(int)))
+		}
+		if err := services.SetValue(*remote); err != nil {
+			return nil, err
+		}
+		return services, nil
+	}
+	return nil, nil
+}
+
+func flattenGatewayDeployment(pr *import1.GatewayDeployment) []map[string]interface{} {
+	if pr == nil {
+		return nil
+	}
+	deployment := make(map[string]interface{})
+
+	deployment["cluster_reference"] = utils.StringValue(pr.ClusterReference)
+	deployment["vcenter_datastore_name"] = utils.StringValue(pr.VcenterDatastoreName)
+	deployment["should_synchronize_system_dns_servers"] = utils.BoolValue(pr.ShouldSynchronizeSystemDnsServers)
+	deployment["should_synchronize_system_ntp_servers"] = utils.BoolValue(pr.ShouldSynchronizeSystemNtpServers)
+	deployment["dns_servers"] = flattenNtpServer(pr.DnsServers)
+
+	interfaces := make([]map[string]interface{}, len(pr.Interfaces))
+	for k, v := range pr.Interfaces {
+		intf := make(map[string]interface{})
+
+		intf["subnet_reference"] = utils.StringValue(v.SubnetReference)
+		intf["ip_address"] = flattenIPAddress(v.IpAddress)
+		intf["default_gateway_address"] = flattenIPAddress(v.DefaultGatewayAddress)
+		intf["mtu"] = utils.IntValue(v.Mtu)
+		intf["mac_address"] = utils.StringValue(v.MacAddress)
+
+		interfaces[k] = intf
+	}
+	deployment["interfaces"] = interfaces
+
+	return []map[string]interface{}{deployment}
+}
+
+func flattenGatewayBgpConfig(pr *import1.BgpConfig, password string) []map[string]interface{} {
+	if pr == nil {
+		return nil
+	}
+	bgpConfig := make(map[string]interface{})
+
+	bgpConfig["asn"] = utils.Int64Value(pr.Asn)
+	bgpConfig["password"] = password
+	bgpConfig["should_redistribute_routes"] = utils.BoolValue(pr.ShouldRedistributeRoutes)
+
+	return []map[string]interface{}{bgpConfig}
+}
+
+// flattenGatewayServices returns the local and remote services of a gateway, only one of them is set
+func flattenGatewayServices(pr *import1.OneOfGatewayServices, localPassword, remotePassword string) ([]map[string]interface{}, []map[string]interface{}) {
+	if pr == nil {
+		return nil, nil
+	}
+
+	switch services := pr.GetValue().(type) {
+	case import1.LocalNetworkServices:
+		local := make(map[string]interface{})
+
+		local["service_address"] = flattenIPAddress(services.ServiceAddress)
+		if services.LocalVpnService != nil {
+			local["local_vpn_service"] = []map[string]interface{}{{
+				"ebgp_config": flattenGatewayBgpConfig(services.LocalVpnService.EbgpConfig, localPassword),
+			}}
+		}
+		if services.LocalBgpService != nil {
+			local["local_bgp_service"] = []map[string]interface{}{{
+				"asn":                     utils.Int64Value(services.LocalBgpService.Asn),
+				"vpc_reference":           utils.StringValue(services.LocalBgpService.VpcReference),
+				"is_bgp_add_path_enabled": utils.BoolValue(services.LocalBgpService.IsBgpAddPathEnabled),
+			}}
+		}
+		return []map[string]interface{}{local}, nil
+	case import1.RemoteNetworkServices:
+		remote := make(map[string]interface{})
+
+		if services.RemoteVpnService != nil {
+			remote["remote_vpn_service"] = []map[string]interface{}{{
+				"service_address": flattenIPAddress(services.RemoteVpnService.ServiceAddress),
+				"ebgp_config":     flattenGatewayBgpConfig(services.RemoteVpnService.EbgpConfig, remotePassword),
+			}}
+		}
+		if services.RemoteBgpService != nil {
+			remote["remote_bgp_service"] = []map[string]interface{}{{
+				"address": flattenIPAddress(services.RemoteBgpService.Address),
+				"asn":     utils.Int64Value(services.RemoteBgpService.Asn),
+			}}
+		}
+		return nil, []map[string]interface{}{remote}
+	}
+	return nil, nil
+}
+
+func flattenNetworkingStatus(pr *import1.Status) []map[string]interface{} {
+	if pr == nil {
+		return nil
+	}
+	status := make(map[string]interface{})
+
+	if pr.State != nil {
+		status["state"] = pr.State.GetName()
+	}
+	status["message"] = utils.StringValue(pr.Message)
+
+	return []map[string]interface{}{status}
+}
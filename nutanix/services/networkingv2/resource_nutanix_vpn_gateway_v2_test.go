@@ -0,0 +1,137 @@
+package networkingv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+)
+
+const resourceNameVpnGateway = "nutanix_vpn_gateway_v2.remote"
+
+func TestAccV2NutanixVpnGatewayResource_Remote(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-vpn-gateway-%d", r)
+	updatedName := fmt.Sprintf("tf-test-vpn-gateway-updated-%d", r)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testVpnGatewayRemoteConfig(name, 65002),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVpnGateway, "name", name),
+					resource.TestCheckResourceAttr(resourceNameVpnGateway, "gateway_device_vendor", "Palo Alto"),
+					resource.TestCheckResourceAttr(resourceNameVpnGateway, "remote_services.0.remote_vpn_service.0.service_address.0.ipv4.0.value", "203.0.113.10"),
+					resource.TestCheckResourceAttr(resourceNameVpnGateway, "remote_services.0.remote_vpn_service.0.ebgp_config.0.asn", "65002"),
+					resource.TestCheckResourceAttr(resourceNameVpnGateway, "local_services.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceNameVpnGateway, "ext_id"),
+					resource.TestCheckResourceAttrPair("data.nutanix_vpn_gateway_v2.test", "name", resourceNameVpnGateway, "name"),
+					resource.TestCheckResourceAttrSet("data.nutanix_vpn_gateways_v2.test", "vpn_gateways.#"),
+				),
+			},
+			{
+				Config: testVpnGatewayRemoteConfig(updatedName, 65003),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVpnGateway, "name", updatedName),
+					resource.TestCheckResourceAttr(resourceNameVpnGateway, "remote_services.0.remote_vpn_service.0.ebgp_config.0.asn", "65003"),
+				),
+			},
+			{
+				ResourceName:      resourceNameVpnGateway,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testVpnGatewayRemoteConfig(name string, asn int) string {
+	return fmt.Sprintf(`
+	resource "nutanix_vpn_gateway_v2" "remote" {
+		name                  = "%[1]s"
+		gateway_device_vendor = "Palo Alto"
+		remote_services {
+			remote_vpn_service {
+				service_address {
+					ipv4 {
+						value = "203.0.113.10"
+					}
+				}
+				ebgp_config {
+					asn = %[2]d
+				}
+			}
+		}
+	}
+
+	data "nutanix_vpn_gateway_v2" "test" {
+		ext_id = nutanix_vpn_gateway_v2.remote.id
+	}
+
+	data "nutanix_vpn_gateways_v2" "test" {
+		filter     = "name eq '%[1]s'"
+		depends_on = [nutanix_vpn_gateway_v2.remote]
+	}
+`, name, asn)
+}
+
+// testLocalGatewayBaseConfig creates a VPC behind an external VLAN subnet to deploy local gateways for
+func testLocalGatewayBaseConfig(vlanID int) string {
+	return fmt.Sprintf(`
+	data "nutanix_clusters_v2" "clusters" {}
+
+	locals {
+		cluster0 =  [
+			  for cluster in data.nutanix_clusters_v2.clusters.cluster_entities :
+			  cluster.ext_id if cluster.config[0].cluster_function[0] != "PRISM_CENTRAL"
+		][0]
+	}
+
+	resource "nutanix_subnet_v2" "test" {
+		name = "terraform-test-subnet-gateway"
+		cluster_reference = local.cluster0
+		subnet_type = "VLAN"
+		network_id = %[1]d
+		is_external = true
+		ip_config {
+			ipv4 {
+				ip_subnet {
+					ip {
+						value = "192.168.0.0"
+					}
+					prefix_length = 24
+				}
+				default_gateway_ip {
+					value = "192.168.0.1"
+				}
+				pool_list{
+					start_ip {
+						value = "192.168.0.20"
+					}
+					end_ip {
+						value = "192.168.0.30"
+					}
+				}
+			}
+		}
+	}
+
+	resource "nutanix_vpc_v2" "test" {
+		name = "terraform-test-vpc-gateway"
+		external_subnets{
+		  subnet_reference = nutanix_subnet_v2.test.id
+		}
+		externally_routable_prefixes {
+			ipv4 {
+				ip {
+					value = "172.30.0.0"
+				}
+				prefix_length = 16
+			}
+		}
+	}
+`, vlanID)
+}
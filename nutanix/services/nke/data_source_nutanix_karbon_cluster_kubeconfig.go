@@ -49,7 +49,7 @@ func DataSourceNutanixKarbonClusterKubeconfig() *schema.Resource {
 
 func dataSourceNutanixKarbonClusterKubeconfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
-	conn := meta.(*conns.Client).KarbonAPI
+	conn := meta.(*conns.Client).KarbonAPI()
 	setTimeout(meta)
 	// Make request to the API
 	karbonClusterID, iok := d.GetOk("karbon_cluster_id")
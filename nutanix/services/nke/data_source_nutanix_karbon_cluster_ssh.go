@@ -20,7 +20,7 @@ func DataSourceNutanixKarbonClusterSSH() *schema.Resource {
 
 func dataSourceNutanixKarbonClusterSSHRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
-	conn := meta.(*conns.Client).KarbonAPI
+	conn := meta.(*conns.Client).KarbonAPI()
 	setTimeout(meta)
 	// Make request to the API
 	karbonClusterID, iok := d.GetOk("karbon_cluster_id")
@@ -28,7 +28,7 @@ func DataSourceNutanixKarbonPrivateRegistries() *schema.Resource {
 
 func dataSourceNutanixKarbonPrivateRegistriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
-	conn := meta.(*conns.Client).KarbonAPI
+	conn := meta.(*conns.Client).KarbonAPI()
 	setTimeout(meta)
 	// Make request to the API
 	resp, err := conn.PrivateRegistry.ListKarbonPrivateRegistries()
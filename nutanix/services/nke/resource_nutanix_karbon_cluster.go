@@ -411,7 +411,7 @@ func resourceNutanixKarbonClusterCreate(ctx context.Context, d *schema.ResourceD
 	log.Print("[Debug] Entering resourceNutanixKarbonClusterCreate")
 	// Get client connection
 	client := meta.(*conns.Client)
-	conn := client.KarbonAPI
+	conn := client.KarbonAPI()
 	setTimeout(meta)
 	// Node pools
 	var err error
@@ -549,7 +549,7 @@ func resourceNutanixKarbonClusterCreate(ctx context.Context, d *schema.ResourceD
 func resourceNutanixKarbonClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Print("[Debug] Entering resourceNutanixKarbonClusterRead")
 	// Get client connection
-	conn := meta.(*conns.Client).KarbonAPI
+	conn := meta.(*conns.Client).KarbonAPI()
 	setTimeout(meta)
 	// Make request to the API
 	var err error
@@ -624,7 +624,7 @@ func resourceNutanixKarbonClusterUpdate(ctx context.Context, d *schema.ResourceD
 	log.Print("[Debug] Entering resourceNutanixKarbonClusterUpdate")
 	// Get client connection
 	client := meta.(*conns.Client)
-	conn := client.KarbonAPI
+	conn := client.KarbonAPI()
 	setTimeout(meta)
 
 	// Make request to the API
@@ -688,7 +688,7 @@ func resourceNutanixKarbonClusterUpdate(ctx context.Context, d *schema.ResourceD
 func resourceNutanixKarbonClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Print("[Debug] Entering resourceNutanixKarbonClusterDelete")
 	client := meta.(*conns.Client)
-	conn := client.KarbonAPI
+	conn := client.KarbonAPI()
 	setTimeout(meta)
 	timeout, timeoutErr := getTimeout(d)
 	if timeoutErr != nil {
@@ -714,7 +714,7 @@ func resourceNutanixKarbonClusterDelete(ctx context.Context, d *schema.ResourceD
 
 func resourceNutanixKarbonClusterExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	log.Print("[DEBUG] Entering resourceNutanixKarbonClusterExists")
-	conn := meta.(*conns.Client).KarbonAPI
+	conn := meta.(*conns.Client).KarbonAPI()
 	setTimeout(meta)
 	karbonClusterName, ok := d.GetOk("name")
 	var exists bool
@@ -1007,7 +1007,7 @@ func WaitForKarbonCluster(ctx context.Context, client *conns.Client, waitTimeout
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"QUEUED", "RUNNING"},
 		Target:     []string{"SUCCEEDED"},
-		Refresh:    taskStateRefreshFunc(client.API, taskUUID),
+		Refresh:    taskStateRefreshFunc(client.API(), taskUUID),
 		Timeout:    timeout,
 		Delay:      WAITDELAY,
 		MinTimeout: WAITMINTIMEOUT,
@@ -1203,7 +1203,7 @@ func determineNodepoolsScaling(client *conns.Client, karbonClusterName string, c
 					scaleUpRequest := &karbon.ClusterScaleUpIntentInput{
 						Count: amountOfNodes,
 					}
-					karbonClusterActionResponse, err := client.KarbonAPI.Cluster.ScaleUpKarbonCluster(
+					karbonClusterActionResponse, err := client.KarbonAPI().Cluster.ScaleUpKarbonCluster(
 						karbonClusterName,
 						*nnp.Name,
 						scaleUpRequest,
@@ -1218,7 +1218,7 @@ func determineNodepoolsScaling(client *conns.Client, karbonClusterName string, c
 					scaleDownRequest := &karbon.ClusterScaleDownIntentInput{
 						Count: amountOfNodes,
 					}
-					karbonClusterActionResponse, err := client.KarbonAPI.Cluster.ScaleDownKarbonCluster(
+					karbonClusterActionResponse, err := client.KarbonAPI().Cluster.ScaleDownKarbonCluster(
 						karbonClusterName,
 						*nnp.Name,
 						scaleDownRequest,
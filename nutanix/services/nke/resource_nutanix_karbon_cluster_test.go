@@ -156,7 +156,7 @@ func testAccCheckNutanixKarbonClusterDestroy(s *terraform.State) error {
 			continue
 		}
 		for {
-			_, err := conn.KarbonAPI.Cluster.GetKarbonCluster(rs.Primary.ID)
+			_, err := conn.KarbonAPI().Cluster.GetKarbonCluster(rs.Primary.ID)
 			if err != nil {
 				if strings.Contains(fmt.Sprint(err), "Not Found:K8s cluster not found.") {
 					return nil
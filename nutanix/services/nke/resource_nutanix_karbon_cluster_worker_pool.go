@@ -115,7 +115,7 @@ func ResourceNutanixKarbonWorkerNodePool() *schema.Resource {
 
 func resourceNutanixKarbonWorkerNodePoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*conns.Client)
-	conn := client.KarbonAPI
+	conn := client.KarbonAPI()
 
 	addworkerRequest := &karbon.ClusterNodePool{}
 	nkeName := ""
@@ -186,7 +186,7 @@ func resourceNutanixKarbonWorkerNodePoolCreate(ctx context.Context, d *schema.Re
 }
 
 func resourceNutanixKarbonWorkerNodePoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).KarbonAPI
+	conn := meta.(*conns.Client).KarbonAPI()
 	setTimeout(meta)
 	// Make request to the API
 	var err error
@@ -233,7 +233,7 @@ func resourceNutanixKarbonWorkerNodePoolRead(ctx context.Context, d *schema.Reso
 
 func resourceNutanixKarbonWorkerNodePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*conns.Client)
-	conn := client.KarbonAPI
+	conn := client.KarbonAPI()
 
 	karbonClsName := d.Get("cluster_name")
 	workerName := d.Get("name")
@@ -255,7 +255,7 @@ func resourceNutanixKarbonWorkerNodePoolUpdate(ctx context.Context, d *schema.Re
 			scaleDownRequest := &karbon.ClusterScaleDownIntentInput{
 				Count: int64(amountOfNodes),
 			}
-			karbonClusterActionResponse, err := client.KarbonAPI.Cluster.ScaleDownKarbonCluster(
+			karbonClusterActionResponse, err := client.KarbonAPI().Cluster.ScaleDownKarbonCluster(
 				*resp.Name,
 				*nodepool.Name,
 				scaleDownRequest,
@@ -272,7 +272,7 @@ func resourceNutanixKarbonWorkerNodePoolUpdate(ctx context.Context, d *schema.Re
 			scaleUpRequest := &karbon.ClusterScaleUpIntentInput{
 				Count: int64(amountOfNodes),
 			}
-			karbonClusterActionResponse, err := client.KarbonAPI.Cluster.ScaleUpKarbonCluster(
+			karbonClusterActionResponse, err := client.KarbonAPI().Cluster.ScaleUpKarbonCluster(
 				*resp.Name,
 				*nodepool.Name,
 				scaleUpRequest,
@@ -315,7 +315,7 @@ func resourceNutanixKarbonWorkerNodePoolUpdate(ctx context.Context, d *schema.Re
 		updateLabelRequest.AddLabel = addLabelMap
 		updateLabelRequest.RemoveLabel = removeLabel
 
-		nodeLabelActionResponse, err := client.KarbonAPI.Cluster.UpdateWorkerNodeLables(
+		nodeLabelActionResponse, err := client.KarbonAPI().Cluster.UpdateWorkerNodeLables(
 			*resp.Name,
 			*nodepool.Name,
 			updateLabelRequest,
@@ -333,7 +333,7 @@ func resourceNutanixKarbonWorkerNodePoolUpdate(ctx context.Context, d *schema.Re
 
 func resourceNutanixKarbonWorkerNodePoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*conns.Client)
-	conn := client.KarbonAPI
+	conn := client.KarbonAPI()
 
 	var err error
 	karbonClsName := d.Get("cluster_name")
@@ -368,7 +368,7 @@ func resourceNutanixKarbonWorkerNodePoolDelete(ctx context.Context, d *schema.Re
 		return diag.FromErr(err)
 	}
 
-	workerNodeDeleteResponse, er := client.KarbonAPI.Cluster.DeleteWorkerNodePool(
+	workerNodeDeleteResponse, er := client.KarbonAPI().Cluster.DeleteWorkerNodePool(
 		karbonClusterName,
 		workerName.(string),
 	)
@@ -71,7 +71,7 @@ func resourceNutanixKarbonPrivateRegistryCreate(ctx context.Context, d *schema.R
 	log.Print("[Debug] Entering resourceNutanixKarbonPrivateRegistryCreate")
 	// Get client connection
 	client := meta.(*conns.Client)
-	conn := client.KarbonAPI
+	conn := client.KarbonAPI()
 	setTimeout(meta)
 	// Prepare request
 	karbonPrivateRegistry := &karbon.PrivateRegistryIntentInput{}
@@ -117,7 +117,7 @@ func resourceNutanixKarbonPrivateRegistryCreate(ctx context.Context, d *schema.R
 func resourceNutanixKarbonPrivateRegistryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Print("[Debug] Entering resourceNutanixKarbonPrivateRegistryRead")
 	// Get client connection
-	conn := meta.(*conns.Client).KarbonAPI
+	conn := meta.(*conns.Client).KarbonAPI()
 	setTimeout(meta)
 	// Make request to the API
 	var name interface{}
@@ -148,7 +148,7 @@ func resourceNutanixKarbonPrivateRegistryUpdate(ctx context.Context, d *schema.R
 func resourceNutanixKarbonPrivateRegistryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Print("[Debug] Entering resourceNutanixKarbonPrivateRegistryDelete")
 	client := meta.(*conns.Client)
-	conn := client.KarbonAPI
+	conn := client.KarbonAPI()
 	setTimeout(meta)
 	karbonPrivateRegistryName := d.Get("name").(string)
 
@@ -162,7 +162,7 @@ func resourceNutanixKarbonPrivateRegistryDelete(ctx context.Context, d *schema.R
 
 func resourceNutanixKarbonPrivateRegistryExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	log.Print("[DEBUG] Entering resourceNutanixKarbonPrivateRegistryExists")
-	conn := meta.(*conns.Client).KarbonAPI
+	conn := meta.(*conns.Client).KarbonAPI()
 	setTimeout(meta)
 	// Make request to the API
 	var name interface{}
@@ -76,7 +76,7 @@ func DatasourceNutanixObjectStoreCertificateV2() *schema.Resource {
 }
 
 func DatasourceNutanixObjectStoreCertificateV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ObjectStoreAPI
+	conn := meta.(*conns.Client).ObjectStoreAPI()
 
 	objectStoreExtID := d.Get("object_store_ext_id").(string)
 	certificateExtID := d.Get("ext_id").(string)
@@ -44,7 +44,7 @@ func DatasourceNutanixObjectStoreCertificatesV2() *schema.Resource {
 }
 
 func DatasourceNutanixObjectStoreCertificatesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ObjectStoreAPI
+	conn := meta.(*conns.Client).ObjectStoreAPI()
 
 	// initialize query params
 	var filter, selects *string
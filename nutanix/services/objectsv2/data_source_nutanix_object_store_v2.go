@@ -143,7 +143,7 @@ func DatasourceNutanixObjectStoreV2() *schema.Resource {
 }
 
 func DatasourceNutanixObjectStoreV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ObjectStoreAPI
+	conn := meta.(*conns.Client).ObjectStoreAPI()
 
 	objectStoreExtID := d.Get("ext_id").(string)
 
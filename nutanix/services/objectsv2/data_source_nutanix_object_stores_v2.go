@@ -52,7 +52,7 @@ func DatasourceNutanixObjectStoresV2() *schema.Resource {
 }
 
 func DatasourceNutanixObjectStoresV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ObjectStoreAPI
+	conn := meta.(*conns.Client).ObjectStoreAPI()
 
 	// initialize query params
 	var filter, orderBy, expand, selects *string
@@ -50,7 +50,7 @@ func testAccCheckNutanixObjectStoreDestroy(s *terraform.State) error {
 		}
 
 		// Now check if the object store itself is deleted
-		objStore, err := conn.ObjectStoreAPI.ObjectStoresAPIInstance.GetObjectstoreById(utils.StringPtr(rs.Primary.ID))
+		objStore, err := conn.ObjectStoreAPI().ObjectStoresAPIInstance.GetObjectstoreById(utils.StringPtr(rs.Primary.ID))
 		if err == nil && objStore != nil {
 			return fmt.Errorf("object store still exists: %s", rs.Primary.ID)
 		}
@@ -6,7 +6,6 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	objectsCommon "github.com/nutanix/ntnx-api-golang-clients/objects-go-client/v4/models/common/v1/config"
 	"github.com/nutanix/ntnx-api-golang-clients/objects-go-client/v4/models/objects/v4/config"
@@ -76,7 +75,7 @@ func ResourceNutanixObjectStoreCertificateV2() *schema.Resource {
 }
 
 func ResourceNutanixObjectStoreCertificateV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ObjectStoreAPI
+	conn := meta.(*conns.Client).ObjectStoreAPI()
 
 	objectStoreExtID := d.Get("object_store_ext_id").(string)
 
@@ -100,16 +99,9 @@ func ResourceNutanixObjectStoreCertificateV2Create(ctx context.Context, d *schem
 	TaskRef := resp.Data.GetValue().(objectPrismConfig.TaskReference)
 	taskUUID := TaskRef.ExtId
 
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the object store certificate to be created
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutCreate),
-	}
-
-	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err = common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return diag.Errorf("error waiting for object store certificate (%s) to be created: %s", utils.StringValue(taskUUID), err)
 	}
 
@@ -132,7 +124,7 @@ func ResourceNutanixObjectStoreCertificateV2Create(ctx context.Context, d *schem
 }
 
 func ResourceNutanixObjectStoreCertificateV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ObjectStoreAPI
+	conn := meta.(*conns.Client).ObjectStoreAPI()
 
 	objectStoreExtID := d.Get("object_store_ext_id").(string)
 
@@ -8,7 +8,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	objectsCommon "github.com/nutanix/ntnx-api-golang-clients/objects-go-client/v4/models/common/v1/config"
@@ -43,7 +42,7 @@ func ResourceNutanixObjectStoresV2() *schema.Resource {
 			if d.Id() == "" {
 				return false
 			}
-			client := meta.(*conns.Client).ObjectStoreAPI
+			client := meta.(*conns.Client).ObjectStoreAPI()
 			resp, err := client.ObjectStoresAPIInstance.GetObjectstoreById(utils.StringPtr(d.Id()))
 			if err != nil {
 				return false
@@ -201,7 +200,7 @@ func ResourceNutanixObjectStoresV2() *schema.Resource {
 }
 
 func ResourceNutanixObjectsV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ObjectStoreAPI
+	conn := meta.(*conns.Client).ObjectStoreAPI()
 
 	objectStorePayload := config.NewObjectStore()
 
@@ -265,16 +264,9 @@ func ResourceNutanixObjectsV2Create(ctx context.Context, d *schema.ResourceData,
 	TaskRef := resp.Data.GetValue().(objectPrismConfig.TaskReference)
 	taskUUID := TaskRef.ExtId
 
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the object store to be created
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutCreate),
-	}
-
-	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err = common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); err != nil {
 		log.Printf("[DEBUG] deploy object store task error: %s", err)
 
 		taskResp, taskErr := taskconn.TaskRefAPI.GetTaskById(taskUUID, nil)
@@ -327,7 +319,7 @@ func ResourceNutanixObjectsV2Create(ctx context.Context, d *schema.ResourceData,
 
 func ResourceNutanixObjectsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Reading object store %s", d.Id())
-	conn := meta.(*conns.Client).ObjectStoreAPI
+	conn := meta.(*conns.Client).ObjectStoreAPI()
 
 	readResp, err := conn.ObjectStoresAPIInstance.GetObjectstoreById(utils.StringPtr(d.Id()))
 	if err != nil {
@@ -404,7 +396,7 @@ func ResourceNutanixObjectsV2Read(ctx context.Context, d *schema.ResourceData, m
 }
 
 func ResourceNutanixObjectsV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ObjectStoreAPI
+	conn := meta.(*conns.Client).ObjectStoreAPI()
 
 	readResp, err := conn.ObjectStoresAPIInstance.GetObjectstoreById(utils.StringPtr(d.Id()))
 	if err != nil {
@@ -428,15 +420,9 @@ func ResourceNutanixObjectsV2Update(ctx context.Context, d *schema.ResourceData,
 	}
 	TaskRef := resp.Data.GetValue().(objectPrismConfig.TaskReference)
 	taskUUID := TaskRef.ExtId
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the object store to be updated
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutUpdate),
-	}
-	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err = common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutUpdate)); err != nil {
 		return diag.Errorf("error waiting for object store (%s) to be updated: %s", utils.StringValue(taskUUID), err)
 	}
 	taskResp, err := taskconn.TaskRefAPI.GetTaskById(taskUUID, nil)
@@ -451,7 +437,7 @@ func ResourceNutanixObjectsV2Update(ctx context.Context, d *schema.ResourceData,
 }
 
 func ResourceNutanixObjectsV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ObjectStoreAPI
+	conn := meta.(*conns.Client).ObjectStoreAPI()
 
 	readResp, err := conn.ObjectStoresAPIInstance.GetObjectstoreById(utils.StringPtr(d.Id()))
 	if err != nil {
@@ -470,15 +456,9 @@ func ResourceNutanixObjectsV2Delete(ctx context.Context, d *schema.ResourceData,
 
 	TaskRef := resp.Data.GetValue().(objectPrismConfig.TaskReference)
 	taskUUID := TaskRef.ExtId
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the object store to be deleted
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutDelete),
-	}
-	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err = common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return diag.Errorf("error waiting for object store (%s) to be deleted: %s", utils.StringValue(taskUUID), err)
 	}
 	taskResp, err := taskconn.TaskRefAPI.GetTaskById(taskUUID, nil)
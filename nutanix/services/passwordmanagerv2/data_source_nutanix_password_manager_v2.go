@@ -101,7 +101,7 @@ func DataSourceNutanixPasswordManagersV2() *schema.Resource {
 }
 
 func dataSourceNutanixPasswordManagerV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ClusterAPI
+	conn := meta.(*conns.Client).ClusterAPI()
 	// initialize query params
 	var filter, orderBy, selects *string
 	var page, limit *int
@@ -46,7 +46,7 @@ func dataSourceNutanixCategoryKeyRead(ctx context.Context, d *schema.ResourceDat
 	log.Printf("[DEBUG] Reading CategoryKey: %s", d.Get("name").(string))
 
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	// Make request to the API
 	resp, err := conn.V3.GetCategoryKey(d.Get("name").(string))
@@ -502,7 +502,7 @@ func DataSourceNutanixProject() *schema.Resource {
 }
 
 func dataSourceNutanixProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	id, iok := d.GetOk("project_id")
 	name, nOk := d.GetOk("project_name")
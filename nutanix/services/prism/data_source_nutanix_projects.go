@@ -233,7 +233,7 @@ func DataSourceNutanixProjects() *schema.Resource {
 
 func dataSourceNutanixProjectsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	req := &v3.DSMetadata{}
 
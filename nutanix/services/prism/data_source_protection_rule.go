@@ -231,7 +231,7 @@ func DataSourceNutanixProtectionRule() *schema.Resource {
 }
 
 func dataSourceNutanixProtectionRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 	protectionRuleID, iOk := d.GetOk("protection_rule_id")
 	protectionRuleName, nOk := d.GetOk("protection_rule_name")
 
@@ -230,7 +230,7 @@ func DataSourceNutanixProtectionRules() *schema.Resource {
 
 func dataSourceNutanixProtectionRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 	req := &v3.DSMetadata{}
 
 	metadata, filtersOk := d.GetOk("metadata")
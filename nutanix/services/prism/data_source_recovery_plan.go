@@ -541,7 +541,7 @@ func DataSourceNutanixRecoveryPlan() *schema.Resource {
 }
 
 func dataSourceNutanixRecoveryPlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 	recoveryPlanID, iOk := d.GetOk("recovery_plan_id")
 	recoveryPlanName, nOk := d.GetOk("recovery_plan_name")
 
@@ -540,7 +540,7 @@ func DataSourceNutanixRecoveryPlans() *schema.Resource {
 
 func dataSourceNutanixRecoveryPlansRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	req := &v3.DSMetadata{}
 
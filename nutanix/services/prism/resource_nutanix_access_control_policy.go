@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spf13/cast"
 	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
 	v3 "github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v3/prism"
 	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
@@ -26,6 +27,7 @@ func ResourceNutanixAccessControlPolicy() *schema.Resource {
 		ReadContext:   resourceNutanixAccessControlPolicyRead,
 		UpdateContext: resourceNutanixAccessControlPolicyUpdate,
 		DeleteContext: resourceNutanixAccessControlPolicyDelete,
+		CustomizeDiff: common.DefaultCategoriesDiff("categories"),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -286,7 +288,7 @@ func ResourceNutanixAccessControlPolicy() *schema.Resource {
 }
 
 func resourceNutanixAccessControlPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 	request := &v3.AccessControlPolicy{}
 	spec := &v3.AccessControlPolicySpec{}
 	metadata := &v3.Metadata{}
@@ -349,7 +351,7 @@ func resourceNutanixAccessControlPolicyCreate(ctx context.Context, d *schema.Res
 }
 
 func resourceNutanixAccessControlPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 	id := d.Id()
 	resp, err := conn.V3.GetAccessControlPolicy(id)
 	if err != nil {
@@ -421,7 +423,7 @@ func resourceNutanixAccessControlPolicyRead(ctx context.Context, d *schema.Resou
 }
 
 func resourceNutanixAccessControlPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 	request := &v3.AccessControlPolicy{}
 	metadata := &v3.Metadata{}
 	res := &v3.AccessControlPolicyResources{}
@@ -508,7 +510,7 @@ func resourceNutanixAccessControlPolicyUpdate(ctx context.Context, d *schema.Res
 }
 
 func resourceNutanixAccessControlPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	resp, err := conn.V3.DeleteAccessControlPolicy(d.Id())
 	if err != nil {
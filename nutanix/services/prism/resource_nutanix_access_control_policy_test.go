@@ -260,7 +260,7 @@ func testAccCheckNutanixAccessControlPolicyDestroy(s *terraform.State) error {
 		if rs.Type != "nutanix_access_control_policy" {
 			continue
 		}
-		if _, err := resourceNutanixAccessControlPolicyExists(conn.API, rs.Primary.ID); err != nil {
+		if _, err := resourceNutanixAccessControlPolicyExists(conn.API(), rs.Primary.ID); err != nil {
 			if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
 				return nil
 			}
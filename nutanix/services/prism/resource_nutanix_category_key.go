@@ -47,7 +47,7 @@ func ResourceNutanixCategoryKey() *schema.Resource {
 func resourceNutanixCategoryKeyCreateOrUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Creating CategoryKey: %s", resourceData.Get("name").(string))
 
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	request := &v3.CategoryKey{}
 
@@ -83,7 +83,7 @@ func resourceNutanixCategoryKeyRead(ctx context.Context, d *schema.ResourceData,
 	log.Printf("[DEBUG] Reading CategoryKey: %s", d.Get("name").(string))
 
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	// Make request to the API
 	resp, err := conn.V3.GetCategoryKey(d.Id())
@@ -103,7 +103,7 @@ func resourceNutanixCategoryKeyRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceNutanixCategoryKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	log.Printf("[Debug] Destroying the category with the ID %s", d.Id())
 
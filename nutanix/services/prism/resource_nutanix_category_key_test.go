@@ -92,7 +92,7 @@ func testAccCheckNutanixCategoryKeyDestroy(s *terraform.State) error {
 			continue
 		}
 		for {
-			_, err := conn.API.V3.GetCategoryKey(rs.Primary.ID)
+			_, err := conn.API().V3.GetCategoryKey(rs.Primary.ID)
 			if err != nil {
 				if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
 					return nil
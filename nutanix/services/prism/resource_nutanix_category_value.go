@@ -49,7 +49,7 @@ func ResourceNutanixCategoryValue() *schema.Resource {
 func resourceNutanixCategoryValueCreateOrUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Creating CategoryValue: %s", resourceData.Get("value").(string))
 
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	request := &v3.CategoryValue{}
 
@@ -93,7 +93,7 @@ func resourceNutanixCategoryValueRead(ctx context.Context, d *schema.ResourceDat
 	}
 
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	// Make request to the API
 	resp, err := conn.V3.GetCategoryValue(name.(string), d.Id())
@@ -113,7 +113,7 @@ func resourceNutanixCategoryValueRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceNutanixCategoryValueDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	name, nameOK := d.GetOk("name")
 
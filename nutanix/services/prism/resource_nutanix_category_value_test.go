@@ -87,7 +87,7 @@ func testAccCheckNutanixCategoryValueDestroy(s *terraform.State) error {
 		}
 
 		for {
-			_, err := conn.API.V3.GetCategoryValue(rs.Primary.Attributes["name"], rs.Primary.ID)
+			_, err := conn.API().V3.GetCategoryValue(rs.Primary.Attributes["name"], rs.Primary.ID)
 			if err != nil {
 				if strings.Contains(fmt.Sprint(err), "CATEGORY_NAME_VALUE_MISMATCH") {
 					return nil
@@ -9,11 +9,13 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spf13/cast"
 	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
 	v3 "github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v3/prism"
 	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
@@ -32,7 +34,10 @@ func ResourceNutanixProject() *schema.Resource {
 			Update: schema.DefaultTimeout(DEFAULTWAITTIMEOUT * time.Minute),
 			Delete: schema.DefaultTimeout(DEFAULTWAITTIMEOUT * time.Minute),
 		},
-		CustomizeDiff: customizeDiffProjectACP,
+		CustomizeDiff: customdiff.All(
+			customizeDiffProjectACP,
+			common.DefaultCategoriesDiff("categories"),
+		),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -762,7 +767,7 @@ func ResourceNutanixProject() *schema.Resource {
 }
 
 func resourceNutanixProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	var uuid, taskUUID string
 	// if use project internal flag is set ,  we will use projects_internal API
@@ -908,7 +913,7 @@ func resourceNutanixProjectCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceNutanixProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 	diags := diag.Diagnostics{}
 
 	//nolint:staticcheck
@@ -1078,7 +1083,7 @@ func resourceNutanixProjectRead(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceNutanixProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 	diags := diag.Diagnostics{}
 
 	var uuid, taskUUID string
@@ -1281,7 +1286,7 @@ func resourceNutanixProjectUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceNutanixProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	resp, err := conn.V3.DeleteProject(d.Id())
 	if err != nil {
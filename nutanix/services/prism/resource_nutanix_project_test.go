@@ -236,7 +236,7 @@ func testAccCheckNutanixProjectDestroy(s *terraform.State) error {
 			continue
 		}
 		for {
-			_, err := conn.API.V3.GetProject(rs.Primary.ID)
+			_, err := conn.API().V3.GetProject(rs.Primary.ID)
 			if err != nil {
 				if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
 					return nil
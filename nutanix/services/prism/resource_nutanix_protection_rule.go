@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spf13/cast"
 	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
 	v3 "github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v3/prism"
 	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
@@ -23,6 +24,7 @@ func ResourceNutanixProtectionRule() *schema.Resource {
 		ReadContext:   resourceNutanixProtectionRuleRead,
 		UpdateContext: resourceNutanixProtectionRuleUpdate,
 		DeleteContext: resourceNutanixProtectionRuleDelete,
+		CustomizeDiff: common.DefaultCategoriesDiff("categories"),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -269,7 +271,7 @@ func ResourceNutanixProtectionRule() *schema.Resource {
 }
 
 func resourceNutanixProtectionRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	request := &v3.ProtectionRuleInput{}
 	spec := &v3.ProtectionRuleSpec{}
@@ -341,7 +343,7 @@ func resourceNutanixProtectionRuleCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceNutanixProtectionRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	id := d.Id()
 	resp, err := conn.V3.GetProtectionRule(id)
@@ -396,7 +398,7 @@ func resourceNutanixProtectionRuleRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceNutanixProtectionRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	request := &v3.ProtectionRuleInput{}
 	metadata := &v3.Metadata{}
@@ -481,7 +483,7 @@ func resourceNutanixProtectionRuleUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceNutanixProtectionRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	resp, err := conn.V3.DeleteProtectionRule(d.Id())
 	if err != nil {
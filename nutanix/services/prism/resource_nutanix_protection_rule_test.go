@@ -118,7 +118,7 @@ func testAccCheckNutanixProtectionRuleDestroy(s *terraform.State) error {
 			continue
 		}
 		for {
-			_, err := conn.API.V3.GetProtectionRule(rs.Primary.ID)
+			_, err := conn.API().V3.GetProtectionRule(rs.Primary.ID)
 			if err != nil {
 				if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
 					return nil
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/spf13/cast"
 	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
 	v3 "github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v3/prism"
 	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
@@ -22,6 +23,7 @@ func ResourceNutanixRecoveryPlan() *schema.Resource {
 		ReadContext:   resourceNutanixRecoveryPlanRead,
 		UpdateContext: resourceNutanixRecoveryPlanUpdate,
 		DeleteContext: resourceNutanixRecoveryPlanDelete,
+		CustomizeDiff: common.DefaultCategoriesDiff("categories"),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -620,7 +622,7 @@ func ResourceNutanixRecoveryPlan() *schema.Resource {
 }
 
 func resourceNutanixRecoveryPlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	request := &v3.RecoveryPlanInput{}
 	spec := &v3.RecoveryPlanSpec{}
@@ -690,7 +692,7 @@ func resourceNutanixRecoveryPlanCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceNutanixRecoveryPlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	id := d.Id()
 	resp, err := conn.V3.GetRecoveryPlan(id)
@@ -737,7 +739,7 @@ func resourceNutanixRecoveryPlanRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceNutanixRecoveryPlanUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	request := &v3.RecoveryPlanInput{}
 	metadata := &v3.Metadata{}
@@ -818,7 +820,7 @@ func resourceNutanixRecoveryPlanUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceNutanixRecoveryPlanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	resp, err := conn.V3.DeleteRecoveryPlan(d.Id())
 	if err != nil {
@@ -205,7 +205,7 @@ func testAccCheckNutanixRecoveryPlanDestroy(s *terraform.State) error {
 			continue
 		}
 		for {
-			_, err := conn.API.V3.GetRecoveryPlan(rs.Primary.ID)
+			_, err := conn.API().V3.GetRecoveryPlan(rs.Primary.ID)
 			if err != nil {
 				if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
 					return nil
@@ -642,7 +642,7 @@ func generateCustomFilters(permissionList []string) []interface{} {
 }
 
 func getRolesPermission(roleID string, meta interface{}, projectUUID string, clusterUUID string, pcCollab bool) []*v3.ContextList {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	resp, _ := conn.V3.GetRole(roleID)
 
@@ -144,7 +144,7 @@ func DatasourceNutanixCategoriesV2() *schema.Resource {
 }
 
 func DatasourceNutanixCategoriesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).PrismAPI
+	conn := meta.(*conns.Client).PrismAPI()
 
 	// initialize query params
 	var filter, orderBy, expand, selects *string
@@ -116,7 +116,7 @@ func DatasourceNutanixCategoryV2() *schema.Resource {
 }
 
 func DatasourceNutanixCategoryV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).PrismAPI
+	conn := meta.(*conns.Client).PrismAPI()
 
 	extID := d.Get("ext_id")
 	var expand *string
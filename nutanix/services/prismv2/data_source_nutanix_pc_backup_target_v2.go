@@ -128,7 +128,7 @@ func DatasourceNutanixBackupTargetV2() *schema.Resource {
 }
 
 func DatasourceNutanixBackupTargetV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).PrismAPI
+	conn := meta.(*conns.Client).PrismAPI()
 
 	domainManagerExtID := d.Get("domain_manager_ext_id").(string)
 	backupTargetExtID := d.Get("ext_id").(string)
@@ -28,7 +28,7 @@ func DatasourceNutanixBackupTargetsV2() *schema.Resource {
 }
 
 func DatasourceNutanixBackupTargetsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).PrismAPI
+	conn := meta.(*conns.Client).PrismAPI()
 
 	domainManagerExtID := d.Get("domain_manager_ext_id").(string)
 
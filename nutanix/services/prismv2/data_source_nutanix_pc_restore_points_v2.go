@@ -57,7 +57,7 @@ func DatasourceNutanixFetchRestorePointsV2() *schema.Resource {
 }
 
 func DatasourceNutanixRestorePointsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).PrismAPI
+	conn := meta.(*conns.Client).PrismAPI()
 
 	restoreSourceExtID := utils.StringPtr(d.Get("restore_source_ext_id").(string))
 	restorableDomainManagerExtID := utils.StringPtr(d.Get("restorable_domain_manager_ext_id").(string))
@@ -114,7 +114,7 @@ func DatasourceNutanixRestoreSourceV2() *schema.Resource {
 }
 
 func DatasourceNutanixRestoreSourceV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).PrismAPI
+	conn := meta.(*conns.Client).PrismAPI()
 
 	restoreSourceExtID := d.Get("ext_id").(string)
 
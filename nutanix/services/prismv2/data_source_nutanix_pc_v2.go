@@ -51,7 +51,7 @@ func DatasourceNutanixFetchPcV2() *schema.Resource {
 }
 
 func DatasourceNutanixPcV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).PrismAPI
+	conn := meta.(*conns.Client).PrismAPI()
 
 	pcExtID := d.Get("ext_id").(string)
 	resp, err := conn.DomainManagerAPIInstance.GetDomainManagerById(utils.StringPtr(pcExtID))
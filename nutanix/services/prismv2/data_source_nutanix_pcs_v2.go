@@ -28,7 +28,7 @@ func DatasourceNutanixListPcsV2() *schema.Resource {
 }
 
 func DatasourceNutanixListPcsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).PrismAPI
+	conn := meta.(*conns.Client).PrismAPI()
 	var selects *string
 
 	if selectf, ok := d.GetOk("select"); ok {
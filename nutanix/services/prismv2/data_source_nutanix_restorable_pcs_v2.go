@@ -42,7 +42,7 @@ func DatasourceNutanixListRestorablePcsV2() *schema.Resource {
 }
 
 func DatasourceNutanixListRestorablePcsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).PrismAPI
+	conn := meta.(*conns.Client).PrismAPI()
 	var filter *string
 	var page, limit *int
 
@@ -68,7 +68,7 @@ func checkAttributeLength(resourceName, attribute string, minLength int) resourc
 func checkClusterLocationBackupTargetExistAndDeleteIfExists() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acc.TestAccProvider.Meta().(*conns.Client)
-		client := conn.PrismAPI.DomainManagerBackupsAPIInstance
+		client := conn.PrismAPI().DomainManagerBackupsAPIInstance
 
 		outputClusterExtID, ok := s.RootModule().Outputs["clusterExtID"]
 		if !ok {
@@ -134,7 +134,7 @@ func checkClusterLocationBackupTargetExistAndDeleteIfExists() resource.TestCheck
 func checkObjectStoreLocationBackupTargetExistAndDeleteIfExists() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acc.TestAccProvider.Meta().(*conns.Client)
-		client := conn.PrismAPI.DomainManagerBackupsAPIInstance
+		client := conn.PrismAPI().DomainManagerBackupsAPIInstance
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type == "nutanix_pc_backup_targets_v2" {
@@ -188,7 +188,7 @@ func checkObjectStoreLocationBackupTargetExistAndDeleteIfExists() resource.TestC
 func checkClusterLocationBackupTargetExistAndCreateIfNotExists() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acc.TestAccProvider.Meta().(*conns.Client)
-		client := conn.PrismAPI.DomainManagerBackupsAPIInstance
+		client := conn.PrismAPI().DomainManagerBackupsAPIInstance
 
 		outputClusterExtID, ok := s.RootModule().Outputs["clusterExtID"]
 		if !ok {
@@ -256,7 +256,7 @@ func checkClusterLocationBackupTargetExistAndCreateIfNotExists() resource.TestCh
 		TaskRef := resp.Data.GetValue().(config.TaskReference)
 		taskUUID := TaskRef.ExtId
 
-		taskconn := conn.PrismAPI
+		taskconn := conn.PrismAPI()
 		// Wait for the backup target to be deleted
 		stateConf := &resource.StateChangeConf{
 			Pending: []string{"PENDING", "RUNNING", "QUEUED"},
@@ -301,7 +301,7 @@ func checkClusterLocationBackupTargetExistAndCreateIfNotExists() resource.TestCh
 func checkObjectStoreLocationBackupTargetExistAndCreateIfNotExists() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acc.TestAccProvider.Meta().(*conns.Client)
-		client := conn.PrismAPI.DomainManagerBackupsAPIInstance
+		client := conn.PrismAPI().DomainManagerBackupsAPIInstance
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type == "nutanix_pc_backup_targets_v2" {
@@ -378,7 +378,7 @@ func checkObjectStoreLocationBackupTargetExistAndCreateIfNotExists() resource.Te
 		TaskRef := resp.Data.GetValue().(config.TaskReference)
 		taskUUID := TaskRef.ExtId
 
-		taskconn := conn.PrismAPI
+		taskconn := conn.PrismAPI()
 		// Wait for the backup target to be deleted
 		stateConf := &resource.StateChangeConf{
 			Pending: []string{"PENDING", "RUNNING", "QUEUED"},
@@ -404,7 +404,7 @@ func checkObjectStoreLocationBackupTargetExistAndCreateIfNotExists() resource.Te
 func checkLastSyncTimeBackupTarget(domainManagerExtID, backupTargetExtID *string, retries int, delay time.Duration) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acc.TestAccProvider.Meta().(*conns.Client)
-		client := conn.PrismAPI.DomainManagerBackupsAPIInstance
+		client := conn.PrismAPI().DomainManagerBackupsAPIInstance
 		if *backupTargetExtID == "" && *domainManagerExtID == "" {
 			return fmt.Errorf("backup target ext_id and domain manager ext_id not set")
 		}
@@ -436,7 +436,7 @@ func waitDeleteTask(resp *management.DeleteBackupTargetApiResponse) error {
 	TaskRef := resp.Data.GetValue().(config.TaskReference)
 	taskUUID := TaskRef.ExtId
 
-	taskconn := conn.PrismAPI
+	taskconn := conn.PrismAPI()
 	// Wait for the backup target to be deleted
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
@@ -468,7 +468,7 @@ func taskStateRefreshPrismTaskGroupFunc(taskUUID string) resource.StateRefreshFu
 	return func() (interface{}, string, error) {
 		// data := base64.StdEncoding.EncodeToString([]byte("ergon"))
 		// encodeUUID := data + ":" + taskUUID
-		vresp, err := conn.PrismAPI.TaskRefAPI.GetTaskById(utils.StringPtr(taskUUID), nil)
+		vresp, err := conn.PrismAPI().TaskRefAPI.GetTaskById(utils.StringPtr(taskUUID), nil)
 
 		if err != nil {
 			return "", "", (fmt.Errorf("error while polling prism task: %v", err))
@@ -516,7 +516,7 @@ func getTaskStatus(pr *config.TaskStatus) string {
 func checkClusterLocationBackupTargetExistAndCreateIfNot(backupTargetExtID, domainManagerExtID *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acc.TestAccProvider.Meta().(*conns.Client)
-		client := conn.PrismAPI.DomainManagerBackupsAPIInstance
+		client := conn.PrismAPI().DomainManagerBackupsAPIInstance
 
 		// Extract the output value for use in later steps
 		outputDomainManagerExtID, ok := s.RootModule().Outputs["domainManagerExtID"]
@@ -584,7 +584,7 @@ func checkClusterLocationBackupTargetExistAndCreateIfNot(backupTargetExtID, doma
 		TaskRef := resp.Data.GetValue().(config.TaskReference)
 		taskUUID := TaskRef.ExtId
 
-		taskconn := conn.PrismAPI
+		taskconn := conn.PrismAPI()
 		// Wait for the backup target to be deleted
 		stateConf := &resource.StateChangeConf{
 			Pending: []string{"PENDING", "RUNNING", "QUEUED"},
@@ -632,7 +632,7 @@ func checkClusterLocationBackupTargetExistAndCreateIfNot(backupTargetExtID, doma
 func checkObjectRestoreLocationBackupTargetExistAndCreateIfNot(backupTargetExtID, domainManagerExtID *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acc.TestAccProvider.Meta().(*conns.Client)
-		client := conn.PrismAPI.DomainManagerBackupsAPIInstance
+		client := conn.PrismAPI().DomainManagerBackupsAPIInstance
 
 		// Extract the output value for use in later steps
 		outputDomainManagerExtID, ok := s.RootModule().Outputs["domainManagerExtID"]
@@ -709,7 +709,7 @@ func checkObjectRestoreLocationBackupTargetExistAndCreateIfNot(backupTargetExtID
 		TaskRef := resp.Data.GetValue().(config.TaskReference)
 		taskUUID := TaskRef.ExtId
 
-		taskconn := conn.PrismAPI
+		taskconn := conn.PrismAPI()
 		// Wait for the backup target to be deleted
 		stateConf := &resource.StateChangeConf{
 			Pending: []string{"PENDING", "RUNNING", "QUEUED"},
@@ -760,7 +760,7 @@ func checkLastSyncTimeBackupTargetRestorePC(backupTargetExtID *string, retries i
 		log.Printf("[DEBUG] Checking Last Sync Time\n")
 
 		conn := acc.TestAccProvider.Meta().(*conns.Client)
-		client := conn.PrismAPI.DomainManagerBackupsAPIInstance
+		client := conn.PrismAPI().DomainManagerBackupsAPIInstance
 
 		// Extract the output value for use in later steps
 		outputDomainManagerExtID, ok := s.RootModule().Outputs["domainManagerExtID"]
@@ -797,7 +797,7 @@ func createClusterLocationRestoreSource(restoreSourceExtID *string) resource.Tes
 	return func(s *terraform.State) error {
 		log.Printf("[DEBUG] Create Restore Source\n")
 		conn := acc.TestAccProvider2.Meta().(*conns.Client)
-		client := conn.PrismAPI.DomainManagerBackupsAPIInstance
+		client := conn.PrismAPI().DomainManagerBackupsAPIInstance
 
 		// Extract the output value for use in later steps
 		outputClusterExtID, ok := s.RootModule().Outputs["clusterExtID"]
@@ -847,7 +847,7 @@ func createObjectStoreLocationLocationRestoreSource(restoreSourceExtID *string)
 	return func(s *terraform.State) error {
 		log.Printf("[DEBUG] Create object store location restore source\n")
 		conn := acc.TestAccProvider2.Meta().(*conns.Client)
-		client := conn.PrismAPI.DomainManagerBackupsAPIInstance
+		client := conn.PrismAPI().DomainManagerBackupsAPIInstance
 
 		// Create Backup Target
 		body := management.RestoreSource{}
@@ -900,7 +900,7 @@ func createObjectStoreLocationLocationRestoreSource(restoreSourceExtID *string)
 func powerOffPC() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acc.TestAccProvider.Meta().(*conns.Client)
-		vmClient := conn.VmmAPI.VMAPIInstance
+		vmClient := conn.VmmAPI().VMAPIInstance
 
 		// Cluster filter
 		vmsResp, err := vmClient.ListVms(nil, nil, nil, nil, nil, nil)
@@ -99,7 +99,7 @@ func ResourceNutanixCategoriesV2() *schema.Resource {
 }
 
 func ResourceNutanixCategoriesV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).PrismAPI
+	conn := meta.(*conns.Client).PrismAPI()
 
 	input := &import1.Category{}
 
@@ -141,7 +141,7 @@ func ResourceNutanixCategoriesV2Create(ctx context.Context, d *schema.ResourceDa
 }
 
 func ResourceNutanixCategoriesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).PrismAPI
+	conn := meta.(*conns.Client).PrismAPI()
 
 	resp, err := conn.CategoriesAPIInstance.GetCategoryById(utils.StringPtr(d.Id()), nil)
 	if err != nil {
@@ -175,7 +175,7 @@ func ResourceNutanixCategoriesV2Read(ctx context.Context, d *schema.ResourceData
 }
 
 func ResourceNutanixCategoriesV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).PrismAPI
+	conn := meta.(*conns.Client).PrismAPI()
 	updatedInput := import1.Category{}
 	resp, err := conn.CategoriesAPIInstance.GetCategoryById(utils.StringPtr(d.Id()), nil)
 	if err != nil {
@@ -215,7 +215,7 @@ func ResourceNutanixCategoriesV2Update(ctx context.Context, d *schema.ResourceDa
 }
 
 func ResourceNutanixCategoriesV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).PrismAPI
+	conn := meta.(*conns.Client).PrismAPI()
 
 	resp, err := conn.CategoriesAPIInstance.DeleteCategoryById(utils.StringPtr(d.Id()))
 	if err != nil {
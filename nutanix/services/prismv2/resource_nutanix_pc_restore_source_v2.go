@@ -145,7 +145,7 @@ func ResourceNutanixRestoreSourceV2() *schema.Resource {
 
 func ResourceNutanixRestoreSourceV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Restore Source Create. ID: %s", d.Id())
-	conn := meta.(*conns.Client).PrismAPI
+	conn := meta.(*conns.Client).PrismAPI()
 
 	body := management.RestoreSource{}
 
@@ -209,7 +209,7 @@ func ResourceNutanixRestoreSourceV2Create(ctx context.Context, d *schema.Resourc
 }
 
 func ResourceNutanixRestoreSourceV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).PrismAPI
+	conn := meta.(*conns.Client).PrismAPI()
 
 	resp, err := conn.DomainManagerBackupsAPIInstance.GetRestoreSourceById(utils.StringPtr(d.Id()))
 
@@ -248,7 +248,7 @@ func ResourceNutanixRestoreSourceV2Update(ctx context.Context, d *schema.Resourc
 }
 
 func ResourceNutanixRestoreSourceV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).PrismAPI
+	conn := meta.(*conns.Client).PrismAPI()
 
 	readResp, err := conn.DomainManagerBackupsAPIInstance.GetRestoreSourceById(utils.StringPtr(d.Id()))
 	if err != nil {
@@ -127,7 +127,7 @@ func DatasourceNutanixKeyManagementServerV2() *schema.Resource {
 }
 
 func DatasourceNutanixKeyManagementServerV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).SecurityAPI
+	conn := meta.(*conns.Client).SecurityAPI()
 
 	extID := d.Get("ext_id").(string)
 
@@ -26,7 +26,7 @@ func DatasourceNutanixKeyManagementServersV2() *schema.Resource {
 }
 
 func DatasourceNutanixKeyManagementServersV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).SecurityAPI
+	conn := meta.(*conns.Client).SecurityAPI()
 
 	resp, err := conn.KeyManagementServersAPIInstance.ListKeyManagementServers()
 	if err != nil {
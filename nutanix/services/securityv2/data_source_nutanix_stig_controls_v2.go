@@ -107,7 +107,7 @@ func DatasourceNutanixStigsControlsV2() *schema.Resource {
 }
 
 func DatasourceNutanixStigsControlsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).SecurityAPI
+	conn := meta.(*conns.Client).SecurityAPI()
 
 	// initialize query params
 	var filter, orderBy, selectQ *string
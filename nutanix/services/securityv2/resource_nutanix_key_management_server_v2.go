@@ -10,7 +10,6 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	prismConfig "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
@@ -167,7 +166,7 @@ func ResourceNutanixKeyManagementServerV2() *schema.Resource {
 }
 
 func ResourceNutanixKeyManagementServerV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).SecurityAPI
+	conn := meta.(*conns.Client).SecurityAPI()
 
 	body := config.NewKeyManagementServer()
 
@@ -200,16 +199,9 @@ func ResourceNutanixKeyManagementServerV2Create(ctx context.Context, d *schema.R
 	taskUUID := TaskRef.ExtId
 
 	// calling group API to poll for completion of task
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the key management server to be created
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutCreate),
-	}
-
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
 		return diag.Errorf("error waiting for key management server (%s) to be created: %s", utils.StringValue(taskUUID), errWaitTask)
 	}
 
@@ -236,7 +228,7 @@ func ResourceNutanixKeyManagementServerV2Create(ctx context.Context, d *schema.R
 }
 
 func ResourceNutanixKeyManagementServerV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).SecurityAPI
+	conn := meta.(*conns.Client).SecurityAPI()
 
 	resp, err := conn.KeyManagementServersAPIInstance.GetKeyManagementServerById(utils.StringPtr(d.Id()))
 	if err != nil {
@@ -282,7 +274,7 @@ func ResourceNutanixKeyManagementServerV2Read(ctx context.Context, d *schema.Res
 }
 
 func ResourceNutanixKeyManagementServerV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).SecurityAPI
+	conn := meta.(*conns.Client).SecurityAPI()
 
 	resp, err := conn.KeyManagementServersAPIInstance.GetKeyManagementServerById(utils.StringPtr(d.Id()))
 	if err != nil {
@@ -383,16 +375,9 @@ func ResourceNutanixKeyManagementServerV2Update(ctx context.Context, d *schema.R
 	taskUUID := TaskRef.ExtId
 
 	// calling group API to poll for completion of task
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the key management server to be updated
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutUpdate),
-	}
-
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutUpdate)); errWaitTask != nil {
 		return diag.Errorf("error waiting for key management server (%s) to be updated: %s", utils.StringValue(taskUUID), errWaitTask)
 	}
 
@@ -409,7 +394,7 @@ func ResourceNutanixKeyManagementServerV2Update(ctx context.Context, d *schema.R
 }
 
 func ResourceNutanixKeyManagementServerV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).SecurityAPI
+	conn := meta.(*conns.Client).SecurityAPI()
 
 	resp, err := conn.KeyManagementServersAPIInstance.DeleteKeyManagementServerById(utils.StringPtr(d.Id()))
 	if err != nil {
@@ -423,16 +408,9 @@ func ResourceNutanixKeyManagementServerV2Delete(ctx context.Context, d *schema.R
 	taskUUID := TaskRef.ExtId
 
 	// calling group API to poll for completion of task
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the key management server to be deleted
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutDelete),
-	}
-
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutDelete)); errWaitTask != nil {
 		return diag.Errorf("error waiting for key management server (%s) to be deleted: %s", utils.StringValue(taskUUID), errWaitTask)
 	}
 
@@ -192,7 +192,7 @@ func DatsourceNutanixCalmApp() *schema.Resource {
 }
 
 func datsourceNutanixCalmAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).CalmAPI
+	conn := meta.(*conns.Client).CalmAPI()
 
 	appID := d.Get("app_uuid").(string)
 	resp, err := conn.Service.GetApp(ctx, appID)
@@ -114,7 +114,7 @@ func DatsourceNutanixCalmRuntimeEditables() *schema.Resource {
 }
 
 func datsourceNutanixCalmRuntimeEditablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).CalmAPI
+	conn := meta.(*conns.Client).CalmAPI()
 
 	var bpUUID string
 	// fetch bp_uuid from bp_name
@@ -67,7 +67,7 @@ func DataSourceNutanixSnapshotPolicy() *schema.Resource {
 }
 
 func dataSourceNutanixCalmSnapshotPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).CalmAPI
+	conn := meta.(*conns.Client).CalmAPI()
 	length := d.Get("length").(int)
 	offset := d.Get("offset").(int)
 
@@ -159,7 +159,7 @@ func DataSourceNutanixCalmSnapshots() *schema.Resource {
 }
 
 func dataSourceNutanixCalmSnapshotsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).CalmAPI
+	conn := meta.(*conns.Client).CalmAPI()
 
 	var appUUID string
 
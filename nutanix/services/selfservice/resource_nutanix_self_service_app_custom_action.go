@@ -45,7 +45,7 @@ func ResourceNutanixCalmAppCustomAction() *schema.Resource {
 }
 
 func ResourceNutanixCalmAppCustomActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).CalmAPI
+	conn := meta.(*conns.Client).CalmAPI()
 
 	var appUUID string
 
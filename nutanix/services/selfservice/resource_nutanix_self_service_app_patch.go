@@ -114,7 +114,7 @@ func ResourceNutanixCalmAppPatch() *schema.Resource {
 }
 
 func resourceNutanixCalmAppPatchCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).CalmAPI
+	conn := meta.(*conns.Client).CalmAPI()
 
 	appUUID := d.Get("app_uuid").(string)
 	patchName := d.Get("patch_name").(string)
@@ -308,7 +308,7 @@ func ResourceNutanixCalmAppProvision() *schema.Resource {
 }
 
 func resourceNutanixCalmAppProvisionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).CalmAPI
+	conn := meta.(*conns.Client).CalmAPI()
 
 	var bpUUID string
 	// fetch bp_uuid from bp_name
@@ -505,7 +505,7 @@ func resourceNutanixCalmAppProvisionCreate(ctx context.Context, d *schema.Resour
 }
 
 func resourceNutanixCalmAppProvisionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).CalmAPI
+	conn := meta.(*conns.Client).CalmAPI()
 
 	resp, err := conn.Service.GetApp(ctx, d.Id())
 	if err != nil {
@@ -561,7 +561,7 @@ func resourceNutanixCalmAppProvisionRead(ctx context.Context, d *schema.Resource
 }
 
 func resourceNutanixCalmAppProvisionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).CalmAPI
+	conn := meta.(*conns.Client).CalmAPI()
 
 	actionSpec := &selfservice.ActionSpec{}
 
@@ -609,7 +609,7 @@ func resourceNutanixCalmAppProvisionUpdate(ctx context.Context, d *schema.Resour
 }
 
 func resourceNutanixCalmAppProvisionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).CalmAPI
+	conn := meta.(*conns.Client).CalmAPI()
 
 	log.Printf("[Debug] Destroying the app with the ID %s", d.Id())
 
@@ -47,7 +47,7 @@ func ResourceNutanixCalmAppRecoveryPoint() *schema.Resource {
 }
 
 func resourceNutanixCalmAppRecoveryPointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).CalmAPI
+	conn := meta.(*conns.Client).CalmAPI()
 
 	var appUUID string
 
@@ -173,7 +173,7 @@ func resourceNutanixCalmAppRecoveryPointUpdate(ctx context.Context, d *schema.Re
 }
 
 func resourceNutanixCalmAppRecoveryPointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).CalmAPI
+	conn := meta.(*conns.Client).CalmAPI()
 
 	var appUUID string
 
@@ -49,7 +49,7 @@ func ResourceNutanixCalmAppRestore() *schema.Resource {
 }
 
 func resourceNutanixCalmAppRestoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).CalmAPI
+	conn := meta.(*conns.Client).CalmAPI()
 
 	var appUUID string
 
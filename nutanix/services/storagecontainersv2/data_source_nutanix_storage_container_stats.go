@@ -119,7 +119,7 @@ func SchemaForValueTimestamp() *schema.Schema {
 }
 
 func DatasourceNutanixStorageStatsInfoV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ClusterAPI
+	conn := meta.(*conns.Client).ClusterAPI()
 
 	extID := d.Get("ext_id")
 	startTime := d.Get("start_time")
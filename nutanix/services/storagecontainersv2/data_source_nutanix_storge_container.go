@@ -154,7 +154,7 @@ func DatasourceNutanixStorageContainerV2() *schema.Resource {
 }
 
 func DatasourceNutanixStorageContainerV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ClusterAPI
+	conn := meta.(*conns.Client).ClusterAPI()
 
 	extID := d.Get("ext_id")
 	resp, err := conn.StorageContainersAPI.GetStorageContainerById(utils.StringPtr(extID.(string)))
@@ -51,7 +51,7 @@ func DatasourceNutanixStorageContainersV2() *schema.Resource {
 }
 
 func DatasourceNutanixStorageContainersV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).ClusterAPI
+	conn := meta.(*conns.Client).ClusterAPI()
 
 	// initialize query params
 	var filter, orderBy, selectQ *string
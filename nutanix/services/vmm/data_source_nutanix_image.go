@@ -184,7 +184,7 @@ func DataSourceNutanixImage() *schema.Resource {
 
 func dataSourceNutanixImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	imageID, iok := d.GetOk("image_id")
 	imageName, nok := d.GetOk("image_name")
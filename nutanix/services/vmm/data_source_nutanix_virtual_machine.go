@@ -472,7 +472,7 @@ func DataSourceNutanixVirtualMachine() *schema.Resource {
 
 func dataSourceNutanixVirtualMachineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	vm, ok := d.GetOk("vm_id")
 
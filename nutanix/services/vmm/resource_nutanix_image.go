@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
 	v3 "github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v3/prism"
 	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
@@ -40,6 +41,7 @@ func ResourceNutanixImage() *schema.Resource {
 		ReadContext:   resourceNutanixImageRead,
 		UpdateContext: resourceNutanixImageUpdate,
 		DeleteContext: resourceNutanixImageDelete,
+		CustomizeDiff: common.DefaultCategoriesDiff("categories"),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -230,7 +232,7 @@ func ResourceNutanixImage() *schema.Resource {
 
 func resourceNutanixImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Creating Image: %s", d.Get("name").(string))
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	request := &v3.ImageIntentInput{}
 	spec := &v3.Image{}
@@ -350,7 +352,7 @@ func resourceNutanixImageRead(ctx context.Context, d *schema.ResourceData, meta
 	log.Printf("[DEBUG] Reading Image: %s", d.Get("name").(string))
 
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	uuid := d.Id()
 
@@ -453,7 +455,7 @@ func resourceNutanixImageRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceNutanixImageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	// get state
 	request := &v3.ImageIntentInput{}
@@ -563,7 +565,7 @@ func resourceNutanixImageUpdate(ctx context.Context, d *schema.ResourceData, met
 func resourceNutanixImageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Deleting Image: %s", d.Get("name").(string))
 
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	UUID := d.Id()
 
@@ -312,7 +312,7 @@ func testAccCheckNutanixImageDestroy(s *terraform.State) error {
 		}
 
 		for {
-			_, err := conn.API.V3.GetImage(rs.Primary.ID)
+			_, err := conn.API().V3.GetImage(rs.Primary.ID)
 			if err != nil {
 				if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
 					return nil
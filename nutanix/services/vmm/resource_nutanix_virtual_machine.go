@@ -12,11 +12,13 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spf13/cast"
 	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
 	v3 "github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v3/prism"
 	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
@@ -36,7 +38,10 @@ func ResourceNutanixVirtualMachine() *schema.Resource {
 		UpdateContext: resourceNutanixVirtualMachineUpdate,
 		DeleteContext: resourceNutanixVirtualMachineDelete,
 		Exists:        resourceNutanixVirtualMachineExists,
-		CustomizeDiff: resourceNutanixVirtualMachineDiff,
+		CustomizeDiff: customdiff.All(
+			resourceNutanixVirtualMachineDiff,
+			common.DefaultCategoriesDiff("categories"),
+		),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -638,7 +643,7 @@ func ResourceNutanixVirtualMachine() *schema.Resource {
 
 func resourceNutanixVirtualMachineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 	setVMTimeout(meta)
 	// Prepare request
 	request := &v3.VMIntentInput{}
@@ -735,7 +740,7 @@ func resourceNutanixVirtualMachineCreate(ctx context.Context, d *schema.Resource
 
 func resourceNutanixVirtualMachineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 	setVMTimeout(meta)
 
 	var err error
@@ -912,7 +917,7 @@ func resourceNutanixVirtualMachineRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceNutanixVirtualMachineUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 	setVMTimeout(meta)
 	hotPlugChange := true
 
@@ -1419,7 +1424,7 @@ func taskVMStateRefreshFunc(client *v3.Client, vmUUID string, powerState string)
 }
 
 func resourceNutanixVirtualMachineDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 	setVMTimeout(meta)
 	log.Printf("[DEBUG] Deleting Virtual Machine: %s, %s", d.Get("name").(string), d.Id())
 	resp, err := conn.V3.DeleteVM(d.Id())
@@ -1450,7 +1455,7 @@ func resourceNutanixVirtualMachineDelete(ctx context.Context, d *schema.Resource
 }
 
 func resourceNutanixVirtualMachineExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*conns.Client).API
+	conn := meta.(*conns.Client).API()
 
 	_, err := conn.V3.GetVM(d.Id())
 	if err != nil {
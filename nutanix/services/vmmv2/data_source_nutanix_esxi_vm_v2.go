@@ -0,0 +1,634 @@
+package vmmv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	esxiConfig "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/vmm/v4/esxi/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// DatasourceNutanixEsxiVMV2 reads a VM of an ESXi cluster
+func DatasourceNutanixEsxiVMV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DatasourceNutanixEsxiVMV2Read,
+		Schema: map[string]*schema.Schema{
+			"ext_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"links": schemaForLinks(),
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"guest_os_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"num_cpus": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"num_cores_per_socket": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"memory_size_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"virtual_hardware_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"power_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster":    schemaForEsxiExtIDReference(),
+			"host":       schemaForEsxiExtIDReference(),
+			"categories": schemaForEsxiExtIDReference(),
+			"ownership_info": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"owner": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ext_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"entity_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"nutanix_guest_tools": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: schemaForEsxiGuestTools(),
+				},
+			},
+			"disks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ext_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"links":        schemaForLinks(),
+						"disk_address": schemaForEsxiDeviceAddress(),
+						"backing_info": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"disk_size_bytes": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"storage_container": schemaForEsxiExtIDReference(),
+									"storage_config": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"is_flash_mode_enabled": {
+													Type:     schema.TypeBool,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"cd_roms": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ext_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"links":        schemaForLinks(),
+						"disk_address": schemaForEsxiDeviceAddress(),
+						"backing_info": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"disk_ext_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"storage_container": schemaForEsxiExtIDReference(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"nics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ext_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"links": schemaForLinks(),
+						"backing_info": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"adapter_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"mac_address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"is_connected": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"network_info": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"port_group_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"ipv4_addresses": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"value": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"prefix_length": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"ipv6_addresses": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DatasourceNutanixEsxiVMV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VmmAPI()
+
+	extID := d.Get("ext_id").(string)
+	resp, err := conn.EsxiVMAPIInstance.GetVmById(utils.StringPtr(extID))
+	if err != nil {
+		return diag.Errorf("error while fetching esxi vm : %v", err)
+	}
+	getResp := resp.Data.GetValue().(esxiConfig.Vm)
+
+	for key, value := range flattenEsxiVM(getResp) {
+		if key == "ext_id" {
+			continue
+		}
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(utils.StringValue(getResp.ExtId))
+	return nil
+}
+
+func schemaForEsxiExtIDReference() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ext_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func schemaForEsxiDeviceAddress() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"bus_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"index": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// schemaForEsxiGuestTools returns the computed attributes of Nutanix Guest Tools on an ESXi VM
+func schemaForEsxiGuestTools() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"available_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"guest_os_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"is_installed": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"is_enabled": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"is_iso_inserted": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"is_reachable": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"is_vss_snapshot_capable": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"is_vm_mobility_drivers_installed": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"capabilities": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"guest_info": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"dns_name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"guest_os_build_number": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"guest_os_full_name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"installed_virt_io_version": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"is_vm_mobility_drivers_installed": {
+						Type:     schema.TypeBool,
+						Computed: true,
+					},
+					"last_boot_up_time": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func flattenEsxiVM(vm esxiConfig.Vm) map[string]interface{} {
+	esxiVM := make(map[string]interface{})
+
+	if vm.ExtId != nil {
+		esxiVM["ext_id"] = vm.ExtId
+	}
+	if vm.TenantId != nil {
+		esxiVM["tenant_id"] = vm.TenantId
+	}
+	if vm.Links != nil {
+		esxiVM["links"] = flattenAPILink(vm.Links)
+	}
+	if vm.Name != nil {
+		esxiVM["name"] = vm.Name
+	}
+	if vm.Description != nil {
+		esxiVM["description"] = vm.Description
+	}
+	if vm.GuestOsName != nil {
+		esxiVM["guest_os_name"] = vm.GuestOsName
+	}
+	if vm.NumCpus != nil {
+		esxiVM["num_cpus"] = vm.NumCpus
+	}
+	if vm.NumCoresPerSocket != nil {
+		esxiVM["num_cores_per_socket"] = vm.NumCoresPerSocket
+	}
+	if vm.MemorySizeBytes != nil {
+		esxiVM["memory_size_bytes"] = vm.MemorySizeBytes
+	}
+	if vm.VirtualHardwareVersion != nil {
+		esxiVM["virtual_hardware_version"] = vm.VirtualHardwareVersion
+	}
+	if vm.PowerState != nil {
+		esxiVM["power_state"] = vm.PowerState.GetName()
+	}
+	if vm.Cluster != nil {
+		esxiVM["cluster"] = flattenEsxiExtIDReference(vm.Cluster.ExtId)
+	}
+	if vm.Host != nil {
+		esxiVM["host"] = flattenEsxiExtIDReference(vm.Host.ExtId)
+	}
+	if vm.Categories != nil {
+		categories := make([]interface{}, 0, len(vm.Categories))
+		for _, category := range vm.Categories {
+			categories = append(categories, map[string]interface{}{"ext_id": utils.StringValue(category.ExtId)})
+		}
+		esxiVM["categories"] = categories
+	}
+	if vm.OwnershipInfo != nil && vm.OwnershipInfo.Owner != nil {
+		owner := map[string]interface{}{"ext_id": utils.StringValue(vm.OwnershipInfo.Owner.ExtId)}
+		if vm.OwnershipInfo.Owner.EntityType != nil {
+			owner["entity_type"] = vm.OwnershipInfo.Owner.EntityType.GetName()
+		}
+		esxiVM["ownership_info"] = []interface{}{map[string]interface{}{"owner": []interface{}{owner}}}
+	}
+	if vm.NutanixGuestTools != nil {
+		esxiVM["nutanix_guest_tools"] = []interface{}{flattenEsxiGuestTools(vm.NutanixGuestTools)}
+	}
+	if vm.Disks != nil {
+		esxiVM["disks"] = flattenEsxiDisks(vm.Disks)
+	}
+	if vm.CdRoms != nil {
+		esxiVM["cd_roms"] = flattenEsxiCdRoms(vm.CdRoms)
+	}
+	if vm.Nics != nil {
+		esxiVM["nics"] = flattenEsxiNics(vm.Nics)
+	}
+	return esxiVM
+}
+
+func flattenEsxiExtIDReference(extID *string) []interface{} {
+	return []interface{}{map[string]interface{}{"ext_id": utils.StringValue(extID)}}
+}
+
+func flattenEsxiGuestTools(ngt *esxiConfig.NutanixGuestTools) map[string]interface{} {
+	guestTools := make(map[string]interface{})
+
+	if ngt.Version != nil {
+		guestTools["version"] = ngt.Version
+	}
+	if ngt.AvailableVersion != nil {
+		guestTools["available_version"] = ngt.AvailableVersion
+	}
+	if ngt.GuestOsVersion != nil {
+		guestTools["guest_os_version"] = ngt.GuestOsVersion
+	}
+	if ngt.IsInstalled != nil {
+		guestTools["is_installed"] = ngt.IsInstalled
+	}
+	if ngt.IsEnabled != nil {
+		guestTools["is_enabled"] = ngt.IsEnabled
+	}
+	if ngt.IsIsoInserted != nil {
+		guestTools["is_iso_inserted"] = ngt.IsIsoInserted
+	}
+	if ngt.IsReachable != nil {
+		guestTools["is_reachable"] = ngt.IsReachable
+	}
+	if ngt.IsVssSnapshotCapable != nil {
+		guestTools["is_vss_snapshot_capable"] = ngt.IsVssSnapshotCapable
+	}
+	if ngt.IsVmMobilityDriversInstalled != nil {
+		guestTools["is_vm_mobility_drivers_installed"] = ngt.IsVmMobilityDriversInstalled
+	}
+	guestTools["capabilities"] = flattenEsxiGuestToolsCapabilities(ngt.Capabilities)
+	if info := ngt.GuestInfo; info != nil {
+		guestInfo := make(map[string]interface{})
+		if info.DnsName != nil {
+			guestInfo["dns_name"] = utils.StringValue(info.DnsName.Value)
+		}
+		if info.GuestOsBuildNumber != nil {
+			guestInfo["guest_os_build_number"] = info.GuestOsBuildNumber
+		}
+		if info.GuestOsFullName != nil {
+			guestInfo["guest_os_full_name"] = info.GuestOsFullName
+		}
+		if info.InstalledVirtIoVersion != nil {
+			guestInfo["installed_virt_io_version"] = info.InstalledVirtIoVersion
+		}
+		if info.IsVmMobilityDriversInstalled != nil {
+			guestInfo["is_vm_mobility_drivers_installed"] = info.IsVmMobilityDriversInstalled
+		}
+		if info.LastBootUpTime != nil {
+			guestInfo["last_boot_up_time"] = info.LastBootUpTime.String()
+		}
+		guestTools["guest_info"] = []interface{}{guestInfo}
+	}
+	return guestTools
+}
+
+func flattenEsxiGuestToolsCapabilities(capabilities []esxiConfig.NutanixGuestToolsCapability) []interface{} {
+	caps := make([]interface{}, len(capabilities))
+	for i, capability := range capabilities {
+		caps[i] = capability.GetName()
+	}
+	return caps
+}
+
+func flattenEsxiDisks(disks []esxiConfig.Disk) []interface{} {
+	diskList := make([]interface{}, len(disks))
+	for k, v := range disks {
+		disk := make(map[string]interface{})
+
+		if v.ExtId != nil {
+			disk["ext_id"] = v.ExtId
+		}
+		if v.TenantId != nil {
+			disk["tenant_id"] = v.TenantId
+		}
+		if v.Links != nil {
+			disk["links"] = flattenAPILink(v.Links)
+		}
+		if v.DiskAddress != nil {
+			address := map[string]interface{}{"index": utils.IntValue(v.DiskAddress.Index)}
+			if v.DiskAddress.BusType != nil {
+				address["bus_type"] = v.DiskAddress.BusType.GetName()
+			}
+			disk["disk_address"] = []interface{}{address}
+		}
+		if v.BackingInfo != nil {
+			backingInfo := make(map[string]interface{})
+			if v.BackingInfo.DiskSizeBytes != nil {
+				backingInfo["disk_size_bytes"] = v.BackingInfo.DiskSizeBytes
+			}
+			if v.BackingInfo.StorageContainer != nil {
+				backingInfo["storage_container"] = flattenEsxiExtIDReference(v.BackingInfo.StorageContainer.ExtId)
+			}
+			if v.BackingInfo.StorageConfig != nil {
+				backingInfo["storage_config"] = []interface{}{map[string]interface{}{
+					"is_flash_mode_enabled": utils.BoolValue(v.BackingInfo.StorageConfig.IsFlashModeEnabled),
+				}}
+			}
+			disk["backing_info"] = []interface{}{backingInfo}
+		}
+		diskList[k] = disk
+	}
+	return diskList
+}
+
+func flattenEsxiCdRoms(cdRoms []esxiConfig.CdRom) []interface{} {
+	cdRomList := make([]interface{}, len(cdRoms))
+	for k, v := range cdRoms {
+		cdRom := make(map[string]interface{})
+
+		if v.ExtId != nil {
+			cdRom["ext_id"] = v.ExtId
+		}
+		if v.TenantId != nil {
+			cdRom["tenant_id"] = v.TenantId
+		}
+		if v.Links != nil {
+			cdRom["links"] = flattenAPILink(v.Links)
+		}
+		if v.DiskAddress != nil {
+			address := map[string]interface{}{"index": utils.IntValue(v.DiskAddress.Index)}
+			if v.DiskAddress.BusType != nil {
+				address["bus_type"] = v.DiskAddress.BusType.GetName()
+			}
+			cdRom["disk_address"] = []interface{}{address}
+		}
+		if v.BackingInfo != nil {
+			backingInfo := make(map[string]interface{})
+			if v.BackingInfo.DiskExtId != nil {
+				backingInfo["disk_ext_id"] = v.BackingInfo.DiskExtId
+			}
+			if v.BackingInfo.StorageContainer != nil {
+				backingInfo["storage_container"] = flattenEsxiExtIDReference(v.BackingInfo.StorageContainer.ExtId)
+			}
+			cdRom["backing_info"] = []interface{}{backingInfo}
+		}
+		cdRomList[k] = cdRom
+	}
+	return cdRomList
+}
+
+func flattenEsxiNics(nics []esxiConfig.Nic) []interface{} {
+	nicList := make([]interface{}, len(nics))
+	for k, v := range nics {
+		nic := make(map[string]interface{})
+
+		if v.ExtId != nil {
+			nic["ext_id"] = v.ExtId
+		}
+		if v.TenantId != nil {
+			nic["tenant_id"] = v.TenantId
+		}
+		if v.Links != nil {
+			nic["links"] = flattenAPILink(v.Links)
+		}
+		if v.BackingInfo != nil {
+			backingInfo := make(map[string]interface{})
+			if v.BackingInfo.AdapterType != nil {
+				backingInfo["adapter_type"] = v.BackingInfo.AdapterType.GetName()
+			}
+			if v.BackingInfo.MacAddress != nil {
+				backingInfo["mac_address"] = v.BackingInfo.MacAddress
+			}
+			if v.BackingInfo.IsConnected != nil {
+				backingInfo["is_connected"] = v.BackingInfo.IsConnected
+			}
+			nic["backing_info"] = []interface{}{backingInfo}
+		}
+		if v.NetworkInfo != nil {
+			networkInfo := make(map[string]interface{})
+			if v.NetworkInfo.PortGroupInfo != nil {
+				networkInfo["port_group_name"] = utils.StringValue(v.NetworkInfo.PortGroupInfo.Name)
+			}
+			if v.NetworkInfo.IpAddressInfo != nil {
+				networkInfo["ipv4_addresses"] = flattenIPv4AddressList(v.NetworkInfo.IpAddressInfo.Ipv4Addresses)
+				networkInfo["ipv6_addresses"] = v.NetworkInfo.IpAddressInfo.Ipv6Addresses
+			}
+			nic["network_info"] = []interface{}{networkInfo}
+		}
+		nicList[k] = nic
+	}
+	return nicList
+}
@@ -0,0 +1,99 @@
+package vmmv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+)
+
+const (
+	datasourceNameEsxiVMs        = "data.nutanix_esxi_vms_v2.test"
+	datasourceNameEsxiVM         = "data.nutanix_esxi_vm_v2.test"
+	resourceNameEsxiVMCategories = "nutanix_esxi_vm_categories_v2.test"
+)
+
+func TestAccV2NutanixEsxiVMDatasource_ListAndGet(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testEsxiVMDatasourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceNameEsxiVMs, "vms.#"),
+					resource.TestCheckResourceAttrPair(datasourceNameEsxiVM, "name", datasourceNameEsxiVMs, "vms.0.name"),
+					resource.TestCheckResourceAttrPair(datasourceNameEsxiVM, "power_state", datasourceNameEsxiVMs, "vms.0.power_state"),
+					resource.TestCheckResourceAttrSet(datasourceNameEsxiVM, "cluster.0.ext_id"),
+					resource.TestCheckResourceAttrSet(datasourceNameEsxiVM, "num_cpus"),
+					resource.TestCheckResourceAttrSet(datasourceNameEsxiVM, "memory_size_bytes"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixEsxiVMCategoriesResource_Basic(t *testing.T) {
+	r := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testEsxiVMCategoriesConfig(r, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameEsxiVMCategories, "categories.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceNameEsxiVMCategories, "categories.0.ext_id",
+						"nutanix_category_v2.test.0", "id"),
+				),
+			},
+			// associate a second category
+			{
+				Config: testEsxiVMCategoriesConfig(r, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameEsxiVMCategories, "categories.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceNameEsxiVMCategories, "categories.1.ext_id",
+						"nutanix_category_v2.test.1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testEsxiVMDatasourceConfig() string {
+	return `
+		data "nutanix_esxi_vms_v2" "test" {
+		  limit = 5
+		}
+
+		data "nutanix_esxi_vm_v2" "test" {
+		  ext_id = data.nutanix_esxi_vms_v2.test.vms.0.ext_id
+		}
+`
+}
+
+func testEsxiVMCategoriesConfig(r, count int) string {
+	return fmt.Sprintf(`
+		data "nutanix_esxi_vms_v2" "test" {
+		  limit = 1
+		}
+
+		resource "nutanix_category_v2" "test" {
+		  count = 2
+		  key   = "tf-esxi-vm-category-%[1]d"
+		  value = "value-${count.index}"
+		}
+
+		resource "nutanix_esxi_vm_categories_v2" "test" {
+		  ext_id = data.nutanix_esxi_vms_v2.test.vms.0.ext_id
+		  dynamic "categories" {
+			for_each = slice(nutanix_category_v2.test, 0, %[2]d)
+			content {
+			  ext_id = categories.value.id
+			}
+		  }
+		}
+`, r, count)
+}
@@ -0,0 +1,101 @@
+package vmmv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	esxiConfig "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/vmm/v4/esxi/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// DatasourceNutanixEsxiVMsV2 lists the VMs of the ESXi clusters
+func DatasourceNutanixEsxiVMsV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DatasourceNutanixEsxiVMsV2Read,
+		Schema: map[string]*schema.Schema{
+			"page": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"limit": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"order_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"select": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"vms": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     DatasourceNutanixEsxiVMV2(),
+			},
+		},
+	}
+}
+
+func DatasourceNutanixEsxiVMsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VmmAPI()
+
+	// initialize query params
+	var filter, orderBy, selects *string
+	var page, limit *int
+
+	if pagef, ok := d.GetOk("page"); ok {
+		page = utils.IntPtr(pagef.(int))
+	}
+	if limitf, ok := d.GetOk("limit"); ok {
+		limit = utils.IntPtr(limitf.(int))
+	}
+	if filterf, ok := d.GetOk("filter"); ok {
+		filter = utils.StringPtr(filterf.(string))
+	}
+	if order, ok := d.GetOk("order_by"); ok {
+		orderBy = utils.StringPtr(order.(string))
+	}
+	if selectf, ok := d.GetOk("select"); ok {
+		selects = utils.StringPtr(selectf.(string))
+	}
+
+	resp, err := conn.EsxiVMAPIInstance.ListVms(page, limit, filter, orderBy, selects)
+	if err != nil {
+		return diag.Errorf("error while fetching esxi vms : %v", err)
+	}
+
+	if resp.Data == nil {
+		if err := d.Set("vms", make([]interface{}, 0)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(utils.GenUUID())
+
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "🫙 No data found.",
+			Detail:   "The API returned an empty list of esxi virtual machines.",
+		}}
+	}
+
+	getResp := resp.Data.GetValue().([]esxiConfig.Vm)
+
+	vms := make([]interface{}, len(getResp))
+	for k, v := range getResp {
+		vms[k] = flattenEsxiVM(v)
+	}
+	if err := d.Set("vms", vms); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.GenUUID())
+	return nil
+}
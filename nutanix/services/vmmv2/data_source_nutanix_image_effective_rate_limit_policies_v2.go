@@ -0,0 +1,143 @@
+package vmmv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	import7 "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/vmm/v4/images/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// DatasourceNutanixImageEffectiveRateLimitPoliciesV2 lists the rate limit policy in effect on each cluster
+func DatasourceNutanixImageEffectiveRateLimitPoliciesV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DatasourceNutanixImageEffectiveRateLimitPoliciesV2Read,
+		Schema: map[string]*schema.Schema{
+			"page": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"limit": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"order_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"select": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"effective_rate_limit_policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ext_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"links": schemaForLinks(),
+						"cluster_ext_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rate_limit_ext_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DatasourceNutanixImageEffectiveRateLimitPoliciesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VmmAPI()
+
+	// initialize query params
+	var filter, orderBy, selects *string
+	var page, limit *int
+
+	if pagef, ok := d.GetOk("page"); ok {
+		page = utils.IntPtr(pagef.(int))
+	}
+	if limitf, ok := d.GetOk("limit"); ok {
+		limit = utils.IntPtr(limitf.(int))
+	}
+	if filterf, ok := d.GetOk("filter"); ok {
+		filter = utils.StringPtr(filterf.(string))
+	}
+	if order, ok := d.GetOk("order_by"); ok {
+		orderBy = utils.StringPtr(order.(string))
+	}
+	if selectf, ok := d.GetOk("select"); ok {
+		selects = utils.StringPtr(selectf.(string))
+	}
+
+	resp, err := conn.ImagesRateLimitAPIInstance.ListEffectiveRateLimitPolicies(page, limit, filter, orderBy, selects)
+	if err != nil {
+		return diag.Errorf("error while fetching effective image rate limit policies : %v", err)
+	}
+
+	if resp.Data == nil {
+		if err := d.Set("effective_rate_limit_policies", make([]interface{}, 0)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(utils.GenUUID())
+
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "🫙 No data found.",
+			Detail:   "The API returned an empty list of effective rate limit policies.",
+		}}
+	}
+
+	policies := resp.Data.GetValue().([]import7.EffectiveRateLimitPolicy)
+
+	if err := d.Set("effective_rate_limit_policies", flattenEffectiveRateLimitPolicies(policies)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.UniqueId())
+	return nil
+}
+
+func flattenEffectiveRateLimitPolicies(pr []import7.EffectiveRateLimitPolicy) []interface{} {
+	policies := make([]interface{}, len(pr))
+	for k, v := range pr {
+		policy := make(map[string]interface{})
+
+		if v.ExtId != nil {
+			policy["ext_id"] = v.ExtId
+		}
+		if v.TenantId != nil {
+			policy["tenant_id"] = v.TenantId
+		}
+		if v.Links != nil {
+			policy["links"] = flattenAPILink(v.Links)
+		}
+		if v.ClusterExtId != nil {
+			policy["cluster_ext_id"] = v.ClusterExtId
+		}
+		if v.RateLimitExtId != nil {
+			policy["rate_limit_ext_id"] = v.RateLimitExtId
+		}
+		policies[k] = policy
+	}
+	return policies
+}
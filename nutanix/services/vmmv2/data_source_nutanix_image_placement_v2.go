@@ -89,7 +89,7 @@ func DatasourceNutanixImagePlacementV4() *schema.Resource {
 }
 
 func DatasourceNutanixImagePlacementV4Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	extID := d.Get("ext_id")
 
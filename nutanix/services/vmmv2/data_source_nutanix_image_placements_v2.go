@@ -118,7 +118,7 @@ func DatasourceNutanixImagePlacementsV4() *schema.Resource {
 }
 
 func DatasourceNutanixImagePlacementsV4Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	// initialize query params
 	var filter, orderBy, selects *string
@@ -0,0 +1,115 @@
+package vmmv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	import7 "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/vmm/v4/images/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// DatasourceNutanixImageRateLimitPolicyV2 fetches an image rate limit policy by its external identifier
+func DatasourceNutanixImageRateLimitPolicyV2() *schema.Resource {
+	policySchema := schemaForRateLimitPolicy()
+	policySchema["ext_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	return &schema.Resource{
+		ReadContext: DatasourceNutanixImageRateLimitPolicyV2Read,
+		Schema:      policySchema,
+	}
+}
+
+func DatasourceNutanixImageRateLimitPolicyV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VmmAPI()
+
+	extID := d.Get("ext_id")
+
+	resp, err := conn.ImagesRateLimitAPIInstance.GetRateLimitPolicyById(utils.StringPtr(extID.(string)))
+	if err != nil {
+		return diag.Errorf("error while fetching image rate limit policy : %v", err)
+	}
+
+	getResp := resp.Data.GetValue().(import7.RateLimitPolicy)
+
+	for key, value := range flattenRateLimitPolicy(getResp) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(utils.StringValue(getResp.ExtId))
+	return nil
+}
+
+// schemaForRateLimitPolicy returns the computed attributes of an image rate limit policy
+func schemaForRateLimitPolicy() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"ext_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"tenant_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"links": schemaForLinks(),
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"rate_limit_kbps": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"cluster_entity_filter": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"category_ext_ids": {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+		"matching_cluster_ext_ids": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"create_time": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"last_update_time": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"owner_ext_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"owner_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
@@ -182,7 +182,7 @@ func DatasourceNutanixImageV4() *schema.Resource {
 }
 
 func DatasourceNutanixImageV4Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	extID := d.Get("ext_id")
 
@@ -211,7 +211,7 @@ func DatasourceNutanixImagesV4() *schema.Resource {
 }
 
 func DatasourceNutanixImagesV4Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	// initialize query params
 	var filter, orderBy, selects *string
@@ -69,7 +69,7 @@ func DatasourceNutanixNGTConfigurationV4() *schema.Resource {
 }
 
 func DatasourceNutanixNGTConfigurationV4Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	extID := d.Get("ext_id")
 	resp, err := conn.VMAPIInstance.GetGuestToolsById(utils.StringPtr(extID.(string)))
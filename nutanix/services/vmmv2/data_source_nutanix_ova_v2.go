@@ -344,7 +344,7 @@ func DatasourceNutanixOvaV2() *schema.Resource {
 
 // datasourceNutanixOvaV2Read implements the ReadContext function for the OVA data source.
 func datasourceNutanixOvaV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	extID := d.Get("ext_id").(string)
 	resp, err := conn.OvasAPIInstance.GetOvaById(utils.StringPtr(extID))
@@ -46,7 +46,7 @@ func DatasourceNutanixOvasV2() *schema.Resource {
 }
 
 func datasourceNutanixOvasV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	// initialize query params
 	var filter, orderBy, selects *string
@@ -118,7 +118,7 @@ func DatasourceNutanixTemplateV2() *schema.Resource {
 }
 
 func DatasourceNutanixTemplateV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	extID := d.Get("ext_id")
 
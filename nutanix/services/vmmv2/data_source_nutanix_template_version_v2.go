@@ -0,0 +1,76 @@
+package vmmv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	vmmContent "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/vmm/v4/content"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func DatasourceNutanixTemplateVersionV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DatasourceNutanixTemplateVersionV2Read,
+		Schema: map[string]*schema.Schema{
+			"template_ext_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ext_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"links": schemaForLinks(),
+			"version_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vm_spec": schemaForTemplateVMSpec(),
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     schemaForTemplateUser(),
+			},
+			"is_active_version": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_gc_override_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func DatasourceNutanixTemplateVersionV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VmmAPI()
+
+	extID := d.Get("ext_id").(string)
+	resp, err := conn.TemplatesAPIInstance.GetTemplateVersionById(utils.StringPtr(d.Get("template_ext_id").(string)), utils.StringPtr(extID))
+	if err != nil {
+		return diag.Errorf("error while fetching template version : %v", err)
+	}
+	getResp := resp.Data.GetValue().(vmmContent.TemplateVersionSpec)
+
+	if diags := setTemplateVersion(d, &getResp); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(extID)
+	return nil
+}
@@ -45,7 +45,7 @@ func DatasourceNutanixTemplatesV2() *schema.Resource {
 }
 
 func DatasourceNutanixTemplatesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	// initialize query params
 	var filter, orderBy, selectQ *string
@@ -1150,7 +1150,7 @@ func DatasourceNutanixVirtualMachineV4() *schema.Resource {
 }
 
 func DatasourceNutanixVirtualMachineV4Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 	extID := d.Get("ext_id")
 
 	resp, err := conn.VMAPIInstance.GetVmById(utils.StringPtr(extID.(string)))
@@ -45,7 +45,7 @@ func DatasourceNutanixVirtualMachinesV4() *schema.Resource {
 }
 
 func DatasourceNutanixVirtualMachinesV4Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	// initialize query params
 	var filter, orderBy, selects *string
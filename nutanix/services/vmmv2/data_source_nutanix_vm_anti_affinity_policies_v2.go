@@ -41,7 +41,7 @@ func DatasourceNutanixVMAntiAffinityPoliciesV2() *schema.Resource {
 }
 
 func DatasourceNutanixVMAntiAffinityPoliciesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	var filter, orderBy *string
 	var page, limit *int
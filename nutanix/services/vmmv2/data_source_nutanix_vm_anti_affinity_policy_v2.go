@@ -72,7 +72,7 @@ func DatasourceNutanixVMAntiAffinityPolicyV2() *schema.Resource {
 }
 
 func DatasourceNutanixVMAntiAffinityPolicyV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	extID := d.Get("ext_id")
 
@@ -41,7 +41,7 @@ func DatasourceNutanixVMHostAffinityPoliciesV2() *schema.Resource {
 }
 
 func DatasourceNutanixVMHostAffinityPoliciesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	var filter, orderBy *string
 	var page, limit *int
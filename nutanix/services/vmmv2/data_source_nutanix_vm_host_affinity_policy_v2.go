@@ -83,7 +83,7 @@ func DatasourceNutanixVMHostAffinityPolicyV2() *schema.Resource {
 }
 
 func DatasourceNutanixVMHostAffinityPolicyV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	extID := d.Get("ext_id")
 
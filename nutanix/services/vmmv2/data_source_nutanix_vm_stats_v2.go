@@ -0,0 +1,312 @@
+package vmmv2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	vmmStats "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/common/v1/stats"
+	"github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/vmm/v4/ahv/stats"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// vmStatsMetrics are the metrics of a VM stats data point, by attribute name. The attribute name is
+// the snake case of the API attribute name.
+var vmStatsMetrics = []struct {
+	name  string
+	value func(t *stats.VmStatsTuple) *int64
+}{
+	{"check_score", func(t *stats.VmStatsTuple) *int64 { return t.CheckScore }},
+	{"controller_avg_io_latency_micros", func(t *stats.VmStatsTuple) *int64 { return t.ControllerAvgIoLatencyMicros }},
+	{"controller_avg_read_io_latency_micros", func(t *stats.VmStatsTuple) *int64 { return t.ControllerAvgReadIoLatencyMicros }},
+	{"controller_avg_read_io_size_kb", func(t *stats.VmStatsTuple) *int64 { return t.ControllerAvgReadIoSizeKb }},
+	{"controller_avg_write_io_latency_micros", func(t *stats.VmStatsTuple) *int64 { return t.ControllerAvgWriteIoLatencyMicros }},
+	{"controller_avg_write_io_size_kb", func(t *stats.VmStatsTuple) *int64 { return t.ControllerAvgWriteIoSizeKb }},
+	{"controller_io_bandwidth_kbps", func(t *stats.VmStatsTuple) *int64 { return t.ControllerIoBandwidthKbps }},
+	{"controller_num_io", func(t *stats.VmStatsTuple) *int64 { return t.ControllerNumIo }},
+	{"controller_num_iops", func(t *stats.VmStatsTuple) *int64 { return t.ControllerNumIops }},
+	{"controller_num_random_io", func(t *stats.VmStatsTuple) *int64 { return t.ControllerNumRandomIo }},
+	{"controller_num_read_io", func(t *stats.VmStatsTuple) *int64 { return t.ControllerNumReadIo }},
+	{"controller_num_read_iops", func(t *stats.VmStatsTuple) *int64 { return t.ControllerNumReadIops }},
+	{"controller_num_seq_io", func(t *stats.VmStatsTuple) *int64 { return t.ControllerNumSeqIo }},
+	{"controller_num_write_io", func(t *stats.VmStatsTuple) *int64 { return t.ControllerNumWriteIo }},
+	{"controller_num_write_iops", func(t *stats.VmStatsTuple) *int64 { return t.ControllerNumWriteIops }},
+	{"controller_oplog_drain_dest_hdd_bytes", func(t *stats.VmStatsTuple) *int64 { return t.ControllerOplogDrainDestHddBytes }},
+	{"controller_oplog_drain_dest_ssd_bytes", func(t *stats.VmStatsTuple) *int64 { return t.ControllerOplogDrainDestSsdBytes }},
+	{"controller_random_io_ppm", func(t *stats.VmStatsTuple) *int64 { return t.ControllerRandomIoPpm }},
+	{"controller_read_io_bandwidth_kbps", func(t *stats.VmStatsTuple) *int64 { return t.ControllerReadIoBandwidthKbps }},
+	{"controller_read_io_ppm", func(t *stats.VmStatsTuple) *int64 { return t.ControllerReadIoPpm }},
+	{"controller_read_source_estore_hdd_local_bytes", func(t *stats.VmStatsTuple) *int64 { return t.ControllerReadSourceEstoreHddLocalBytes }},
+	{"controller_read_source_estore_hdd_remote_bytes", func(t *stats.VmStatsTuple) *int64 { return t.ControllerReadSourceEstoreHddRemoteBytes }},
+	{"controller_read_source_estore_ssd_local_bytes", func(t *stats.VmStatsTuple) *int64 { return t.ControllerReadSourceEstoreSsdLocalBytes }},
+	{"controller_read_source_estore_ssd_remote_bytes", func(t *stats.VmStatsTuple) *int64 { return t.ControllerReadSourceEstoreSsdRemoteBytes }},
+	{"controller_read_source_oplog_bytes", func(t *stats.VmStatsTuple) *int64 { return t.ControllerReadSourceOplogBytes }},
+	{"controller_seq_io_ppm", func(t *stats.VmStatsTuple) *int64 { return t.ControllerSeqIoPpm }},
+	{"controller_shared_usage_bytes", func(t *stats.VmStatsTuple) *int64 { return t.ControllerSharedUsageBytes }},
+	{"controller_snapshot_usage_bytes", func(t *stats.VmStatsTuple) *int64 { return t.ControllerSnapshotUsageBytes }},
+	{"controller_storage_tier_ssd_usage_bytes", func(t *stats.VmStatsTuple) *int64 { return t.ControllerStorageTierSsdUsageBytes }},
+	{"controller_timespan_micros", func(t *stats.VmStatsTuple) *int64 { return t.ControllerTimespanMicros }},
+	{"controller_total_io_size_kb", func(t *stats.VmStatsTuple) *int64 { return t.ControllerTotalIoSizeKb }},
+	{"controller_total_io_time_micros", func(t *stats.VmStatsTuple) *int64 { return t.ControllerTotalIoTimeMicros }},
+	{"controller_total_read_io_size_kb", func(t *stats.VmStatsTuple) *int64 { return t.ControllerTotalReadIoSizeKb }},
+	{"controller_total_read_io_time_micros", func(t *stats.VmStatsTuple) *int64 { return t.ControllerTotalReadIoTimeMicros }},
+	{"controller_total_transformed_usage_bytes", func(t *stats.VmStatsTuple) *int64 { return t.ControllerTotalTransformedUsageBytes }},
+	{"controller_user_bytes", func(t *stats.VmStatsTuple) *int64 { return t.ControllerUserBytes }},
+	{"controller_write_dest_estore_hdd_bytes", func(t *stats.VmStatsTuple) *int64 { return t.ControllerWriteDestEstoreHddBytes }},
+	{"controller_write_dest_estore_ssd_bytes", func(t *stats.VmStatsTuple) *int64 { return t.ControllerWriteDestEstoreSsdBytes }},
+	{"controller_write_io_bandwidth_kbps", func(t *stats.VmStatsTuple) *int64 { return t.ControllerWriteIoBandwidthKbps }},
+	{"controller_write_io_ppm", func(t *stats.VmStatsTuple) *int64 { return t.ControllerWriteIoPpm }},
+	{"controller_wss120_second_read_mb", func(t *stats.VmStatsTuple) *int64 { return t.ControllerWss120SecondReadMb }},
+	{"controller_wss120_second_union_mb", func(t *stats.VmStatsTuple) *int64 { return t.ControllerWss120SecondUnionMb }},
+	{"controller_wss120_second_write_mb", func(t *stats.VmStatsTuple) *int64 { return t.ControllerWss120SecondWriteMb }},
+	{"controller_wss3600_second_read_mb", func(t *stats.VmStatsTuple) *int64 { return t.ControllerWss3600SecondReadMb }},
+	{"controller_wss3600_second_union_mb", func(t *stats.VmStatsTuple) *int64 { return t.ControllerWss3600SecondUnionMb }},
+	{"controller_wss3600_second_write_mb", func(t *stats.VmStatsTuple) *int64 { return t.ControllerWss3600SecondWriteMb }},
+	{"disk_capacity_bytes", func(t *stats.VmStatsTuple) *int64 { return t.DiskCapacityBytes }},
+	{"disk_usage_ppm", func(t *stats.VmStatsTuple) *int64 { return t.DiskUsagePpm }},
+	{"frame_buffer_usage_ppm", func(t *stats.VmStatsTuple) *int64 { return t.FrameBufferUsagePpm }},
+	{"gpu_usage_ppm", func(t *stats.VmStatsTuple) *int64 { return t.GpuUsagePpm }},
+	{"guest_memory_usage_ppm", func(t *stats.VmStatsTuple) *int64 { return t.GuestMemoryUsagePpm }},
+	{"hypervisor_avg_io_latency_micros", func(t *stats.VmStatsTuple) *int64 { return t.HypervisorAvgIoLatencyMicros }},
+	{"hypervisor_cpu_ready_time_ppm", func(t *stats.VmStatsTuple) *int64 { return t.HypervisorCpuReadyTimePpm }},
+	{"hypervisor_cpu_usage_ppm", func(t *stats.VmStatsTuple) *int64 { return t.HypervisorCpuUsagePpm }},
+	{"hypervisor_io_bandwidth_kbps", func(t *stats.VmStatsTuple) *int64 { return t.HypervisorIoBandwidthKbps }},
+	{"hypervisor_memory_balloon_reclaim_target_bytes", func(t *stats.VmStatsTuple) *int64 { return t.HypervisorMemoryBalloonReclaimTargetBytes }},
+	{"hypervisor_memory_balloon_reclaimed_bytes", func(t *stats.VmStatsTuple) *int64 { return t.HypervisorMemoryBalloonReclaimedBytes }},
+	{"hypervisor_memory_usage_ppm", func(t *stats.VmStatsTuple) *int64 { return t.HypervisorMemoryUsagePpm }},
+	{"hypervisor_num_io", func(t *stats.VmStatsTuple) *int64 { return t.HypervisorNumIo }},
+	{"hypervisor_num_iops", func(t *stats.VmStatsTuple) *int64 { return t.HypervisorNumIops }},
+	{"hypervisor_num_read_io", func(t *stats.VmStatsTuple) *int64 { return t.HypervisorNumReadIo }},
+	{"hypervisor_num_read_iops", func(t *stats.VmStatsTuple) *int64 { return t.HypervisorNumReadIops }},
+	{"hypervisor_num_receive_packets_dropped", func(t *stats.VmStatsTuple) *int64 { return t.HypervisorNumReceivePacketsDropped }},
+	{"hypervisor_num_received_bytes", func(t *stats.VmStatsTuple) *int64 { return t.HypervisorNumReceivedBytes }},
+	{"hypervisor_num_transmit_packets_dropped", func(t *stats.VmStatsTuple) *int64 { return t.HypervisorNumTransmitPacketsDropped }},
+	{"hypervisor_num_transmitted_bytes", func(t *stats.VmStatsTuple) *int64 { return t.HypervisorNumTransmittedBytes }},
+	{"hypervisor_num_write_io", func(t *stats.VmStatsTuple) *int64 { return t.HypervisorNumWriteIo }},
+	{"hypervisor_num_write_iops", func(t *stats.VmStatsTuple) *int64 { return t.HypervisorNumWriteIops }},
+	{"hypervisor_read_io_bandwidth_kbps", func(t *stats.VmStatsTuple) *int64 { return t.HypervisorReadIoBandwidthKbps }},
+	{"hypervisor_swap_in_rate_kbps", func(t *stats.VmStatsTuple) *int64 { return t.HypervisorSwapInRateKbps }},
+	{"hypervisor_swap_out_rate_kbps", func(t *stats.VmStatsTuple) *int64 { return t.HypervisorSwapOutRateKbps }},
+	{"hypervisor_timespan_micros", func(t *stats.VmStatsTuple) *int64 { return t.HypervisorTimespanMicros }},
+	{"hypervisor_total_io_size_kb", func(t *stats.VmStatsTuple) *int64 { return t.HypervisorTotalIoSizeKb }},
+	{"hypervisor_total_io_time_micros", func(t *stats.VmStatsTuple) *int64 { return t.HypervisorTotalIoTimeMicros }},
+	{"hypervisor_total_read_io_size_kb", func(t *stats.VmStatsTuple) *int64 { return t.HypervisorTotalReadIoSizeKb }},
+	{"hypervisor_vm_running_time_usecs", func(t *stats.VmStatsTuple) *int64 { return t.HypervisorVmRunningTimeUsecs }},
+	{"hypervisor_write_io_bandwidth_kbps", func(t *stats.VmStatsTuple) *int64 { return t.HypervisorWriteIoBandwidthKbps }},
+	{"memory_reserved_bytes", func(t *stats.VmStatsTuple) *int64 { return t.MemoryReservedBytes }},
+	{"memory_usage_bytes", func(t *stats.VmStatsTuple) *int64 { return t.MemoryUsageBytes }},
+	{"memory_usage_ppm", func(t *stats.VmStatsTuple) *int64 { return t.MemoryUsagePpm }},
+	{"num_vcpus_used_ppm", func(t *stats.VmStatsTuple) *int64 { return t.NumVcpusUsedPpm }},
+	{"physical_memory_allocated_bytes", func(t *stats.VmStatsTuple) *int64 { return t.PhysicalMemoryAllocatedBytes }},
+}
+
+var vmStatsTypes = map[string]vmmStats.DownSamplingOperator{
+	"SUM":   vmmStats.DOWNSAMPLINGOPERATOR_SUM,
+	"MIN":   vmmStats.DOWNSAMPLINGOPERATOR_MIN,
+	"MAX":   vmmStats.DOWNSAMPLINGOPERATOR_MAX,
+	"AVG":   vmmStats.DOWNSAMPLINGOPERATOR_AVG,
+	"COUNT": vmmStats.DOWNSAMPLINGOPERATOR_COUNT,
+	"LAST":  vmmStats.DOWNSAMPLINGOPERATOR_LAST,
+}
+
+func DatasourceNutanixVMStatsV2() *schema.Resource {
+	s := schemaForVMStatsQuery()
+	s["ext_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	s["tenant_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	s["links"] = schemaForLinks()
+	s["stats"] = schemaForVMStatsTuples()
+
+	return &schema.Resource{
+		ReadContext: DatasourceNutanixVMStatsV2Read,
+		Schema:      s,
+	}
+}
+
+func DatasourceNutanixVMStatsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VmmAPI()
+
+	extID := d.Get("ext_id").(string)
+	query, err := expandVMStatsQuery(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := conn.StatsAPIInstance.GetVmStatsById(utils.StringPtr(extID), &query.startTime, &query.endTime,
+		utils.IntPtr(query.samplingInterval), &query.statType, query.selects)
+	if err != nil {
+		return diag.Errorf("error while fetching vm stats : %v", err)
+	}
+
+	getResp := resp.Data.GetValue().(stats.VmStats)
+
+	if err := d.Set("tenant_id", getResp.TenantId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("links", flattenAPILink(getResp.Links)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("stats", flattenVMStatsTuples(getResp.Stats)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(extID)
+	return nil
+}
+
+// vmStatsQuery holds the query parameters of the vm stats APIs
+type vmStatsQuery struct {
+	startTime, endTime time.Time
+	samplingInterval   int
+	statType           vmmStats.DownSamplingOperator
+	selects            *string
+}
+
+func expandVMStatsQuery(d *schema.ResourceData) (*vmStatsQuery, error) {
+	query := &vmStatsQuery{
+		samplingInterval: d.Get("sampling_interval").(int),
+		// Default value is LAST, Aggregation containing only the last recorded value.
+		statType: vmmStats.DOWNSAMPLINGOPERATOR_LAST,
+	}
+	if query.samplingInterval <= 0 {
+		return nil, fmt.Errorf("sampling_interval should be greater than 0")
+	}
+	if statType, ok := vmStatsTypes[d.Get("stat_type").(string)]; ok {
+		query.statType = statType
+	}
+
+	var err error
+	if query.startTime, err = time.Parse(time.RFC3339, d.Get("start_time").(string)); err != nil {
+		return nil, fmt.Errorf("error while parsing start_time : %v", err)
+	}
+	if query.endTime, err = time.Parse(time.RFC3339, d.Get("end_time").(string)); err != nil {
+		return nil, fmt.Errorf("error while parsing end_time : %v", err)
+	}
+
+	// metrics are selected with their API attribute name, e.g. stats/hypervisorCpuUsagePpm
+	if selected := common.ExpandListOfString(d.Get("select").([]interface{})); len(selected) > 0 {
+		attributes := make([]string, len(selected))
+		for i, metric := range selected {
+			attributes[i] = "stats/" + snakeToCamelCase(metric)
+		}
+		query.selects = utils.StringPtr(strings.Join(attributes, ","))
+	}
+	return query, nil
+}
+
+func snakeToCamelCase(s string) string {
+	parts := strings.Split(s, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// schemaForVMStatsQuery returns the arguments of the vm stats data sources
+func schemaForVMStatsQuery() map[string]*schema.Schema {
+	metrics := make([]string, len(vmStatsMetrics))
+	for i, metric := range vmStatsMetrics {
+		metrics[i] = metric.name
+	}
+
+	return map[string]*schema.Schema{
+		"start_time": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"end_time": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"sampling_interval": {
+			Type:     schema.TypeInt,
+			Default:  1,
+			Optional: true,
+		},
+		"stat_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"AVG", "MIN", "MAX", "LAST", "SUM", "COUNT"}, false),
+		},
+		"select": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice(metrics, false),
+			},
+		},
+	}
+}
+
+func schemaForVMStatsTuples() *schema.Schema {
+	tuple := map[string]*schema.Schema{
+		"timestamp": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"cluster": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"hypervisor_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+	for _, metric := range vmStatsMetrics {
+		tuple[metric.name] = &schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: tuple,
+		},
+	}
+}
+
+func flattenVMStatsTuples(tuples []stats.VmStatsTuple) []map[string]interface{} {
+	if len(tuples) > 0 {
+		tupleList := make([]map[string]interface{}, len(tuples))
+
+		for k, v := range tuples {
+			tuple := map[string]interface{}{}
+			if v.Timestamp != nil {
+				tuple["timestamp"] = v.Timestamp.Format("2006-01-02T15:04:05Z07:00")
+			}
+			if v.Cluster != nil {
+				tuple["cluster"] = v.Cluster
+			}
+			if v.HypervisorType != nil {
+				tuple["hypervisor_type"] = v.HypervisorType
+			}
+			for _, metric := range vmStatsMetrics {
+				if value := metric.value(&v); value != nil {
+					tuple[metric.name] = int(*value)
+				}
+			}
+
+			tupleList[k] = tuple
+		}
+		return tupleList
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package vmmv2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+)
+
+const datasourceNameVMStats = "data.nutanix_vm_stats_v2.test"
+
+func TestAccV2NutanixVMStatsDataSource_Basic(t *testing.T) {
+	r := acctest.RandInt()
+	vmName := fmt.Sprintf("tf-test-vm-stats-%d", r)
+
+	// stats of the last hour, sampled every 5 minutes
+	endTime := time.Now().UTC()
+	startTime := endTime.Add(-1 * time.Hour)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testVMPreEnvConfig(r) + testVMStatsDatasourceConfig(vmName, startTime.Format(time.RFC3339), endTime.Format(time.RFC3339), "MAX"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceNameVMStats, "ext_id", "nutanix_virtual_machine_v2.test-vm", "id"),
+					resource.TestCheckResourceAttrSet(datasourceNameVMStats, "stats.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixVMStatsDataSource_InvalidStatType(t *testing.T) {
+	r := acctest.RandInt()
+	vmName := fmt.Sprintf("tf-test-vm-stats-%d", r)
+
+	endTime := time.Now().UTC()
+	startTime := endTime.Add(-1 * time.Hour)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testVMPreEnvConfig(r) + testVMStatsDatasourceConfig(vmName, startTime.Format(time.RFC3339), endTime.Format(time.RFC3339), "MEDIAN"),
+				ExpectError: regexp.MustCompile("expected stat_type to be one of"),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixVMsStatsDataSource_Basic(t *testing.T) {
+	endTime := time.Now().UTC()
+	startTime := endTime.Add(-1 * time.Hour)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testVMsStatsDatasourceConfig(startTime.Format(time.RFC3339), endTime.Format(time.RFC3339)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.nutanix_vms_stats_v2.test", "vm_stats.#"),
+					resource.TestCheckResourceAttrSet("data.nutanix_vms_stats_v2.test", "vm_stats.0.ext_id"),
+				),
+			},
+		},
+	})
+}
+
+func testVMStatsDatasourceConfig(vmName, startTime, endTime, statType string) string {
+	return fmt.Sprintf(`
+		resource "nutanix_virtual_machine_v2" "test-vm" {
+		  name                 = "%[1]s"
+		  description          = "vm to fetch stats of"
+		  num_cores_per_socket = 1
+		  num_sockets          = 1
+		  memory_size_bytes    = 1 * 1024 * 1024 * 1024
+		  cluster {
+			ext_id = local.clusterUUID
+		  }
+		  power_state = "ON"
+
+		  lifecycle {
+			ignore_changes = [guest_tools]
+		  }
+		}
+
+		data "nutanix_vm_stats_v2" "test" {
+		  ext_id            = nutanix_virtual_machine_v2.test-vm.id
+		  start_time        = "%[2]s"
+		  end_time          = "%[3]s"
+		  sampling_interval = 300
+		  stat_type         = "%[4]s"
+		  select            = ["hypervisor_cpu_usage_ppm", "memory_usage_ppm", "controller_num_iops", "hypervisor_num_transmitted_bytes"]
+		}
+`, vmName, startTime, endTime, statType)
+}
+
+func testVMsStatsDatasourceConfig(startTime, endTime string) string {
+	return fmt.Sprintf(`
+		data "nutanix_vms_stats_v2" "test" {
+		  start_time        = "%[1]s"
+		  end_time          = "%[2]s"
+		  sampling_interval = 300
+		  stat_type         = "AVG"
+		  select            = ["hypervisor_cpu_usage_ppm"]
+		  limit             = 5
+		}
+`, startTime, endTime)
+}
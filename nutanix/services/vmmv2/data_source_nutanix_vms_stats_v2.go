@@ -0,0 +1,134 @@
+package vmmv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/vmm/v4/ahv/stats"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func DatasourceNutanixVMsStatsV2() *schema.Resource {
+	s := schemaForVMStatsQuery()
+	s["page"] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Optional: true,
+	}
+	s["limit"] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Optional: true,
+	}
+	s["filter"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	s["order_by"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	s["vm_stats"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ext_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"tenant_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"links": schemaForLinks(),
+				"stats": schemaForVMStatsTuples(),
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: DatasourceNutanixVMsStatsV2Read,
+		Schema:      s,
+	}
+}
+
+func DatasourceNutanixVMsStatsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VmmAPI()
+
+	query, err := expandVMStatsQuery(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// initialize query params
+	var filter, orderBy *string
+	var page, limit *int
+
+	if pagef, ok := d.GetOk("page"); ok {
+		page = utils.IntPtr(pagef.(int))
+	}
+	if limitf, ok := d.GetOk("limit"); ok {
+		limit = utils.IntPtr(limitf.(int))
+	}
+	if filterf, ok := d.GetOk("filter"); ok {
+		filter = utils.StringPtr(filterf.(string))
+	}
+	if order, ok := d.GetOk("order_by"); ok {
+		orderBy = utils.StringPtr(order.(string))
+	}
+
+	resp, err := conn.StatsAPIInstance.ListVmStats(&query.startTime, &query.endTime, utils.IntPtr(query.samplingInterval),
+		&query.statType, page, limit, filter, orderBy, query.selects)
+	if err != nil {
+		return diag.Errorf("error while fetching vms stats : %v", err)
+	}
+
+	if resp.Data == nil {
+		if err := d.Set("vm_stats", make([]interface{}, 0)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(utils.GenUUID())
+
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "🫙 No data found.",
+			Detail:   "The API returned an empty list of vm stats.",
+		}}
+	}
+
+	getResp := resp.Data.GetValue().([]stats.VmStats)
+
+	if err := d.Set("vm_stats", flattenVMStatsList(getResp)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.GenUUID())
+	return nil
+}
+
+func flattenVMStatsList(vmStats []stats.VmStats) []interface{} {
+	if len(vmStats) > 0 {
+		vmStatsList := make([]interface{}, len(vmStats))
+
+		for k, v := range vmStats {
+			vm := make(map[string]interface{})
+
+			if v.ExtId != nil {
+				vm["ext_id"] = v.ExtId
+			}
+			if v.TenantId != nil {
+				vm["tenant_id"] = v.TenantId
+			}
+			if v.Links != nil {
+				vm["links"] = flattenAPILink(v.Links)
+			}
+			vm["stats"] = flattenVMStatsTuples(v.Stats)
+
+			vmStatsList[k] = vm
+		}
+		return vmStatsList
+	}
+	return nil
+}
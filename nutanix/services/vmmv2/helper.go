@@ -2,11 +2,15 @@ package vmmv2
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"reflect"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	vmmClient "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/client"
 	prismConfig "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/prism/v4/config"
 	"github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/vmm/v4/ahv/config"
 	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
@@ -35,6 +39,15 @@ func isVmmEtagMismatchErr(err error) bool {
 		strings.Contains(msg, "VMM-30303")
 }
 
+// isVmmNotFoundErr reports whether the vmm API answered the request with 404 Not Found
+func isVmmNotFoundErr(err error) bool {
+	var apiErr vmmClient.GenericOpenAPIError
+	if errors.As(err, &apiErr) {
+		return strings.HasPrefix(apiErr.Status, "404")
+	}
+	return false
+}
+
 // StripDataSourceFromDiskBackingInfo removes the data_source key from a disk's backing_info.vm_disk map.
 // Call this on each updated disk map before expanding for UpdateDiskById so the API accepts the payload.
 func StripDataSourceFromDiskBackingInfo(disk interface{}) {
@@ -68,14 +81,8 @@ func StripDataSourceFromDiskBackingInfo(disk interface{}) {
 }
 
 func waitForDiskTask(ctx context.Context, d *schema.ResourceData, meta interface{}, taskUUID *string, timeoutType string, operation string) diag.Diagnostics {
-	taskconn := meta.(*conns.Client).PrismAPI
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(timeoutType),
-	}
-	if _, errWait := stateConf.WaitForStateContext(ctx); errWait != nil {
+	taskconn := meta.(*conns.Client).PrismAPI()
+	if _, errWait := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(timeoutType)); errWait != nil {
 		return diag.Errorf("error waiting for disk (%s) to %s: %s", utils.StringValue(taskUUID), operation, errWait)
 	}
 	return nil
@@ -177,3 +184,127 @@ func ApplyDiskAdditions(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 	return nil
 }
+
+// diskStorageContainer returns the ext id of the storage container of a disk config, empty if unset.
+func diskStorageContainer(disk interface{}) string {
+	vmDisk := diskVMDiskConfig(disk)
+	if vmDisk == nil {
+		return ""
+	}
+	containers, ok := vmDisk["storage_container"].([]interface{})
+	if !ok || len(containers) == 0 || containers[0] == nil {
+		return ""
+	}
+	extID, _ := containers[0].(map[string]interface{})["ext_id"].(string)
+	return extID
+}
+
+// diskVMDiskConfig returns the backing_info.vm_disk map of a disk config, nil if unset.
+func diskVMDiskConfig(disk interface{}) map[string]interface{} {
+	diskMap, ok := disk.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	backingInfo, ok := diskMap["backing_info"].([]interface{})
+	if !ok || len(backingInfo) == 0 || backingInfo[0] == nil {
+		return nil
+	}
+	vmDisks, ok := backingInfo[0].(map[string]interface{})["vm_disk"].([]interface{})
+	if !ok || len(vmDisks) == 0 || vmDisks[0] == nil {
+		return nil
+	}
+	vmDisk, _ := vmDisks[0].(map[string]interface{})
+	return vmDisk
+}
+
+// withoutStorageContainer returns a copy of a disk config without its storage container.
+func withoutStorageContainer(disk interface{}) interface{} {
+	diskMap, ok := disk.(map[string]interface{})
+	if !ok || diskVMDiskConfig(disk) == nil {
+		return disk
+	}
+	vmDisk := make(map[string]interface{})
+	for k, v := range diskVMDiskConfig(disk) {
+		if k != "storage_container" {
+			vmDisk[k] = v
+		}
+	}
+	backingInfo := make(map[string]interface{})
+	for k, v := range diskMap["backing_info"].([]interface{})[0].(map[string]interface{}) {
+		backingInfo[k] = v
+	}
+	backingInfo["vm_disk"] = []interface{}{vmDisk}
+	copied := make(map[string]interface{})
+	for k, v := range diskMap {
+		copied[k] = v
+	}
+	copied["backing_info"] = []interface{}{backingInfo}
+	return copied
+}
+
+// ApplyDiskMigrations migrates in place the updated disks whose storage container changed to their new
+// storage container, keeping their data, and waits for the migration task. It returns the updated
+// disks that still differ from their previous config otherwise, to be updated with ApplyDiskUpdates.
+func ApplyDiskMigrations(ctx context.Context, d *schema.ResourceData, meta interface{}, conn *vmm.Client, vmID string, oldDisks, updatedDisks []interface{}) ([]interface{}, diag.Diagnostics) {
+	remaining := make([]interface{}, 0, len(updatedDisks))
+	plans := make([]config.ADSFDiskMigrationPlan, 0)
+	planIndex := make(map[string]int)
+	for _, disk := range updatedDisks {
+		extID, _ := disk.(map[string]interface{})["ext_id"].(string)
+		var oldDisk interface{}
+		for _, old := range oldDisks {
+			if oldExtID, _ := old.(map[string]interface{})["ext_id"].(string); oldExtID == extID {
+				oldDisk = old
+				break
+			}
+		}
+
+		container := diskStorageContainer(disk)
+		if extID == "" || oldDisk == nil || container == "" || container == diskStorageContainer(oldDisk) {
+			remaining = append(remaining, disk)
+			continue
+		}
+
+		i, ok := planIndex[container]
+		if !ok {
+			i = len(plans)
+			planIndex[container] = i
+			plans = append(plans, config.ADSFDiskMigrationPlan{
+				StorageContainer: &config.VmDiskContainerReference{ExtId: utils.StringPtr(container)},
+			})
+		}
+		plans[i].VmDisks = append(plans[i].VmDisks, config.MigrateDiskReference{DiskExtId: utils.StringPtr(extID)})
+
+		if !reflect.DeepEqual(withoutStorageContainer(disk), withoutStorageContainer(oldDisk)) {
+			remaining = append(remaining, disk)
+		}
+	}
+	if len(plans) == 0 {
+		return remaining, nil
+	}
+
+	migrateDisks := config.NewOneOfDiskMigrationParamsMigrateDisks()
+	if err := migrateDisks.SetValue(config.MigrationPlans{Plans: plans}); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	body := config.DiskMigrationParams{MigrateDisks: migrateDisks}
+	aJSON, _ := json.MarshalIndent(body, "", "  ")
+	log.Printf("[DEBUG] VM Disks Migrate Request Body: %s", string(aJSON))
+
+	readVMResp, err := conn.VMAPIInstance.GetVmById(utils.StringPtr(vmID))
+	if err != nil {
+		return nil, diag.Errorf("error while fetching vm : %v", err)
+	}
+	args := make(map[string]interface{})
+	args["If-Match"] = getEtagHeader(readVMResp, conn)
+
+	resp, err := conn.VMAPIInstance.MigrateVmDisks(utils.StringPtr(vmID), &body, args)
+	if err != nil {
+		return nil, diag.Errorf("error while migrating disks to storage container : %v", err)
+	}
+	taskRef := resp.Data.GetValue().(prismConfig.TaskReference)
+	if err := waitForDiskTask(ctx, d, meta, taskRef.ExtId, schema.TimeoutUpdate, "migrate"); err != nil {
+		return nil, err
+	}
+	return remaining, nil
+}
@@ -12,7 +12,7 @@ import (
 // implement vm check destroy function
 func testAccCheckNutanixVmsResourceDestroy(s *terraform.State) error {
 	conn := acc.TestAccProvider.Meta().(*conns.Client)
-	vmClient := conn.VmmAPI.VMAPIInstance
+	vmClient := conn.VmmAPI().VMAPIInstance
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "nutanix_virtual_machine_v2" {
@@ -0,0 +1,256 @@
+package vmmv2
+
+import (
+	"context"
+	"crypto/sha1" //nolint:gosec
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/prism/v4/config"
+	import5 "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/vmm/v4/content"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
+	v3 "github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v3/prism"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+const (
+	// defaultImageUploadRetries is the number of times a failed upload is retried when upload_retries is not set
+	defaultImageUploadRetries = 3
+	// imageUploadRetryDelay is the delay before the first retry of an upload, doubled on each retry
+	imageUploadRetryDelay = 10 * time.Second
+	// imageUploadProgressStep is the percentage of the file uploaded between two progress reports
+	imageUploadProgressStep = 10
+)
+
+// createImageFromPath creates the image and uploads the local file to it. The v4 API does not upload
+// files, so the image is created and its file uploaded with the v3 API, which gives the image the same
+// identifier in both APIs. The other attributes are then set with the v4 API.
+func createImageFromPath(ctx context.Context, d *schema.ResourceData, meta interface{}, path string) diag.Diagnostics {
+	v3conn := meta.(*conns.Client).API()
+
+	// verify the file before anything is created, so that a wrong file fails fast
+	checksum, err := imageFileChecksum(path, d.Get("checksum"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	request := &v3.ImageIntentInput{
+		Metadata: &v3.Metadata{
+			Kind: utils.StringPtr("image"),
+		},
+		Spec: &v3.Image{
+			Name: utils.StringPtr(d.Get("name").(string)),
+			Resources: &v3.ImageResources{
+				ImageType: utils.StringPtr(d.Get("type").(string)),
+			},
+		},
+	}
+	if desc, ok := d.GetOk("description"); ok {
+		request.Spec.Description = utils.StringPtr(desc.(string))
+	}
+
+	resp, err := v3conn.V3.CreateImage(request)
+	if err != nil {
+		return diag.Errorf("error while creating Image : %v", err)
+	}
+	uuid := utils.StringValue(resp.Metadata.UUID)
+
+	if taskUUID, ok := resp.Status.ExecutionContext.TaskUUID.(string); ok {
+		if err := waitForV3Task(ctx, v3conn, taskUUID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("error waiting for image (%s) to create: %s", uuid, err)
+		}
+	}
+	d.SetId(uuid)
+
+	if err := uploadImageFile(ctx, d, v3conn, path, checksum); err != nil {
+		// do not leave an image without its file behind
+		if delErr := ResourceNutanixImageV4Delete(ctx, d, meta); delErr.HasError() {
+			return append(delErr, diag.Errorf("failed uploading image %s: %s", uuid, err)...)
+		}
+		d.SetId("")
+		return diag.Errorf("failed uploading image %s: %s", uuid, err)
+	}
+
+	_, ctgOk := d.GetOk("category_ext_ids")
+	_, clsOk := d.GetOk("cluster_location_ext_ids")
+	if ctgOk || clsOk {
+		if diags := updateImageLocations(ctx, d, meta); diags.HasError() {
+			return diags
+		}
+	}
+	return ResourceNutanixImageV4Read(ctx, d, meta)
+}
+
+// uploadImageFile uploads the file to the image, retrying failed uploads with an exponential backoff.
+// The image file endpoint takes the whole file in a single request and cannot resume an upload, so a
+// retry sends the file again from its beginning to the same image. The client does not retry the upload
+// request itself, the file is sent at most upload_retries + 1 times.
+func uploadImageFile(ctx context.Context, d *schema.ResourceData, v3conn *v3.Client, path string, checksum *v3.Checksum) error {
+	retries := defaultImageUploadRetries
+	if r, ok := d.GetOkExists("upload_retries"); ok { //nolint:staticcheck
+		retries = r.(int)
+	}
+
+	delay := imageUploadRetryDelay
+	for attempt := 0; ; attempt++ {
+		err := v3conn.V3.UploadImageWithProgress(ctx, d.Id(), path, checksum, logImageUploadProgress(d.Id(), path))
+		if err == nil {
+			break
+		}
+		if attempt >= retries {
+			return err
+		}
+		log.Printf("[WARN] upload of %s to image %s failed: %s. Retrying in %s (attempt %d/%d)", path, d.Id(), err, delay, attempt+1, retries)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		delay *= 2
+	}
+
+	// the file is processed by tasks of the image once uploaded
+	image, err := v3conn.V3.GetImage(d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading image %s: %s", d.Id(), err)
+	}
+	if image.Status == nil || image.Status.ExecutionContext == nil {
+		return nil
+	}
+	taskUUIDs, _ := image.Status.ExecutionContext.TaskUUID.([]interface{})
+	for _, taskUUID := range taskUUIDs {
+		if err := waitForV3Task(ctx, v3conn, taskUUID.(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateImageLocations sets the categories and the cluster locations of an uploaded image
+func updateImageLocations(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VmmAPI()
+
+	resp, err := conn.ImagesAPIInstance.GetImageById(utils.StringPtr(d.Id()))
+	if err != nil {
+		return diag.Errorf("error while fetching images : %v", err)
+	}
+	updateSpec := resp.Data.GetValue().(import5.Image)
+
+	if ctgExts, ok := d.GetOk("category_ext_ids"); ok {
+		updateSpec.CategoryExtIds = flattenStringValue(ctgExts.([]interface{}))
+	}
+	if clsExts, ok := d.GetOk("cluster_location_ext_ids"); ok {
+		updateSpec.ClusterLocationExtIds = flattenStringValue(clsExts.([]interface{}))
+	}
+
+	updateResp, err := conn.ImagesAPIInstance.UpdateImageById(utils.StringPtr(d.Id()), &updateSpec)
+	if err != nil {
+		return diag.Errorf("error while updating images : %v", err)
+	}
+	TaskRef := updateResp.Data.GetValue().(import1.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	taskconn := meta.(*conns.Client).PrismAPI()
+	// Wait for the image to be updated
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
+		return diag.Errorf("error waiting for image (%s) to update: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+	return nil
+}
+
+// imageFileChecksum computes the checksum of the file with the algorithm of the configured checksum and
+// compares it to the configured digest. The checksum is returned so that Prism verifies the uploaded file
+// as well. No checksum is computed when none is configured.
+func imageFileChecksum(path string, checksum interface{}) (*v3.Checksum, error) {
+	checksums, _ := checksum.([]interface{})
+	if len(checksums) == 0 || checksums[0] == nil {
+		return nil, nil
+	}
+	val := checksums[0].(map[string]interface{})
+	expected := val["hex_digest"].(string)
+
+	var h hash.Hash
+	var algorithm string
+	if val["object_type"] == "sha1" {
+		h = sha1.New() //nolint:gosec
+		algorithm = "SHA_1"
+	} else {
+		h = sha256.New()
+		algorithm = "SHA_256"
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error: cannot open file: %s", err)
+	}
+	defer file.Close()
+
+	log.Printf("[DEBUG] computing the %s checksum of %s", val["object_type"], path)
+	if _, err := io.Copy(h, file); err != nil {
+		return nil, fmt.Errorf("error reading file %s: %s", path, err)
+	}
+
+	digest := hex.EncodeToString(h.Sum(nil))
+	if !strings.EqualFold(digest, expected) {
+		return nil, fmt.Errorf("%s checksum of %s is %s, expected %s", val["object_type"], path, digest, expected)
+	}
+	return &v3.Checksum{
+		ChecksumAlgorithm: utils.StringPtr(algorithm),
+		ChecksumValue:     utils.StringPtr(expected),
+	}, nil
+}
+
+// logImageUploadProgress logs the progress of the upload every imageUploadProgressStep percent
+func logImageUploadProgress(uuid, path string) v3.UploadProgressFunc {
+	last := int64(-1)
+	return func(sent, total int64) {
+		if total <= 0 {
+			return
+		}
+		step := sent * 100 / total / imageUploadProgressStep
+		// a retried upload starts over from the beginning of the file
+		if step == last {
+			return
+		}
+		last = step
+		log.Printf("[INFO] uploading %s to image %s: %d%% (%d/%d bytes)", path, uuid, step*imageUploadProgressStep, sent, total)
+	}
+}
+
+// waitForV3Task waits for a v3 task to succeed
+func waitForV3Task(ctx context.Context, v3conn *v3.Client, taskUUID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"QUEUED", "RUNNING"},
+		Target:  []string{"SUCCEEDED"},
+		Refresh: func() (interface{}, string, error) {
+			task, err := v3conn.V3.GetTask(taskUUID)
+			if err != nil {
+				return nil, "", err
+			}
+			if utils.StringValue(task.Status) == "FAILED" {
+				return task, "FAILED", fmt.Errorf("error_detail: %s, progress_message: %s",
+					utils.StringValue(task.ErrorDetail), utils.StringValue(task.ProgressMessage))
+			}
+			return task, utils.StringValue(task.Status), nil
+		},
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 2 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
@@ -0,0 +1,183 @@
+package vmmv2
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/prism/v4/config"
+	esxiConfig "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/vmm/v4/esxi/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// ResourceNutanixEsxiVMCategoriesV2 associates categories to a VM of an ESXi cluster. Only the
+// categories of the resource are managed, other categories of the VM are left as they are.
+func ResourceNutanixEsxiVMCategoriesV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceNutanixEsxiVMCategoriesV2Create,
+		ReadContext:   ResourceNutanixEsxiVMCategoriesV2Read,
+		UpdateContext: ResourceNutanixEsxiVMCategoriesV2Update,
+		DeleteContext: ResourceNutanixEsxiVMCategoriesV2Delete,
+		Schema: map[string]*schema.Schema{
+			"ext_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"categories": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ext_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceNutanixEsxiVMCategoriesV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vmExtID := d.Get("ext_id").(string)
+
+	categories := expandEsxiCategoryReferences(d.Get("categories").([]interface{}))
+	if diags := updateEsxiVMCategories(ctx, d, meta, vmExtID, categories, true); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(vmExtID)
+	return ResourceNutanixEsxiVMCategoriesV2Read(ctx, d, meta)
+}
+
+// ResourceNutanixEsxiVMCategoriesV2Read keeps the categories of the resource that are still associated
+// to the VM, so that a category disassociated outside of terraform shows as a change.
+func ResourceNutanixEsxiVMCategoriesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VmmAPI()
+
+	resp, err := conn.EsxiVMAPIInstance.GetVmById(utils.StringPtr(d.Id()))
+	if err != nil {
+		return diag.Errorf("error while fetching esxi vm : %v", err)
+	}
+	vm := resp.Data.GetValue().(esxiConfig.Vm)
+
+	associated := make(map[string]bool, len(vm.Categories))
+	for _, category := range vm.Categories {
+		associated[utils.StringValue(category.ExtId)] = true
+	}
+
+	categories := make([]interface{}, 0)
+	for _, category := range d.Get("categories").([]interface{}) {
+		if associated[category.(map[string]interface{})["ext_id"].(string)] {
+			categories = append(categories, category)
+		}
+	}
+	if err := d.Set("categories", categories); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func ResourceNutanixEsxiVMCategoriesV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	oldCategories, newCategories := d.GetChange("categories")
+	oldRefs := expandEsxiCategoryReferences(oldCategories.([]interface{}))
+	newRefs := expandEsxiCategoryReferences(newCategories.([]interface{}))
+
+	if removed := diffEsxiCategoryReferences(oldRefs, newRefs); len(removed) > 0 {
+		if diags := updateEsxiVMCategories(ctx, d, meta, d.Id(), removed, false); diags.HasError() {
+			return diags
+		}
+	}
+	if added := diffEsxiCategoryReferences(newRefs, oldRefs); len(added) > 0 {
+		if diags := updateEsxiVMCategories(ctx, d, meta, d.Id(), added, true); diags.HasError() {
+			return diags
+		}
+	}
+	return ResourceNutanixEsxiVMCategoriesV2Read(ctx, d, meta)
+}
+
+func ResourceNutanixEsxiVMCategoriesV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	categories := expandEsxiCategoryReferences(d.Get("categories").([]interface{}))
+	if len(categories) == 0 {
+		return nil
+	}
+	return updateEsxiVMCategories(ctx, d, meta, d.Id(), categories, false)
+}
+
+// updateEsxiVMCategories associates the categories to the VM, or disassociates them from it
+func updateEsxiVMCategories(ctx context.Context, d *schema.ResourceData, meta interface{}, vmExtID string,
+	categories []esxiConfig.CategoryReference, associate bool,
+) diag.Diagnostics {
+	conn := meta.(*conns.Client).VmmAPI()
+
+	readResp, err := conn.EsxiVMAPIInstance.GetVmById(utils.StringPtr(vmExtID))
+	if err != nil {
+		return diag.Errorf("error while reading esxi vm : %v", err)
+	}
+	// Extract E-Tag Header
+	args := make(map[string]interface{})
+	args["If-Match"] = getEtagHeader(readResp, conn)
+
+	var TaskRef import1.TaskReference
+	if associate {
+		body := &esxiConfig.AssociateVmCategoriesParams{Categories: categories}
+		aJSON, _ := json.MarshalIndent(body, "", "  ")
+		log.Printf("[DEBUG] Esxi VM associate categories request body: %s", string(aJSON))
+
+		resp, err := conn.EsxiVMAPIInstance.AssociateCategories(utils.StringPtr(vmExtID), body, args)
+		if err != nil {
+			return diag.Errorf("error while associating categories to esxi vm : %v", err)
+		}
+		TaskRef = resp.Data.GetValue().(import1.TaskReference)
+	} else {
+		body := &esxiConfig.DisassociateVmCategoriesParams{Categories: categories}
+		aJSON, _ := json.MarshalIndent(body, "", "  ")
+		log.Printf("[DEBUG] Esxi VM disassociate categories request body: %s", string(aJSON))
+
+		resp, err := conn.EsxiVMAPIInstance.DisassociateCategories(utils.StringPtr(vmExtID), body, args)
+		if err != nil {
+			return diag.Errorf("error while disassociating categories from esxi vm : %v", err)
+		}
+		TaskRef = resp.Data.GetValue().(import1.TaskReference)
+	}
+	taskUUID := TaskRef.ExtId
+
+	taskconn := meta.(*conns.Client).PrismAPI()
+	// Wait for the categories to be associated or disassociated
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
+		return diag.Errorf("error waiting for esxi vm categories (%s) to update: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+	return nil
+}
+
+func expandEsxiCategoryReferences(pr []interface{}) []esxiConfig.CategoryReference {
+	categories := make([]esxiConfig.CategoryReference, 0, len(pr))
+	for _, v := range pr {
+		category := esxiConfig.NewCategoryReference()
+		category.ExtId = utils.StringPtr(v.(map[string]interface{})["ext_id"].(string))
+		categories = append(categories, *category)
+	}
+	return categories
+}
+
+// diffEsxiCategoryReferences returns the categories of a that are not in b
+func diffEsxiCategoryReferences(a, b []esxiConfig.CategoryReference) []esxiConfig.CategoryReference {
+	inB := make(map[string]bool, len(b))
+	for _, category := range b {
+		inB[utils.StringValue(category.ExtId)] = true
+	}
+	diff := make([]esxiConfig.CategoryReference, 0)
+	for _, category := range a {
+		if !inB[utils.StringValue(category.ExtId)] {
+			diff = append(diff, category)
+		}
+	}
+	return diff
+}
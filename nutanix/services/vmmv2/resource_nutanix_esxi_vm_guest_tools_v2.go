@@ -0,0 +1,270 @@
+package vmmv2
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/prism/v4/config"
+	esxiConfig "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/vmm/v4/esxi/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// ResourceNutanixEsxiVMGuestToolsV2 installs Nutanix Guest Tools on a VM of an ESXi cluster, and
+// uninstalls them when destroyed.
+func ResourceNutanixEsxiVMGuestToolsV2() *schema.Resource {
+	s := schemaForEsxiGuestTools()
+	s["ext_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+	s["credential"] = &schema.Schema{
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"username": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"password": {
+					Type:      schema.TypeString,
+					Required:  true,
+					Sensitive: true,
+				},
+			},
+		},
+	}
+	s["capabilities"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{"SELF_SERVICE_RESTORE", "VSS_SNAPSHOT"}, false),
+		},
+	}
+	s["reboot_preference"] = &schema.Schema{
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"schedule_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice([]string{"SKIP", "IMMEDIATE", "LATER"}, false),
+				},
+				"schedule": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"start_time": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	s["is_enabled"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		CreateContext: ResourceNutanixEsxiVMGuestToolsV2Create,
+		ReadContext:   ResourceNutanixEsxiVMGuestToolsV2Read,
+		UpdateContext: ResourceNutanixEsxiVMGuestToolsV2Update,
+		DeleteContext: ResourceNutanixEsxiVMGuestToolsV2Delete,
+		Schema:        s,
+	}
+}
+
+func ResourceNutanixEsxiVMGuestToolsV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VmmAPI()
+
+	vmExtID := d.Get("ext_id").(string)
+
+	readResp, err := conn.EsxiVMAPIInstance.GetVmById(utils.StringPtr(vmExtID))
+	if err != nil {
+		return diag.Errorf("error while reading esxi vm : %v", err)
+	}
+	// Extract E-Tag Header
+	args := make(map[string]interface{})
+	args["If-Match"] = getEtagHeader(readResp, conn)
+
+	body := &esxiConfig.NutanixGuestToolsInstallConfig{}
+	if capabilities, ok := d.GetOk("capabilities"); ok {
+		body.Capabilities = expandEsxiGuestToolsCapabilities(capabilities.([]interface{}))
+	}
+	if credential, ok := d.GetOk("credential"); ok {
+		cred := credential.([]interface{})[0].(map[string]interface{})
+		body.Credential = &esxiConfig.NutanixCredential{
+			Username: utils.StringPtr(cred["username"].(string)),
+			Password: utils.StringPtr(cred["password"].(string)),
+		}
+	}
+	if rebootPreference, ok := d.GetOk("reboot_preference"); ok {
+		preference, err := expandEsxiRebootPreference(rebootPreference.([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		body.RebootPreference = preference
+	}
+
+	aJSON, _ := json.Marshal(body.Capabilities)
+	log.Printf("[DEBUG] Installing NGT on esxi vm %s with capabilities: %s", vmExtID, aJSON)
+
+	resp, err := conn.EsxiVMAPIInstance.InstallNutanixGuestTools(utils.StringPtr(vmExtID), body, args)
+	if err != nil {
+		return diag.Errorf("error while installing guest tools on esxi vm : %v", err)
+	}
+	TaskRef := resp.Data.GetValue().(import1.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	taskconn := meta.(*conns.Client).PrismAPI()
+	// Wait for the NGT to be installed
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
+		return diag.Errorf("error waiting for NGT installation (%s) to complete: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+
+	d.SetId(vmExtID)
+	return ResourceNutanixEsxiVMGuestToolsV2Read(ctx, d, meta)
+}
+
+func ResourceNutanixEsxiVMGuestToolsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VmmAPI()
+
+	resp, err := conn.EsxiVMAPIInstance.GetNutanixGuestToolsById(utils.StringPtr(d.Id()))
+	if err != nil {
+		return diag.Errorf("error while fetching esxi vm guest tools : %v", err)
+	}
+	getResp := resp.Data.GetValue().(esxiConfig.NutanixGuestTools)
+
+	for key, value := range flattenEsxiGuestTools(&getResp) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func ResourceNutanixEsxiVMGuestToolsV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VmmAPI()
+
+	if !d.HasChanges("capabilities", "is_enabled") {
+		return ResourceNutanixEsxiVMGuestToolsV2Read(ctx, d, meta)
+	}
+
+	readResp, err := conn.EsxiVMAPIInstance.GetNutanixGuestToolsById(utils.StringPtr(d.Id()))
+	if err != nil {
+		return diag.Errorf("error while fetching esxi vm guest tools : %v", err)
+	}
+	args := make(map[string]interface{})
+	args["If-Match"] = getEtagHeader(readResp, conn)
+
+	updateSpec := readResp.Data.GetValue().(esxiConfig.NutanixGuestTools)
+	if d.HasChange("capabilities") {
+		updateSpec.Capabilities = expandEsxiGuestToolsCapabilities(d.Get("capabilities").([]interface{}))
+	}
+	if d.HasChange("is_enabled") {
+		updateSpec.IsEnabled = utils.BoolPtr(d.Get("is_enabled").(bool))
+	}
+
+	resp, err := conn.EsxiVMAPIInstance.UpdateNutanixGuestToolsById(utils.StringPtr(d.Id()), &updateSpec, args)
+	if err != nil {
+		return diag.Errorf("error while updating guest tools of esxi vm : %v", err)
+	}
+	TaskRef := resp.Data.GetValue().(import1.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	taskconn := meta.(*conns.Client).PrismAPI()
+	// Wait for the NGT to be updated
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutUpdate)); errWaitTask != nil {
+		return diag.Errorf("error waiting for NGT update (%s) to complete: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+	return ResourceNutanixEsxiVMGuestToolsV2Read(ctx, d, meta)
+}
+
+func ResourceNutanixEsxiVMGuestToolsV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VmmAPI()
+
+	readResp, err := conn.EsxiVMAPIInstance.GetVmById(utils.StringPtr(d.Id()))
+	if err != nil {
+		return diag.Errorf("error while reading esxi vm : %v", err)
+	}
+	args := make(map[string]interface{})
+	args["If-Match"] = getEtagHeader(readResp, conn)
+
+	resp, err := conn.EsxiVMAPIInstance.UninstallNutanixGuestTools(utils.StringPtr(d.Id()), args)
+	if err != nil {
+		return diag.Errorf("error while uninstalling guest tools from esxi vm : %v", err)
+	}
+	TaskRef := resp.Data.GetValue().(import1.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	taskconn := meta.(*conns.Client).PrismAPI()
+	// Wait for the NGT to be uninstalled
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutDelete)); errWaitTask != nil {
+		return diag.Errorf("error waiting for NGT uninstallation (%s) to complete: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+	return nil
+}
+
+func expandEsxiGuestToolsCapabilities(pr []interface{}) []esxiConfig.NutanixGuestToolsCapability {
+	const two, three = 2, 3
+	capabilityMap := map[string]int{
+		"SELF_SERVICE_RESTORE": two,
+		"VSS_SNAPSHOT":         three,
+	}
+	capabilities := make([]esxiConfig.NutanixGuestToolsCapability, 0, len(pr))
+	for _, v := range pr {
+		if pVal, ok := capabilityMap[v.(string)]; ok {
+			capabilities = append(capabilities, esxiConfig.NutanixGuestToolsCapability(pVal))
+		}
+	}
+	return capabilities
+}
+
+func expandEsxiRebootPreference(pr []interface{}) (*esxiConfig.NutanixRebootPreference, error) {
+	if len(pr) == 0 || pr[0] == nil {
+		return nil, nil
+	}
+	rp := pr[0].(map[string]interface{})
+
+	const two, three, four = 2, 3, 4
+	scheduleTypesMap := map[string]int{
+		"SKIP":      two,
+		"IMMEDIATE": three,
+		"LATER":     four,
+	}
+	scheduleType := rp["schedule_type"].(string)
+	preference := &esxiConfig.NutanixRebootPreference{
+		ScheduleType: (*esxiConfig.NutanixScheduleType)(utils.IntPtr(scheduleTypesMap[scheduleType])),
+	}
+	if schedule, ok := rp["schedule"].([]interface{}); ok && scheduleType == "LATER" && len(schedule) > 0 {
+		s := schedule[0].(map[string]interface{})
+		t, err := time.Parse(time.RFC3339, s["start_time"].(string))
+		if err != nil {
+			return nil, err
+		}
+		preference.Schedule = &esxiConfig.NutanixRebootPreferenceSchedule{
+			StartTime: utils.Time(t),
+		}
+	}
+	return preference, nil
+}
@@ -0,0 +1,114 @@
+package vmmv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/prism/v4/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// ResourceNutanixEsxiVMPowerActionV2 changes the power state of a VM of an ESXi cluster
+func ResourceNutanixEsxiVMPowerActionV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceNutanixEsxiVMPowerActionV2Create,
+		ReadContext:   ResourceNutanixEsxiVMPowerActionV2Read,
+		UpdateContext: ResourceNutanixEsxiVMPowerActionV2Update,
+		DeleteContext: ResourceNutanixEsxiVMPowerActionV2Delete,
+		Schema: map[string]*schema.Schema{
+			"ext_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"action": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{"power_on", "power_off", "reset", "suspend",
+					"guest_shutdown", "guest_reboot"}, false),
+			},
+		},
+	}
+}
+
+func ResourceNutanixEsxiVMPowerActionV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VmmAPI()
+
+	vmExtID := utils.StringPtr(d.Get("ext_id").(string))
+	action := d.Get("action").(string)
+
+	readResp, err := conn.EsxiVMAPIInstance.GetVmById(vmExtID)
+	if err != nil {
+		return diag.Errorf("error while reading esxi vm : %v", err)
+	}
+	// Extract E-Tag Header
+	args := make(map[string]interface{})
+	args["If-Match"] = getEtagHeader(readResp, conn)
+
+	var TaskRef import1.TaskReference
+	switch action {
+	case "power_on":
+		resp, err := conn.EsxiVMAPIInstance.PowerOnVm(vmExtID, args)
+		if err != nil {
+			return diag.Errorf("error while powering on esxi vm : %v", err)
+		}
+		TaskRef = resp.Data.GetValue().(import1.TaskReference)
+	case "power_off":
+		resp, err := conn.EsxiVMAPIInstance.PowerOffVm(vmExtID, args)
+		if err != nil {
+			return diag.Errorf("error while powering off esxi vm : %v", err)
+		}
+		TaskRef = resp.Data.GetValue().(import1.TaskReference)
+	case "reset":
+		resp, err := conn.EsxiVMAPIInstance.ResetVm(vmExtID, args)
+		if err != nil {
+			return diag.Errorf("error while resetting esxi vm : %v", err)
+		}
+		TaskRef = resp.Data.GetValue().(import1.TaskReference)
+	case "suspend":
+		resp, err := conn.EsxiVMAPIInstance.SuspendVm(vmExtID, args)
+		if err != nil {
+			return diag.Errorf("error while suspending esxi vm : %v", err)
+		}
+		TaskRef = resp.Data.GetValue().(import1.TaskReference)
+	case "guest_shutdown":
+		resp, err := conn.EsxiVMAPIInstance.ShutdownGuestVm(vmExtID, args)
+		if err != nil {
+			return diag.Errorf("error while shutting down guest of esxi vm : %v", err)
+		}
+		TaskRef = resp.Data.GetValue().(import1.TaskReference)
+	case "guest_reboot":
+		resp, err := conn.EsxiVMAPIInstance.RebootGuestVm(vmExtID, args)
+		if err != nil {
+			return diag.Errorf("error while rebooting guest of esxi vm : %v", err)
+		}
+		TaskRef = resp.Data.GetValue().(import1.TaskReference)
+	}
+	taskUUID := TaskRef.ExtId
+
+	taskconn := meta.(*conns.Client).PrismAPI()
+	// Wait for the VM action to complete
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
+		return diag.Errorf("error waiting for esxi vm action (%s) (%s) to complete: %s", action, utils.StringValue(taskUUID), errWaitTask)
+	}
+
+	// This is an action resource that does not maintain state.
+	// The resource ID is set to the task ExtId for traceability.
+	d.SetId(utils.StringValue(taskUUID))
+	return nil
+}
+
+func ResourceNutanixEsxiVMPowerActionV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func ResourceNutanixEsxiVMPowerActionV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return ResourceNutanixEsxiVMPowerActionV2Create(ctx, d, meta)
+}
+
+func ResourceNutanixEsxiVMPowerActionV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
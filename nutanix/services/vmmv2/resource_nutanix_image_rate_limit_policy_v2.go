@@ -0,0 +1,274 @@
+package vmmv2
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	import2 "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/prism/v4/config"
+	import7 "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/vmm/v4/images/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// ResourceNutanixImageRateLimitPolicyV2 limits the network bandwidth image operations use on the clusters
+// matching the categories of the policy
+func ResourceNutanixImageRateLimitPolicyV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceNutanixImageRateLimitPolicyV2Create,
+		ReadContext:   ResourceNutanixImageRateLimitPolicyV2Read,
+		UpdateContext: ResourceNutanixImageRateLimitPolicyV2Update,
+		DeleteContext: ResourceNutanixImageRateLimitPolicyV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"ext_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"rate_limit_kbps": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"cluster_entity_filter": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"CATEGORIES_MATCH_ALL", "CATEGORIES_MATCH_ANY"}, false),
+						},
+						"category_ext_ids": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"matching_cluster_ext_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner_ext_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"links": schemaForLinks(),
+		},
+	}
+}
+
+func ResourceNutanixImageRateLimitPolicyV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VmmAPI()
+
+	body := &import7.RateLimitPolicy{
+		Name:                utils.StringPtr(d.Get("name").(string)),
+		RateLimitKbps:       utils.Int64Ptr(int64(d.Get("rate_limit_kbps").(int))),
+		ClusterEntityFilter: expandEntityFilter(d.Get("cluster_entity_filter")),
+	}
+	if desc, ok := d.GetOk("description"); ok {
+		body.Description = utils.StringPtr(desc.(string))
+	}
+
+	aJSON, _ := json.MarshalIndent(body, "", "  ")
+	log.Printf("[DEBUG] Image Rate Limit Policy Create Request Body: %s", string(aJSON))
+
+	resp, err := conn.ImagesRateLimitAPIInstance.CreateRateLimitPolicy(body)
+	if err != nil {
+		return diag.Errorf("error while creating image rate limit policy : %v", err)
+	}
+
+	TaskRef := resp.Data.GetValue().(import1.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	taskconn := meta.(*conns.Client).PrismAPI()
+	// Wait for the image rate limit policy to be created
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
+		return diag.Errorf("error waiting for image rate limit policy (%s) to create: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+
+	// Get UUID from TASK API
+	taskResp, err := taskconn.TaskRefAPI.GetTaskById(taskUUID, nil)
+	if err != nil {
+		return diag.Errorf("error while fetching image rate limit policy create task (%s): %v", utils.StringValue(taskUUID), err)
+	}
+	taskDetails := taskResp.Data.GetValue().(import2.Task)
+
+	aJSON, _ = json.MarshalIndent(taskDetails, "", "  ")
+	log.Printf("[DEBUG] Image Rate Limit Policy Task Details: %s", string(aJSON))
+
+	uuid, err := common.ExtractEntityUUIDFromTask(taskDetails, utils.RelEntityTypeImageRateLimitPolicy, "Image rate limit policy")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(utils.StringValue(uuid))
+	return ResourceNutanixImageRateLimitPolicyV2Read(ctx, d, meta)
+}
+
+func ResourceNutanixImageRateLimitPolicyV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VmmAPI()
+
+	resp, err := conn.ImagesRateLimitAPIInstance.GetRateLimitPolicyById(utils.StringPtr(d.Id()))
+	if err != nil {
+		return diag.Errorf("error while fetching image rate limit policy : %v", err)
+	}
+
+	getResp := resp.Data.GetValue().(import7.RateLimitPolicy)
+
+	for key, value := range flattenRateLimitPolicy(getResp) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func ResourceNutanixImageRateLimitPolicyV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VmmAPI()
+
+	readResp, err := conn.ImagesRateLimitAPIInstance.GetRateLimitPolicyById(utils.StringPtr(d.Id()))
+	if err != nil {
+		return diag.Errorf("error while fetching image rate limit policy : %v", err)
+	}
+	// Extract E-Tag Header
+	args := make(map[string]interface{})
+	args["If-Match"] = getEtagHeader(readResp, conn)
+
+	updateSpec := readResp.Data.GetValue().(import7.RateLimitPolicy)
+
+	if d.HasChange("name") {
+		updateSpec.Name = utils.StringPtr(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		updateSpec.Description = utils.StringPtr(d.Get("description").(string))
+	}
+	if d.HasChange("rate_limit_kbps") {
+		updateSpec.RateLimitKbps = utils.Int64Ptr(int64(d.Get("rate_limit_kbps").(int)))
+	}
+	if d.HasChange("cluster_entity_filter") {
+		updateSpec.ClusterEntityFilter = expandEntityFilter(d.Get("cluster_entity_filter"))
+	}
+
+	aJSON, _ := json.MarshalIndent(updateSpec, "", "  ")
+	log.Printf("[DEBUG] Image Rate Limit Policy Update Request Body: %s", string(aJSON))
+
+	updateResp, err := conn.ImagesRateLimitAPIInstance.UpdateRateLimitPolicyById(utils.StringPtr(d.Id()), &updateSpec, args)
+	if err != nil {
+		return diag.Errorf("error while updating image rate limit policy : %v", err)
+	}
+	TaskRef := updateResp.Data.GetValue().(import1.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	taskconn := meta.(*conns.Client).PrismAPI()
+	// Wait for the image rate limit policy to be updated
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutUpdate)); errWaitTask != nil {
+		return diag.Errorf("error waiting for image rate limit policy (%s) to update: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+
+	return ResourceNutanixImageRateLimitPolicyV2Read(ctx, d, meta)
+}
+
+func ResourceNutanixImageRateLimitPolicyV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VmmAPI()
+
+	resp, err := conn.ImagesRateLimitAPIInstance.DeleteRateLimitPolicyById(utils.StringPtr(d.Id()))
+	if err != nil {
+		return diag.Errorf("error while deleting image rate limit policy : %v", err)
+	}
+	TaskRef := resp.Data.GetValue().(import1.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	taskconn := meta.(*conns.Client).PrismAPI()
+	// Wait for the image rate limit policy to be deleted
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutDelete)); errWaitTask != nil {
+		return diag.Errorf("error waiting for image rate limit policy (%s) to delete: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+	return nil
+}
+
+func flattenRateLimitPolicy(pr import7.RateLimitPolicy) map[string]interface{} {
+	policy := make(map[string]interface{})
+
+	if pr.ExtId != nil {
+		policy["ext_id"] = pr.ExtId
+	}
+	if pr.TenantId != nil {
+		policy["tenant_id"] = pr.TenantId
+	}
+	if pr.Links != nil {
+		policy["links"] = flattenAPILink(pr.Links)
+	}
+	if pr.Name != nil {
+		policy["name"] = pr.Name
+	}
+	if pr.Description != nil {
+		policy["description"] = pr.Description
+	}
+	if pr.RateLimitKbps != nil {
+		policy["rate_limit_kbps"] = pr.RateLimitKbps
+	}
+	if pr.ClusterEntityFilter != nil {
+		filter := map[string]interface{}{
+			"category_ext_ids": pr.ClusterEntityFilter.CategoryExtIds,
+		}
+		if pr.ClusterEntityFilter.Type != nil {
+			filter["type"] = pr.ClusterEntityFilter.Type.GetName()
+		}
+		policy["cluster_entity_filter"] = []interface{}{filter}
+	}
+	policy["matching_cluster_ext_ids"] = pr.MatchingClusterExtIds
+	if pr.CreateTime != nil {
+		policy["create_time"] = pr.CreateTime.String()
+	}
+	if pr.LastUpdateTime != nil {
+		policy["last_update_time"] = pr.LastUpdateTime.String()
+	}
+	if pr.OwnerExtId != nil {
+		policy["owner_ext_id"] = pr.OwnerExtId
+	}
+	if pr.OwnerName != nil {
+		policy["owner_name"] = pr.OwnerName
+	}
+	return policy
+}
@@ -0,0 +1,119 @@
+package vmmv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+)
+
+const (
+	resourceNameImageRateLimitPolicy          = "nutanix_image_rate_limit_policy_v2.test"
+	datasourceNameImageRateLimitPolicy        = "data.nutanix_image_rate_limit_policy_v2.test"
+	datasourceNameImageRateLimitPolicies      = "data.nutanix_image_rate_limit_policies_v2.test"
+	datasourceNameImageEffectiveRateLimitList = "data.nutanix_image_effective_rate_limit_policies_v2.test"
+)
+
+func TestAccV2NutanixImageRateLimitPolicyResource_Basic(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("test-irlp-%d", r)
+	desc := "test irlp description"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			// create image rate limit policy
+			{
+				Config: testImageRateLimitPolicyV2Config(name, desc, 10240),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceNameImageRateLimitPolicy, "ext_id"),
+					resource.TestCheckResourceAttr(resourceNameImageRateLimitPolicy, "name", name),
+					resource.TestCheckResourceAttr(resourceNameImageRateLimitPolicy, "description", desc),
+					resource.TestCheckResourceAttr(resourceNameImageRateLimitPolicy, "rate_limit_kbps", "10240"),
+					resource.TestCheckResourceAttr(resourceNameImageRateLimitPolicy, "cluster_entity_filter.0.type", "CATEGORIES_MATCH_ANY"),
+					resource.TestCheckResourceAttrSet(resourceNameImageRateLimitPolicy, "create_time"),
+				),
+			},
+			// update the rate limit
+			{
+				Config: testImageRateLimitPolicyV2Config(name, desc+" updated", 20480),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameImageRateLimitPolicy, "description", desc+" updated"),
+					resource.TestCheckResourceAttr(resourceNameImageRateLimitPolicy, "rate_limit_kbps", "20480"),
+				),
+			},
+			// import the policy
+			{
+				ResourceName:      resourceNameImageRateLimitPolicy,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixImageRateLimitPolicyDatasource_Basic(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("test-irlp-%d", r)
+	desc := "test irlp description"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testImageRateLimitPolicyV2Config(name, desc, 10240) + testImageRateLimitPolicyDatasourcesV2Config(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceNameImageRateLimitPolicy, "ext_id", resourceNameImageRateLimitPolicy, "ext_id"),
+					resource.TestCheckResourceAttr(datasourceNameImageRateLimitPolicy, "name", name),
+					resource.TestCheckResourceAttr(datasourceNameImageRateLimitPolicy, "rate_limit_kbps", "10240"),
+					resource.TestCheckResourceAttr(datasourceNameImageRateLimitPolicies, "rate_limit_policies.#", "1"),
+					resource.TestCheckResourceAttr(datasourceNameImageRateLimitPolicies, "rate_limit_policies.0.name", name),
+					resource.TestCheckResourceAttrSet(datasourceNameImageEffectiveRateLimitList, "effective_rate_limit_policies.#"),
+				),
+			},
+		},
+	})
+}
+
+func testImageRateLimitPolicyV2Config(name, desc string, rateLimit int) string {
+	return fmt.Sprintf(`
+
+		data "nutanix_categories_v2" "categories"{}
+
+		locals {
+			category0 = data.nutanix_categories_v2.categories.categories.0.ext_id
+		}
+
+		resource "nutanix_image_rate_limit_policy_v2" "test" {
+			name            = "%[1]s"
+			description     = "%[2]s"
+			rate_limit_kbps = %[3]d
+			cluster_entity_filter {
+				category_ext_ids = [
+					local.category0,
+				]
+				type = "CATEGORIES_MATCH_ANY"
+			}
+			depends_on = [data.nutanix_categories_v2.categories]
+		}
+`, name, desc, rateLimit)
+}
+
+func testImageRateLimitPolicyDatasourcesV2Config() string {
+	return `
+		data "nutanix_image_rate_limit_policy_v2" "test" {
+			ext_id = nutanix_image_rate_limit_policy_v2.test.ext_id
+		}
+
+		data "nutanix_image_rate_limit_policies_v2" "test" {
+			filter     = "name eq '${nutanix_image_rate_limit_policy_v2.test.name}'"
+			depends_on = [nutanix_image_rate_limit_policy_v2.test]
+		}
+
+		data "nutanix_image_effective_rate_limit_policies_v2" "test" {
+			depends_on = [nutanix_image_rate_limit_policy_v2.test]
+		}
+`
+}
@@ -7,7 +7,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	import2 "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
@@ -27,19 +27,22 @@ func ResourceNutanixImageV4() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
-			sources := []string{"source.0.url_source", "source.0.vm_disk_source", "source.0.object_lite_source"}
-			count := 0
-			for _, s := range sources {
-				if _, ok := d.GetOk(s); ok {
-					count++
+		CustomizeDiff: customdiff.All(
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				sources := []string{"source.0.url_source", "source.0.vm_disk_source", "source.0.object_lite_source"}
+				count := 0
+				for _, s := range sources {
+					if _, ok := d.GetOk(s); ok {
+						count++
+					}
 				}
-			}
-			if count > 1 {
-				return fmt.Errorf("only one of url_source, vm_disk_source, or object_lite_source can be specified in source")
-			}
-			return nil
-		},
+				if count > 1 {
+					return fmt.Errorf("only one of url_source, vm_disk_source, or object_lite_source can be specified in source")
+				}
+				return nil
+			},
+			common.DefaultCategoryExtIDsDiff("category_ext_ids", ""),
+		),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -72,6 +75,19 @@ func ResourceNutanixImageV4() *schema.Resource {
 					},
 				},
 			},
+			// source_path uploads a local file to the image, for sites which cannot serve images over HTTP
+			"source_path": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source"},
+			},
+			// upload_retries restarts the full upload of source_path, the image file API cannot resume an upload
+			"upload_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 			"source": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -229,7 +245,11 @@ func ResourceNutanixImageV4() *schema.Resource {
 }
 
 func ResourceNutanixImageV4Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	if path, ok := d.GetOk("source_path"); ok {
+		return createImageFromPath(ctx, d, meta, path.(string))
+	}
+
+	conn := meta.(*conns.Client).VmmAPI()
 
 	body := &import5.Image{}
 
@@ -270,16 +290,9 @@ func ResourceNutanixImageV4Create(ctx context.Context, d *schema.ResourceData, m
 	TaskRef := resp.Data.GetValue().(import1.TaskReference)
 	taskUUID := TaskRef.ExtId
 
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the image to be created
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutCreate),
-	}
-
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
 		return diag.Errorf("error waiting for image (%s) to create: %s", utils.StringValue(taskUUID), errWaitTask)
 	}
 
@@ -302,7 +315,7 @@ func ResourceNutanixImageV4Create(ctx context.Context, d *schema.ResourceData, m
 }
 
 func ResourceNutanixImageV4Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	resp, err := conn.ImagesAPIInstance.GetImageById(utils.StringPtr(d.Id()))
 	if err != nil {
@@ -367,7 +380,7 @@ func ResourceNutanixImageV4Read(ctx context.Context, d *schema.ResourceData, met
 }
 
 func ResourceNutanixImageV4Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	resp, err := conn.ImagesAPIInstance.GetImageById(utils.StringPtr(d.Id()))
 	if err != nil {
@@ -413,16 +426,9 @@ func ResourceNutanixImageV4Update(ctx context.Context, d *schema.ResourceData, m
 	TaskRef := updateResp.Data.GetValue().(import1.TaskReference)
 	taskUUID := TaskRef.ExtId
 
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the image to be updated
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutUpdate),
-	}
-
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutUpdate)); errWaitTask != nil {
 		return diag.Errorf("error waiting for image (%s) to update: %s", utils.StringValue(taskUUID), errWaitTask)
 	}
 
@@ -430,7 +436,7 @@ func ResourceNutanixImageV4Update(ctx context.Context, d *schema.ResourceData, m
 }
 
 func ResourceNutanixImageV4Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	resp, err := conn.ImagesAPIInstance.DeleteImageById(utils.StringPtr(d.Id()))
 	if err != nil {
@@ -439,16 +445,9 @@ func ResourceNutanixImageV4Delete(ctx context.Context, d *schema.ResourceData, m
 	TaskRef := resp.Data.GetValue().(import1.TaskReference)
 	taskUUID := TaskRef.ExtId
 
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the image to be deleted
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutDelete),
-	}
-
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutDelete)); errWaitTask != nil {
 		return diag.Errorf("error waiting for image (%s) to delete: %s", utils.StringValue(taskUUID), errWaitTask)
 	}
 	return nil
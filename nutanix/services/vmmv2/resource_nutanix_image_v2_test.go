@@ -1,7 +1,10 @@
 package vmmv2_test
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"os"
 	"regexp"
 	"testing"
 
@@ -165,6 +168,42 @@ func TestAccV2NutanixImagesResource_WithMoreThanOneSource(t *testing.T) {
 	})
 }
 
+func TestAccV2NutanixImagesResource_WithSourcePath(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("test-image-%d", r)
+	desc := "test image uploaded from a local file"
+
+	// a raw disk image of 1 MiB
+	imagePath := t.TempDir() + "/disk.raw"
+	content := make([]byte, 1024*1024)
+	if err := os.WriteFile(imagePath, content, 0o600); err != nil {
+		t.Fatalf("failed to write image file: %s", err)
+	}
+	digest := sha256.Sum256(content)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			// a wrong checksum fails before the image is created
+			{
+				Config:      testImagesV2ConfigWithSourcePath(name, desc, imagePath, "sha256", "0000"),
+				ExpectError: regexp.MustCompile("sha256 checksum of .* is .*, expected 0000"),
+			},
+			{
+				Config: testImagesV2ConfigWithSourcePath(name, desc, imagePath, "sha256", hex.EncodeToString(digest[:])),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceNameImage, "ext_id"),
+					resource.TestCheckResourceAttr(resourceNameImage, "name", name),
+					resource.TestCheckResourceAttr(resourceNameImage, "type", "DISK_IMAGE"),
+					resource.TestCheckResourceAttr(resourceNameImage, "source_path", imagePath),
+					resource.TestCheckResourceAttr(resourceNameImage, "size_bytes", fmt.Sprint(len(content))),
+				),
+			},
+		},
+	})
+}
+
 func testImagesV2Config(name, desc string) string {
 	return fmt.Sprintf(`
 		resource "nutanix_images_v2" "test" {
@@ -287,3 +326,19 @@ resource "nutanix_images_v2" "test" {
 }
 `
 }
+
+func testImagesV2ConfigWithSourcePath(name, desc, imagePath, checksumType, digest string) string {
+	return fmt.Sprintf(`
+		resource "nutanix_images_v2" "test" {
+			name        = "%[1]s"
+			description = "%[2]s"
+			type        = "DISK_IMAGE"
+			source_path = "%[3]s"
+			checksum {
+				object_type = "%[4]s"
+				hex_digest  = "%[5]s"
+			}
+			upload_retries = 2
+		}
+`, name, desc, imagePath, checksumType, digest)
+}
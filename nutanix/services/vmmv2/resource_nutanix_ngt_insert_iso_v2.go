@@ -7,7 +7,6 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	taskPoll "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
@@ -100,7 +99,7 @@ func ResourceNutanixNGTInsertIsoV2() *schema.Resource {
 
 // Install NGT on Vm
 func ResourceNutanixNGTInsertIsoV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	if action, ok := d.GetOk("action"); ok && action.(string) == "insert" {
 		extID := d.Get("ext_id")
@@ -152,16 +151,9 @@ func ResourceNutanixNGTInsertIsoV2Create(ctx context.Context, d *schema.Resource
 		TaskRef := resp.Data.GetValue().(vmmPrism.TaskReference)
 		taskUUID := TaskRef.ExtId
 
-		taskconn := meta.(*conns.Client).PrismAPI
+		taskconn := meta.(*conns.Client).PrismAPI()
 		// Wait for the NGT ISO to be inserted
-		stateConf := &resource.StateChangeConf{
-			Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-			Target:  []string{"SUCCEEDED"},
-			Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-			Timeout: d.Timeout(schema.TimeoutCreate),
-		}
-
-		if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+		if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
 			return diag.Errorf("error waiting for NGT ISO insert (%s) to complete: %s", utils.StringValue(taskUUID), errWaitTask)
 		}
 
@@ -192,7 +184,7 @@ func ResourceNutanixNGTInsertIsoV2Create(ctx context.Context, d *schema.Resource
 
 // Read NGT Configuration
 func ResourceNutanixNGTInsertIsoV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	extID := d.Get("ext_id").(string)
 	resp, err := conn.VMAPIInstance.GetGuestToolsById(utils.StringPtr(extID))
@@ -292,7 +284,7 @@ func ResourceNutanixNGTInsertIsoV2Delete(ctx context.Context, d *schema.Resource
 
 func ejectCdromISO(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Ejecting ISO from the CD-ROM %s of the VM %s", d.Get("cdrom_ext_id").(string), d.Get("vm_ext_id").(string))
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 	vmExtID := d.Get("vm_ext_id").(string)
 	extID := d.Get("cdrom_ext_id").(string)
 
@@ -318,17 +310,10 @@ func ejectCdromISO(ctx context.Context, d *schema.ResourceData, meta interface{}
 		TaskRef := resp.Data.GetValue().(vmmPrism.TaskReference)
 		taskUUID := TaskRef.ExtId
 
-		taskconn := meta.(*conns.Client).PrismAPI
+		taskconn := meta.(*conns.Client).PrismAPI()
 
 		// Wait for the CD-ROM to be ejected
-		stateConf := &resource.StateChangeConf{
-			Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-			Target:  []string{"SUCCEEDED"},
-			Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-			Timeout: d.Timeout(schema.TimeoutDelete),
-		}
-
-		if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+		if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutDelete)); errWaitTask != nil {
 			// Retry only for the known ETag mismatch failure mode.
 			if attempt < maxAttempts && isVmmEtagMismatchErr(errWaitTask) {
 				log.Printf("[DEBUG] ISO EJECTION failed due to VM ETag mismatch (attempt %d/%d). Retrying with refreshed ETag. Task UUID: %s, error: %s",
@@ -9,7 +9,6 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	taskPoll "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
@@ -165,7 +164,7 @@ func ResourceNutanixNGTInstallationV2() *schema.Resource {
 
 // ResourceNutanixNGTInstallationV4Create Install NGT on Vm
 func ResourceNutanixNGTInstallationV4Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	vmmExtID := utils.StringPtr(d.Get("ext_id").(string))
 
@@ -245,16 +244,9 @@ func ResourceNutanixNGTInstallationV4Create(ctx context.Context, d *schema.Resou
 	TaskRef := installResp.Data.GetValue().(vmmPrism.TaskReference)
 	taskUUID := TaskRef.ExtId
 
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the NGT to be installed
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutCreate),
-	}
-
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
 		return diag.Errorf("error waiting for NGT installation (%s) to complete: %s", utils.StringValue(taskUUID), errWaitTask)
 	}
 
@@ -282,7 +274,7 @@ func ResourceNutanixNGTInstallationV4Create(ctx context.Context, d *schema.Resou
 
 // ResourceNutanixNGTInstallationV4Read Read NGT Configuration
 func ResourceNutanixNGTInstallationV4Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	extID := d.Id()
 	resp, err := conn.VMAPIInstance.GetGuestToolsById(utils.StringPtr(extID))
@@ -326,7 +318,7 @@ func ResourceNutanixNGTInstallationV4Read(ctx context.Context, d *schema.Resourc
 
 // ResourceNutanixNGTInstallationV4Update Update NGT Configuration
 func ResourceNutanixNGTInstallationV4Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	extID := d.Get("ext_id").(string)
 
@@ -388,16 +380,9 @@ func ResourceNutanixNGTInstallationV4Update(ctx context.Context, d *schema.Resou
 	TaskRef := resp.Data.GetValue().(vmmPrism.TaskReference)
 	taskUUID := TaskRef.ExtId
 
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the NGT to be updated
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutUpdate),
-	}
-
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutUpdate)); errWaitTask != nil {
 		return diag.Errorf("error waiting for NGT update (%s) to complete: %s", utils.StringValue(taskUUID), errWaitTask)
 	}
 
@@ -406,11 +391,11 @@ func ResourceNutanixNGTInstallationV4Update(ctx context.Context, d *schema.Resou
 
 // ResourceNutanixNGTInstallationV4Delete Uninstall NGT from Vm
 func ResourceNutanixNGTInstallationV4Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	extID := d.Get("ext_id").(string)
 
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 
 	// This operation is async. Under cluster load (or during parallel destroys), the task can
 	// sit queued and the VM ETag can change before it actually starts, leading to VM_ETAG_MISMATCH.
@@ -433,14 +418,7 @@ func ResourceNutanixNGTInstallationV4Delete(ctx context.Context, d *schema.Resou
 		TaskRef := resp.Data.GetValue().(vmmPrism.TaskReference)
 		taskUUID = TaskRef.ExtId
 
-		stateConf := &resource.StateChangeConf{
-			Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-			Target:  []string{"SUCCEEDED"},
-			Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-			Timeout: d.Timeout(schema.TimeoutDelete),
-		}
-
-		if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+		if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutDelete)); errWaitTask != nil {
 			if attempt < maxAttempts && isVmmEtagMismatchErr(errWaitTask) {
 				log.Printf("[DEBUG] NGT uninstall failed due to VM ETag mismatch (attempt %d/%d). Retrying with refreshed ETag. Task UUID: %s, error: %s",
 					attempt, maxAttempts, utils.StringValue(taskUUID), errWaitTask)
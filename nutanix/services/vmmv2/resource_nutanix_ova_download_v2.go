@@ -32,7 +32,7 @@ func ResourceNutanixOvaDownloadV2() *schema.Resource {
 }
 
 func ResourceNutanixOvaDownloadV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	ovaExtID := d.Get("ova_ext_id")
 	resp, err := conn.OvasAPIInstance.GetFileByOvaId(utils.StringPtr(ovaExtID.(string)))
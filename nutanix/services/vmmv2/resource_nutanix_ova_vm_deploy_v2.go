@@ -8,7 +8,6 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	import4 "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
@@ -448,7 +447,7 @@ func ResourceNutanixOvaVMDeploymentV2() *schema.Resource {
 }
 
 func ResourceNutanixOvaVMDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	extID := d.Get("ext_id").(string)
 	vmDeploymentSpec := &import1.OvaDeploymentSpec{}
@@ -501,15 +500,9 @@ func ResourceNutanixOvaVMDeploymentCreate(ctx context.Context, d *schema.Resourc
 	taskUUID := TaskRef.ExtId
 	log.Printf("[DEBUG] OVA deployment task started with UUID: %s", utils.StringValue(taskUUID))
 
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the OVA VM to be deployed
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutCreate),
-	}
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
 		return diag.Errorf("error waiting for OVA VM deployment (%s) to complete: %s", utils.StringValue(taskUUID), errWaitTask)
 	}
 
@@ -597,7 +590,7 @@ func ResourceNutanixOvaVMDeploymentCreate(ctx context.Context, d *schema.Resourc
 }
 
 func ResourceNutanixOvaVMDeploymentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	resp, err := conn.VMAPIInstance.GetVmById(utils.StringPtr(d.Id()))
 	if err != nil {
@@ -609,7 +602,7 @@ func ResourceNutanixOvaVMDeploymentRead(ctx context.Context, d *schema.ResourceD
 }
 
 func ResourceNutanixOvaVMDeploymentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	log.Printf("[DEBUG] starting OVA VM update for VM ID: %s", d.Id())
 
@@ -678,6 +671,11 @@ func ResourceNutanixOvaVMDeploymentUpdate(ctx context.Context, d *schema.Resourc
 		if err := ApplyDiskDeletions(ctx, d, meta, conn, d.Id(), oldDeletedDisk, expandDiskFn); err != nil {
 			return err
 		}
+		// disks moved to another storage container are migrated in place rather than re-created
+		updatedDisk, diags := ApplyDiskMigrations(ctx, d, meta, conn, d.Id(), oldDisks, updatedDisk)
+		if diags.HasError() {
+			return diags
+		}
 		if err := ApplyDiskUpdates(ctx, d, meta, conn, d.Id(), updatedDisk, expandDiskFn); err != nil {
 			return err
 		}
@@ -716,7 +714,7 @@ func ResourceNutanixOvaVMDeploymentUpdate(ctx context.Context, d *schema.Resourc
 }
 
 func ResourceNutanixOvaVMDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	readResp, err := conn.VMAPIInstance.GetVmById(utils.StringPtr(d.Id()))
 	if err != nil {
@@ -821,15 +819,9 @@ func setOvaVMConfig(d *schema.ResourceData, vm import2.Vm) diag.Diagnostics {
 
 // waitForTask waits for a Nutanix task to complete
 func waitForTask(ctx context.Context, d *schema.ResourceData, meta interface{}, taskUUID *string, timeoutType string, operation string) diag.Diagnostics {
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(timeoutType),
-	}
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(timeoutType)); errWaitTask != nil {
 		return diag.Errorf("error waiting for %s (%s): %s", operation, utils.StringValue(taskUUID), errWaitTask)
 	}
 
@@ -859,7 +851,7 @@ func handlePowerStateChanges(ctx context.Context, d *schema.ResourceData, meta i
 	if oldPowerState != newPowerState && newPowerState != "" && !hotPlugRequired {
 		log.Printf("[DEBUG] Handling power state change from '%s' to '%s'", oldPowerState, newPowerState)
 
-		vmmConn := conn.(*conns.Client).VmmAPI
+		vmmConn := conn.(*conns.Client).VmmAPI()
 		readResp, err := vmmConn.VMAPIInstance.GetVmById(utils.StringPtr(d.Id()))
 		if err != nil {
 			return diag.Errorf("error reading VM for power state change: %v", err)
@@ -903,7 +895,7 @@ func handleVMConfigurationUpdates(ctx context.Context, d *schema.ResourceData, m
 		return nil
 	}
 
-	vmmConn := conn.(*conns.Client).VmmAPI
+	vmmConn := conn.(*conns.Client).VmmAPI()
 	updatedVMResp, err := vmmConn.VMAPIInstance.GetVmById(utils.StringPtr(d.Id()))
 	if err != nil {
 		return diag.Errorf("error reading VM for update: %v", err)
@@ -0,0 +1,114 @@
+package vmmv2
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	vmmProsmConfig "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/prism/v4/config"
+	vmmContent "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/vmm/v4/content"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// ResourceNutanixTemplateActiveVersionV2 sets the active version of a template, the version VMs are
+// deployed from by default. Changing the version rolls the template forward or back.
+func ResourceNutanixTemplateActiveVersionV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceNutanixTemplateActiveVersionV2Create,
+		ReadContext:   ResourceNutanixTemplateActiveVersionV2Read,
+		UpdateContext: ResourceNutanixTemplateActiveVersionV2Update,
+		DeleteContext: ResourceNutanixTemplateActiveVersionV2Delete,
+		Schema: map[string]*schema.Schema{
+			"template_ext_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func ResourceNutanixTemplateActiveVersionV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := publishTemplateVersion(ctx, d, meta, schema.TimeoutCreate); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(d.Get("template_ext_id").(string))
+	return ResourceNutanixTemplateActiveVersionV2Read(ctx, d, meta)
+}
+
+// ResourceNutanixTemplateActiveVersionV2Read reads the active version of the template, so that
+// activating another version outside of terraform shows as a change.
+func ResourceNutanixTemplateActiveVersionV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VmmAPI()
+
+	versions, err := listTemplateVersions(conn, d.Id())
+	if err != nil {
+		return diag.Errorf("error while fetching template versions : %v", err)
+	}
+	for _, version := range versions {
+		if utils.BoolValue(version.IsActiveVersion) {
+			if err := d.Set("version_id", utils.StringValue(version.ExtId)); err != nil {
+				return diag.FromErr(err)
+			}
+			return nil
+		}
+	}
+	log.Printf("[WARN] template %s has no active version", d.Id())
+	return nil
+}
+
+func ResourceNutanixTemplateActiveVersionV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := publishTemplateVersion(ctx, d, meta, schema.TimeoutUpdate); diags.HasError() {
+		return diags
+	}
+	return ResourceNutanixTemplateActiveVersionV2Read(ctx, d, meta)
+}
+
+// ResourceNutanixTemplateActiveVersionV2Delete leaves the active version of the template as it is.
+func ResourceNutanixTemplateActiveVersionV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func publishTemplateVersion(ctx context.Context, d *schema.ResourceData, meta interface{}, timeoutKey string) diag.Diagnostics {
+	conn := meta.(*conns.Client).VmmAPI()
+	templateExtID := d.Get("template_ext_id").(string)
+
+	readResp, err := conn.TemplatesAPIInstance.GetTemplateById(utils.StringPtr(templateExtID))
+	if err != nil {
+		return diag.Errorf("error while fetching template : %v", err)
+	}
+
+	// Extract E-Tag Header
+	args := make(map[string]interface{})
+	args["If-Match"] = getEtagHeader(readResp, conn)
+
+	body := &vmmContent.TemplatePublishSpec{
+		VersionId: utils.StringPtr(d.Get("version_id").(string)),
+	}
+
+	aJSON, _ := json.MarshalIndent(body, "", "  ")
+	log.Printf("[DEBUG] Template publish request body :\n %s", string(aJSON))
+
+	resp, err := conn.TemplatesAPIInstance.PublishTemplate(utils.StringPtr(templateExtID), body, args)
+	if err != nil {
+		return diag.Errorf("error while activating template version : %v", err)
+	}
+	TaskRef := resp.Data.GetValue().(vmmProsmConfig.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	taskconn := meta.(*conns.Client).PrismAPI()
+	// Wait for the template version to be activated
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(timeoutKey)); errWaitTask != nil {
+		return diag.Errorf("error waiting for template version (%s) to activate: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+	return nil
+}
@@ -3,11 +3,12 @@ package vmmv2
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log"
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	import2 "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
 	import4 "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/common/v1/config"
@@ -83,8 +84,6 @@ func ResourceNutanixTemplateDeployV2() *schema.Resource {
 }
 
 func ResourceNutanixTemplateDeployV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
-
 	extID := d.Get("ext_id")
 	body := &import5.TemplateDeployment{}
 
@@ -103,38 +102,7 @@ func ResourceNutanixTemplateDeployV2Create(ctx context.Context, d *schema.Resour
 
 	aJSON, _ := json.MarshalIndent(body, "", "  ")
 	log.Printf("[DEBUG] Payload to deploy template: %s", string(aJSON))
-	resp, err := conn.TemplatesAPIInstance.DeployTemplate(utils.StringPtr(extID.(string)), body)
-	if err != nil {
-		return diag.Errorf("error while deploying template : %v", err)
-	}
-
-	TaskRef := resp.Data.GetValue().(import1.TaskReference)
-	taskUUID := TaskRef.ExtId
-
-	taskconn := meta.(*conns.Client).PrismAPI
-	// Wait for the template to be deployed
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutCreate),
-	}
-
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
-		return diag.Errorf("error waiting for template deploy (%s) to complete: %s", utils.StringValue(taskUUID), errWaitTask)
-	}
-
-	// Get UUID from TASK API
-	taskResp, err := taskconn.TaskRefAPI.GetTaskById(taskUUID, nil)
-	if err != nil {
-		return diag.Errorf("error while fetching template deploy task (%s): %v", utils.StringValue(taskUUID), err)
-	}
-	taskDetails := taskResp.Data.GetValue().(import2.Task)
-
-	aJSON, _ = json.MarshalIndent(taskDetails, "", " ")
-	log.Printf("[DEBUG] Template Deploy Task Details: %s", string(aJSON))
-
-	uuid, err := common.ExtractEntityUUIDFromTask(taskDetails, utils.RelEntityTypeVM, "VM")
+	uuid, err := deployTemplate(ctx, meta, extID.(string), body, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -155,6 +123,38 @@ func ResourceNutanixTemplateDeployV2Delete(ctx context.Context, d *schema.Resour
 	return nil
 }
 
+// deployTemplate deploys the VMs of body from the template templateExtID, waits for the deploy task and
+// returns the ext_id of a deployed VM
+func deployTemplate(ctx context.Context, meta interface{}, templateExtID string, body *import5.TemplateDeployment, timeout time.Duration) (*string, error) {
+	conn := meta.(*conns.Client).VmmAPI()
+
+	resp, err := conn.TemplatesAPIInstance.DeployTemplate(utils.StringPtr(templateExtID), body)
+	if err != nil {
+		return nil, fmt.Errorf("error while deploying template : %v", err)
+	}
+
+	TaskRef := resp.Data.GetValue().(import1.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	taskconn := meta.(*conns.Client).PrismAPI()
+	// Wait for the template to be deployed
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), timeout); errWaitTask != nil {
+		return nil, fmt.Errorf("error waiting for template deploy (%s) to complete: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+
+	// Get UUID from TASK API
+	taskResp, err := taskconn.TaskRefAPI.GetTaskById(taskUUID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching template deploy task (%s): %v", utils.StringValue(taskUUID), err)
+	}
+	taskDetails := taskResp.Data.GetValue().(import2.Task)
+
+	aJSON, _ := json.MarshalIndent(taskDetails, "", " ")
+	log.Printf("[DEBUG] Template Deploy Task Details: %s", string(aJSON))
+
+	return common.ExtractEntityUUIDFromTask(taskDetails, utils.RelEntityTypeVM, "VM")
+}
+
 func expandVMConfigOverride(pr interface{}, d *schema.ResourceData) map[string]import5.VmConfigOverride {
 	if len(pr.([]interface{})) > 0 {
 		// vmcfg := import5.VmConfigOverride{}
@@ -6,7 +6,6 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	import2 "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
@@ -55,8 +54,8 @@ func ResourceNutanixTemplateActionsV2() *schema.Resource {
 }
 
 func ResourceNutanixTemplateActionsV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
-	taskconn := meta.(*conns.Client).PrismAPI
+	conn := meta.(*conns.Client).VmmAPI()
+	taskconn := meta.(*conns.Client).PrismAPI()
 
 	extID := d.Get("ext_id").(string)
 	action := d.Get("action").(string)
@@ -76,14 +75,7 @@ func ResourceNutanixTemplateActionsV2Create(ctx context.Context, d *schema.Resou
 		taskUUID = TaskRef.ExtId
 
 		// Wait for the guest OS update to be initiated
-		stateConf := &resource.StateChangeConf{
-			Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-			Target:  []string{"SUCCEEDED"},
-			Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-			Timeout: d.Timeout(schema.TimeoutCreate),
-		}
-
-		if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+		if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
 			return diag.Errorf("error waiting for guest OS update initiation (%s) to complete: %s", utils.StringValue(taskUUID), errWaitTask)
 		}
 	}
@@ -106,14 +98,7 @@ func ResourceNutanixTemplateActionsV2Create(ctx context.Context, d *schema.Resou
 		taskUUID = TaskRef.ExtId
 
 		// Wait for the guest OS update to complete
-		stateConf := &resource.StateChangeConf{
-			Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-			Target:  []string{"SUCCEEDED"},
-			Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-			Timeout: d.Timeout(schema.TimeoutCreate),
-		}
-
-		if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+		if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
 			return diag.Errorf("error waiting for guest OS update completion (%s) to finish: %s", utils.StringValue(taskUUID), errWaitTask)
 		}
 	}
@@ -127,14 +112,7 @@ func ResourceNutanixTemplateActionsV2Create(ctx context.Context, d *schema.Resou
 		taskUUID = TaskRef.ExtId
 
 		// Wait for the guest OS update to be cancelled
-		stateConf := &resource.StateChangeConf{
-			Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-			Target:  []string{"SUCCEEDED"},
-			Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-			Timeout: d.Timeout(schema.TimeoutCreate),
-		}
-
-		if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+		if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
 			return diag.Errorf("error waiting for guest OS update cancellation (%s) to complete: %s", utils.StringValue(taskUUID), errWaitTask)
 		}
 	}
@@ -6,7 +6,6 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	prismConfig "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
@@ -75,7 +74,7 @@ func ResourceNutanixTemplatesV2() *schema.Resource {
 							Computed: true,
 							Elem:     schemaForTemplateUser(),
 						},
-						"version_source": schemaForVersionSource(),
+						"version_source": schemaForVersionSource("template_version_spec.0.version_source"),
 						"version_source_discriminator": {
 							Type:     schema.TypeString,
 							Optional: true,
@@ -140,6 +139,12 @@ func ResourceNutanixTemplatesV2() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			// number of newest versions kept, older ones but the active version are deleted after create and update
+			"retain_versions": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"tenant_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -150,7 +155,7 @@ func ResourceNutanixTemplatesV2() *schema.Resource {
 }
 
 func ResourceNutanixTemplatesV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 	body := vmmContent.NewTemplate()
 
 	if name, ok := d.GetOk("template_name"); ok {
@@ -216,16 +221,9 @@ func ResourceNutanixTemplatesV2Create(ctx context.Context, d *schema.ResourceDat
 	TaskRef := resp.Data.GetValue().(vmmProsmConfig.TaskReference)
 	taskUUID := TaskRef.ExtId
 
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the template to be created
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutCreate),
-	}
-
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
 		return diag.Errorf("error waiting for template (%s) to create: %s", utils.StringValue(taskUUID), errWaitTask)
 	}
 
@@ -244,11 +242,15 @@ func ResourceNutanixTemplatesV2Create(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 	d.SetId(utils.StringValue(uuid))
+
+	if diags := retainTemplateVersions(ctx, d, meta); diags.HasError() {
+		return diags
+	}
 	return ResourceNutanixTemplatesV2Read(ctx, d, meta)
 }
 
 func ResourceNutanixTemplatesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 	tempVersionSpecData := d.Get("template_version_spec").([]interface{})
 	log.Printf("[DEBUG] tempVersionSpecData: %v", tempVersionSpecData)
 	resp, err := conn.TemplatesAPIInstance.GetTemplateById(utils.StringPtr(d.Id()))
@@ -318,7 +320,15 @@ func ResourceNutanixTemplatesV2Read(ctx context.Context, d *schema.ResourceData,
 }
 
 func ResourceNutanixTemplatesV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
+
+	// changing retain_versions alone only prunes the versions
+	if !d.HasChangeExcept("retain_versions") {
+		if diags := retainTemplateVersions(ctx, d, meta); diags.HasError() {
+			return diags
+		}
+		return ResourceNutanixTemplatesV2Read(ctx, d, meta)
+	}
 
 	readResp, err := conn.TemplatesAPIInstance.GetTemplateById(utils.StringPtr(d.Id()))
 	if err != nil {
@@ -429,23 +439,20 @@ func ResourceNutanixTemplatesV2Update(ctx context.Context, d *schema.ResourceDat
 	TaskRef := respUpdate.Data.GetValue().(vmmProsmConfig.TaskReference)
 	taskUUID := TaskRef.ExtId
 
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the template to be updated
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutUpdate),
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutUpdate)); errWaitTask != nil {
+		return diag.Errorf("error waiting for template (%s) to update: %s", utils.StringValue(taskUUID), errWaitTask)
 	}
 
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
-		return diag.Errorf("error waiting for template (%s) to update: %s", utils.StringValue(taskUUID), errWaitTask)
+	if diags := retainTemplateVersions(ctx, d, meta); diags.HasError() {
+		return diags
 	}
 	return ResourceNutanixTemplatesV2Read(ctx, d, meta)
 }
 
 func ResourceNutanixTemplatesV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	resp, err := conn.TemplatesAPIInstance.DeleteTemplateById(utils.StringPtr(d.Id()))
 	if err != nil {
@@ -454,21 +461,23 @@ func ResourceNutanixTemplatesV2Delete(ctx context.Context, d *schema.ResourceDat
 	TaskRef := resp.Data.GetValue().(vmmProsmConfig.TaskReference)
 	taskUUID := TaskRef.ExtId
 
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the template to be deleted
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutDelete),
-	}
-
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutDelete)); errWaitTask != nil {
 		return diag.Errorf("error waiting for template (%s) to delete: %s", utils.StringValue(taskUUID), errWaitTask)
 	}
 	return nil
 }
 
+// retainTemplateVersions prunes the versions of the template beyond retain_versions, when it is set
+func retainTemplateVersions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	retain, ok := d.GetOk("retain_versions")
+	if !ok {
+		return nil
+	}
+	return pruneTemplateVersions(ctx, d, meta, d.Id(), retain.(int))
+}
+
 // Schema's functions
 func schemaForLinks() *schema.Schema {
 	return &schema.Schema{
@@ -1389,7 +1398,10 @@ func schemaForTemplateVMSpec() *schema.Schema {
 	}
 }
 
-func schemaForVersionSource() *schema.Schema {
+// schemaForVersionSource returns the schema of the version source of a template version, path being the
+// path of the version source attribute.
+func schemaForVersionSource(path string) *schema.Schema {
+	exactlyOneOf := []string{path + ".0.template_version_reference", path + ".0.template_vm_reference"}
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Required: true,
@@ -1401,7 +1413,7 @@ func schemaForVersionSource() *schema.Schema {
 					Optional:     true,
 					Computed:     true,
 					MaxItems:     1,
-					ExactlyOneOf: []string{"template_version_spec.0.version_source.0.template_version_reference", "template_version_spec.0.version_source.0.template_vm_reference"},
+					ExactlyOneOf: exactlyOneOf,
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							"ext_id": {
@@ -1417,7 +1429,7 @@ func schemaForVersionSource() *schema.Schema {
 					Optional:     true,
 					Computed:     true,
 					MaxItems:     1,
-					ExactlyOneOf: []string{"template_version_spec.0.version_source.0.template_version_reference", "template_version_spec.0.version_source.0.template_vm_reference"},
+					ExactlyOneOf: exactlyOneOf,
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							"version_id": {
@@ -227,7 +227,7 @@ func TestAccV2NutanixTemplateResource_GuestCustomizationCloudInit(t *testing.T)
 func testTemplateV2CheckDestroy(state *terraform.State) error {
 	fmt.Println("testTemplateV2CheckDestroy")
 	conn := acc.TestAccProvider.Meta().(*conns.Client)
-	client := conn.VmmAPI.TemplatesAPIInstance
+	client := conn.VmmAPI().TemplatesAPIInstance
 	for _, rs := range state.RootModule().Resources {
 		if rs.Type != resourceNameTemplate {
 			continue
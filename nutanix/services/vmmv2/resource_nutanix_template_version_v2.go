@@ -0,0 +1,277 @@
+package vmmv2
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	vmmProsmConfig "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/prism/v4/config"
+	vmmContent "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/vmm/v4/content"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v4/vmm"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// templateVersionsPageLimit is the number of template versions listed per page
+const templateVersionsPageLimit = 100
+
+// ResourceNutanixTemplateVersionV2 manages a version of a template. The version is created from a VM or
+// from another version of the template, and deleted with the resource.
+func ResourceNutanixTemplateVersionV2() *schema.Resource {
+	versionSource := schemaForVersionSource("version_source")
+	versionSource.ForceNew = true
+
+	return &schema.Resource{
+		CreateContext: ResourceNutanixTemplateVersionV2Create,
+		ReadContext:   ResourceNutanixTemplateVersionV2Read,
+		DeleteContext: ResourceNutanixTemplateVersionV2Delete,
+		Schema: map[string]*schema.Schema{
+			"template_ext_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version_description": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version_source": versionSource,
+			// the active version of the template changes as other versions are activated, so it is only
+			// set on creation and changes to it are suppressed afterwards
+			"is_active_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != ""
+				},
+			},
+			"is_gc_override_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"ext_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"links":   schemaForLinks(),
+			"vm_spec": schemaForTemplateVMSpec(),
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     schemaForTemplateUser(),
+			},
+		},
+	}
+}
+
+func ResourceNutanixTemplateVersionV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VmmAPI()
+	templateExtID := d.Get("template_ext_id").(string)
+	versionName := d.Get("version_name").(string)
+
+	readResp, err := conn.TemplatesAPIInstance.GetTemplateById(utils.StringPtr(templateExtID))
+	if err != nil {
+		return diag.Errorf("error while fetching template : %v", err)
+	}
+
+	// Extract E-Tag Header
+	args := make(map[string]interface{})
+	args["If-Match"] = getEtagHeader(readResp, conn)
+
+	versionSpec := &vmmContent.TemplateVersionSpec{
+		VersionName:        utils.StringPtr(versionName),
+		VersionDescription: utils.StringPtr(d.Get("version_description").(string)),
+		VersionSource:      expandTemplateVersionSpecVersionSource(d.Get("version_source")),
+		IsActiveVersion:    utils.BoolPtr(d.Get("is_active_version").(bool)),
+	}
+	if isGcOverride, ok := d.GetOkExists("is_gc_override_enabled"); ok { //nolint:staticcheck
+		versionSpec.IsGcOverrideEnabled = utils.BoolPtr(isGcOverride.(bool))
+	}
+	body := &vmmContent.Template{
+		ExtId:               utils.StringPtr(templateExtID),
+		TemplateVersionSpec: versionSpec,
+	}
+
+	aJSON, _ := json.MarshalIndent(body, "", "  ")
+	log.Printf("[DEBUG] Template version create request body :\n %s", string(aJSON))
+
+	resp, err := conn.TemplatesAPIInstance.UpdateTemplateById(utils.StringPtr(templateExtID), body, args)
+	if err != nil {
+		return diag.Errorf("error while creating template version : %v", err)
+	}
+	TaskRef := resp.Data.GetValue().(vmmProsmConfig.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	taskconn := meta.(*conns.Client).PrismAPI()
+	// Wait for the template version to be created
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
+		return diag.Errorf("error waiting for template version (%s) to create: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+
+	// the task does not report the version it creates, it is the latest version with the name
+	versions, err := listTemplateVersions(conn, templateExtID)
+	if err != nil {
+		return diag.Errorf("error while fetching template versions : %v", err)
+	}
+	var created *vmmContent.TemplateVersionSpec
+	for i, version := range versions {
+		if utils.StringValue(version.VersionName) != versionName || version.CreateTime == nil {
+			continue
+		}
+		if created == nil || version.CreateTime.After(*created.CreateTime) {
+			created = &versions[i]
+		}
+	}
+	if created == nil {
+		return diag.Errorf("template version %s of template %s not found after task %s", versionName, templateExtID, utils.StringValue(taskUUID))
+	}
+
+	d.SetId(utils.StringValue(created.ExtId))
+	return ResourceNutanixTemplateVersionV2Read(ctx, d, meta)
+}
+
+func ResourceNutanixTemplateVersionV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VmmAPI()
+
+	resp, err := conn.TemplatesAPIInstance.GetTemplateVersionById(utils.StringPtr(d.Get("template_ext_id").(string)), utils.StringPtr(d.Id()))
+	if err != nil {
+		// the version may have been deleted outside of terraform, e.g. pruned by retain_versions of the template
+		if isVmmNotFoundErr(err) {
+			log.Printf("[WARN] template version %s not found, removing it from the state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error while fetching template version : %v", err)
+	}
+	getResp := resp.Data.GetValue().(vmmContent.TemplateVersionSpec)
+
+	return setTemplateVersion(d, &getResp)
+}
+
+func ResourceNutanixTemplateVersionV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VmmAPI()
+
+	resp, err := conn.TemplatesAPIInstance.DeleteTemplateVersionById(utils.StringPtr(d.Get("template_ext_id").(string)), utils.StringPtr(d.Id()))
+	if err != nil {
+		return diag.Errorf("error while deleting template version : %v", err)
+	}
+	TaskRef := resp.Data.GetValue().(vmmProsmConfig.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	taskconn := meta.(*conns.Client).PrismAPI()
+	// Wait for the template version to be deleted
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutDelete)); errWaitTask != nil {
+		return diag.Errorf("error waiting for template version (%s) to delete: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+	return nil
+}
+
+// setTemplateVersion sets the attributes of a template version
+func setTemplateVersion(d *schema.ResourceData, version *vmmContent.TemplateVersionSpec) diag.Diagnostics {
+	flattened := flattenTemplateVersionSpec(version)
+	if len(flattened) == 0 {
+		return nil
+	}
+	for key, value := range flattened[0] {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+// listTemplateVersions returns all the versions of the template
+func listTemplateVersions(conn *vmm.Client, templateExtID string) ([]vmmContent.TemplateVersionSpec, error) {
+	versions := make([]vmmContent.TemplateVersionSpec, 0)
+	for page := 0; ; page++ {
+		resp, err := conn.TemplatesAPIInstance.ListTemplateVersions(utils.StringPtr(templateExtID),
+			utils.IntPtr(page), utils.IntPtr(templateVersionsPageLimit), nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Data == nil {
+			return versions, nil
+		}
+		pageVersions, ok := resp.Data.GetValue().([]vmmContent.TemplateVersionSpec)
+		if !ok {
+			return versions, nil
+		}
+		versions = append(versions, pageVersions...)
+		if len(pageVersions) < templateVersionsPageLimit {
+			return versions, nil
+		}
+	}
+}
+
+// pruneTemplateVersions deletes the oldest versions of the template but the retain newest ones. The
+// active version is never deleted.
+func pruneTemplateVersions(ctx context.Context, d *schema.ResourceData, meta interface{}, templateExtID string, retain int) diag.Diagnostics {
+	conn := meta.(*conns.Client).VmmAPI()
+
+	versions, err := listTemplateVersions(conn, templateExtID)
+	if err != nil {
+		return diag.Errorf("error while fetching template versions : %v", err)
+	}
+
+	for _, version := range templateVersionsToPrune(versions, retain) {
+		log.Printf("[INFO] deleting version %s (%s) of template %s to retain %d versions",
+			utils.StringValue(version.ExtId), utils.StringValue(version.VersionName), templateExtID, retain)
+
+		resp, err := conn.TemplatesAPIInstance.DeleteTemplateVersionById(utils.StringPtr(templateExtID), version.ExtId)
+		if err != nil {
+			return diag.Errorf("error while deleting template version %s : %v", utils.StringValue(version.ExtId), err)
+		}
+		TaskRef := resp.Data.GetValue().(vmmProsmConfig.TaskReference)
+		taskUUID := TaskRef.ExtId
+
+		taskconn := meta.(*conns.Client).PrismAPI()
+		// Wait for the template version to be deleted
+		if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutUpdate)); errWaitTask != nil {
+			return diag.Errorf("error waiting for template version (%s) to delete: %s", utils.StringValue(taskUUID), errWaitTask)
+		}
+	}
+	return nil
+}
+
+// templateVersionsToPrune returns the versions beyond the retain newest ones, except the active version
+func templateVersionsToPrune(versions []vmmContent.TemplateVersionSpec, retain int) []vmmContent.TemplateVersionSpec {
+	sorted := make([]vmmContent.TemplateVersionSpec, len(versions))
+	copy(sorted, versions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].CreateTime == nil || sorted[j].CreateTime == nil {
+			return sorted[j].CreateTime == nil && sorted[i].CreateTime != nil
+		}
+		return sorted[i].CreateTime.After(*sorted[j].CreateTime)
+	})
+
+	pruned := make([]vmmContent.TemplateVersionSpec, 0)
+	for i, version := range sorted {
+		if i < retain || utils.BoolValue(version.IsActiveVersion) {
+			continue
+		}
+		pruned = append(pruned, version)
+	}
+	return pruned
+}
@@ -0,0 +1,86 @@
+package vmmv2
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	vmmContent "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/vmm/v4/content"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func testTemplateVersion(extID string, created *time.Time, active bool) vmmContent.TemplateVersionSpec {
+	return vmmContent.TemplateVersionSpec{
+		ExtId:           utils.StringPtr(extID),
+		CreateTime:      created,
+		IsActiveVersion: utils.BoolPtr(active),
+	}
+}
+
+func TestTemplateVersionsToPrune(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(hours int) *time.Time {
+		created := base.Add(time.Duration(hours) * time.Hour)
+		return &created
+	}
+
+	cases := []struct {
+		name     string
+		versions []vmmContent.TemplateVersionSpec
+		retain   int
+		want     []string
+	}{
+		{
+			name: "prunes the oldest versions whatever the listing order",
+			versions: []vmmContent.TemplateVersionSpec{
+				testTemplateVersion("v2", at(2), false),
+				testTemplateVersion("v4", at(4), false),
+				testTemplateVersion("v1", at(1), false),
+				testTemplateVersion("v3", at(3), false),
+			},
+			retain: 2,
+			want:   []string{"v2", "v1"},
+		},
+		{
+			name: "keeps the active version",
+			versions: []vmmContent.TemplateVersionSpec{
+				testTemplateVersion("v1", at(1), true),
+				testTemplateVersion("v2", at(2), false),
+				testTemplateVersion("v3", at(3), false),
+			},
+			retain: 1,
+			want:   []string{"v2"},
+		},
+		{
+			name: "sorts versions without create time as the oldest",
+			versions: []vmmContent.TemplateVersionSpec{
+				testTemplateVersion("unknown", nil, false),
+				testTemplateVersion("v1", at(1), false),
+				testTemplateVersion("v2", at(2), false),
+			},
+			retain: 2,
+			want:   []string{"unknown"},
+		},
+		{
+			name: "prunes nothing when the versions are within retain",
+			versions: []vmmContent.TemplateVersionSpec{
+				testTemplateVersion("v1", at(1), true),
+				testTemplateVersion("v2", at(2), false),
+			},
+			retain: 2,
+			want:   []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := make([]string, 0)
+			for _, version := range templateVersionsToPrune(tc.versions, tc.retain) {
+				got = append(got, utils.StringValue(version.ExtId))
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("templateVersionsToPrune() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+package vmmv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	vmmContent "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/vmm/v4/content"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+const (
+	resourceNameTemplateVersion       = "nutanix_template_version_v2.v2"
+	resourceNameTemplateActiveVersion = "nutanix_template_active_version_v2.test"
+	datasourceNameTemplateVersion     = "data.nutanix_template_version_v2.v2"
+)
+
+func TestAccV2NutanixTemplateVersionResource_RollForwardAndBack(t *testing.T) {
+	r := acctest.RandInt()
+	vmName := fmt.Sprintf("tf-test-vm-%d", r)
+	templateName := fmt.Sprintf("test-temp-%d", r)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testTemplateV2CheckDestroy,
+		Steps: []resource.TestStep{
+			// create inactive versions and activate the second one
+			{
+				Config: testTemplateVersionV2Config(vmName, templateName, "nutanix_template_version_v2.v2.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceNameTemplateVersion, "id"),
+					resource.TestCheckResourceAttr(resourceNameTemplateVersion, "version_name", "2.0.0"),
+					resource.TestCheckResourceAttr(resourceNameTemplateVersion, "version_description", "second version"),
+					resource.TestCheckResourceAttrSet(resourceNameTemplateVersion, "vm_spec.#"),
+					resource.TestCheckResourceAttrSet(resourceNameTemplateVersion, "create_time"),
+					resource.TestCheckResourceAttrPair(resourceNameTemplateActiveVersion, "version_id",
+						resourceNameTemplateVersion, "id"),
+					resource.TestCheckResourceAttr(datasourceNameTemplateVersion, "version_name", "2.0.0"),
+					resource.TestCheckResourceAttr(datasourceNameTemplateVersion, "is_active_version", "true"),
+				),
+			},
+			// roll forward to the third version
+			{
+				Config: testTemplateVersionV2Config(vmName, templateName, "nutanix_template_version_v2.v3.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceNameTemplateActiveVersion, "version_id",
+						"nutanix_template_version_v2.v3", "id"),
+					resource.TestCheckResourceAttr(datasourceNameTemplateVersion, "is_active_version", "false"),
+				),
+			},
+			// roll back to the second version
+			{
+				Config: testTemplateVersionV2Config(vmName, templateName, "nutanix_template_version_v2.v2.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceNameTemplateActiveVersion, "version_id",
+						resourceNameTemplateVersion, "id"),
+					resource.TestCheckResourceAttr(datasourceNameTemplateVersion, "is_active_version", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixTemplateResource_RetainVersions(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-vm-%d", r)
+	desc := "test vm description"
+	templateName := fmt.Sprintf("test-temp-%d", r)
+	templateDesc := "test temp description"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testTemplateV2CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testTemplateV2Config(name, desc, templateName, templateDesc),
+			},
+			// the new version is active and the initial version is deleted
+			{
+				Config: testTemplateV2RetainVersionsConfig(name, desc, templateName, templateDesc),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameTemplate, "retain_versions", "1"),
+					resource.TestCheckResourceAttr(resourceNameTemplate, "template_version_spec.0.version_name", "2.0.0"),
+					testTemplateV2VersionsCount(resourceNameTemplate, 1),
+				),
+			},
+		},
+	})
+}
+
+func testTemplateV2VersionsCount(resourceName string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found", resourceName)
+		}
+		conn := acc.TestAccProvider.Meta().(*conns.Client)
+		resp, err := conn.VmmAPI().TemplatesAPIInstance.ListTemplateVersions(utils.StringPtr(rs.Primary.ID), nil, nil, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		versions := 0
+		if resp.Data != nil {
+			if list, ok := resp.Data.GetValue().([]vmmContent.TemplateVersionSpec); ok {
+				versions = len(list)
+			}
+		}
+		if versions != count {
+			return fmt.Errorf("template %s has %d versions, expected %d", rs.Primary.ID, versions, count)
+		}
+		return nil
+	}
+}
+
+func testTemplateVersionV2Config(vmName, templateName, activeVersion string) string {
+	return fmt.Sprintf(`
+		data "nutanix_clusters_v2" "clusters" {}
+
+		locals {
+			cluster0 = [
+			  for cluster in data.nutanix_clusters_v2.clusters.cluster_entities :
+			  cluster.ext_id if cluster.config[0].cluster_function[0] != "PRISM_CENTRAL"
+			][0]
+		}
+
+		resource "nutanix_virtual_machine_v2" "test"{
+			name= "%[1]s"
+			description =  "vm for template versions"
+			num_cores_per_socket = 1
+			num_sockets = 1
+			cluster {
+				ext_id = local.cluster0
+			}
+		}
+
+		resource "nutanix_template_v2" "test" {
+			template_name = "%[2]s"
+			template_description = "template with versions"
+			template_version_spec{
+			  version_name        = "1.0.0"
+			  version_description = "first version"
+			  version_source{
+				template_vm_reference{
+				  ext_id = nutanix_virtual_machine_v2.test.id
+				}
+			  }
+			}
+			lifecycle {
+			  ignore_changes = [template_version_spec]
+			}
+		}
+
+		resource "nutanix_template_version_v2" "v2" {
+			template_ext_id     = nutanix_template_v2.test.id
+			version_name        = "2.0.0"
+			version_description = "second version"
+			is_active_version   = false
+			version_source {
+			  template_vm_reference {
+				ext_id = nutanix_virtual_machine_v2.test.id
+			  }
+			}
+		}
+
+		resource "nutanix_template_version_v2" "v3" {
+			template_ext_id     = nutanix_template_v2.test.id
+			version_name        = "3.0.0"
+			version_description = "third version"
+			is_active_version   = false
+			version_source {
+			  template_vm_reference {
+				ext_id = nutanix_virtual_machine_v2.test.id
+			  }
+			}
+			depends_on = [nutanix_template_version_v2.v2]
+		}
+
+		resource "nutanix_template_active_version_v2" "test" {
+			template_ext_id = nutanix_template_v2.test.id
+			version_id      = %[3]s
+		}
+
+		data "nutanix_template_version_v2" "v2" {
+			template_ext_id = nutanix_template_v2.test.id
+			ext_id          = nutanix_template_version_v2.v2.id
+			depends_on      = [nutanix_template_active_version_v2.test]
+		}
+`, vmName, templateName, activeVersion)
+}
+
+func testTemplateV2RetainVersionsConfig(name, desc, tempName, tempDesc string) string {
+	return fmt.Sprintf(`
+		data "nutanix_clusters_v2" "clusters" {}
+
+		locals {
+			cluster0 = [
+			  for cluster in data.nutanix_clusters_v2.clusters.cluster_entities :
+			  cluster.ext_id if cluster.config[0].cluster_function[0] != "PRISM_CENTRAL"
+			][0]
+		}
+
+		resource "nutanix_virtual_machine_v2" "test"{
+			name= "%[1]s"
+			description =  "%[2]s"
+			num_cores_per_socket = 1
+			num_sockets = 1
+			cluster {
+				ext_id = local.cluster0
+			}
+		}
+
+		resource "nutanix_template_v2" "test" {
+			template_name = "%[3]s"
+			template_description = "%[4]s"
+			retain_versions = 1
+			template_version_spec{
+			  version_name        = "2.0.0"
+			  version_description = "updating version from initial to 2.0.0"
+			  version_source{
+				template_vm_reference{
+				  ext_id = nutanix_virtual_machine_v2.test.id
+				}
+			  }
+			}
+			depends_on = [nutanix_virtual_machine_v2.test]
+		}
+`, name, desc, tempName, tempDesc)
+}
@@ -33,6 +33,7 @@ func ResourceNutanixVirtualMachineV2() *schema.Resource {
 		ReadContext:   ResourceNutanixVirtualMachineV2Read,
 		UpdateContext: ResourceNutanixVirtualMachineV2Update,
 		DeleteContext: ResourceNutanixVirtualMachineV2Delete,
+		CustomizeDiff: common.DefaultCategoryExtIDsDiff("categories", "ext_id"),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -1346,7 +1347,7 @@ func schemaForGuestCustomization() *schema.Schema {
 }
 
 func ResourceNutanixVirtualMachineV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 	VMConfigMap := resourceDataToMap(d, ResourceNutanixVirtualMachineV2().Schema)
 	body := prepareVMConfigFromMap(VMConfigMap)
 	aJSON, _ := json.MarshalIndent(body, "", " ")
@@ -1365,16 +1366,9 @@ func ResourceNutanixVirtualMachineV2Create(ctx context.Context, d *schema.Resour
 	TaskRef := resp.Data.GetValue().(import1.TaskReference)
 	taskUUID := TaskRef.ExtId
 
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the task to complete
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutCreate),
-	}
-
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
 		return diag.Errorf("error waiting for virtual Machine (%s) to create: %s", utils.StringValue(taskUUID), errWaitTask)
 	}
 
@@ -1449,7 +1443,7 @@ func ResourceNutanixVirtualMachineV2Create(ctx context.Context, d *schema.Resour
 }
 
 func ResourceNutanixVirtualMachineV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	resp, err := conn.VMAPIInstance.GetVmById(utils.StringPtr(d.Id()))
 	if err != nil {
@@ -1463,7 +1457,7 @@ func ResourceNutanixVirtualMachineV2Read(ctx context.Context, d *schema.Resource
 }
 
 func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	// Power Off of VM is required for specific VM update operations.
 	isCpuHotplugEnabled := d.Get("is_cpu_hotplug_enabled").(bool)
@@ -1552,9 +1546,18 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 		updateSpec.OwnershipInfo = expandOwnershipInfo(d.Get("ownership_info"))
 		checkForUpdateParams = true
 	}
+	// a running VM is live migrated to the desired host once updated, the host of a powered off VM
+	// is updated in place
+	migrateToHost := ""
 	if d.HasChange("host") {
-		updateSpec.Host = expandHostReference(d.Get("host"))
-		checkForUpdateParams = true
+		host := expandHostReference(d.Get("host"))
+		if host != nil && utils.StringValue(host.ExtId) != "" && respVM.PowerState != nil &&
+			*respVM.PowerState == config.POWERSTATE_ON && d.Get("power_state").(string) != "OFF" {
+			migrateToHost = utils.StringValue(host.ExtId)
+		} else {
+			updateSpec.Host = host
+			checkForUpdateParams = true
+		}
 	}
 	if d.HasChange("cluster") {
 		updateSpec.Cluster = expandClusterReference(d.Get("cluster"))
@@ -1638,16 +1641,9 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 		TaskRef := updateResp.Data.GetValue().(import1.TaskReference)
 		taskUUID := TaskRef.ExtId
 
-		taskconn := meta.(*conns.Client).PrismAPI
+		taskconn := meta.(*conns.Client).PrismAPI()
 		// Wait for the task to complete
-		stateConf := &resource.StateChangeConf{
-			Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-			Target:  []string{"SUCCEEDED"},
-			Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-			Timeout: d.Timeout(schema.TimeoutUpdate),
-		}
-
-		if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+		if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutUpdate)); errWaitTask != nil {
 			return diag.Errorf("error waiting for virtual machine (%s) to update: %s", utils.StringValue(taskUUID), errWaitTask)
 		}
 	}
@@ -1662,6 +1658,11 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 		if err := ApplyDiskDeletions(ctx, d, meta, conn, d.Id(), oldDeletedDisk, expandDiskFn); err != nil {
 			return err
 		}
+		// disks moved to another storage container are migrated in place rather than re-created
+		updatedDisk, diags := ApplyDiskMigrations(ctx, d, meta, conn, d.Id(), oldDisk.([]interface{}), updatedDisk)
+		if diags.HasError() {
+			return diags
+		}
 		if err := ApplyDiskUpdates(ctx, d, meta, conn, d.Id(), updatedDisk, expandDiskFn); err != nil {
 			return err
 		}
@@ -1707,16 +1708,9 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 				TaskRef := resp.Data.GetValue().(import1.TaskReference)
 				taskUUID := TaskRef.ExtId
 
-				taskconn := meta.(*conns.Client).PrismAPI
+				taskconn := meta.(*conns.Client).PrismAPI()
 				// Wait for the task to complete
-				stateConf := &resource.StateChangeConf{
-					Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-					Target:  []string{"SUCCEEDED"},
-					Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-					Timeout: d.Timeout(schema.TimeoutDelete),
-				}
-
-				if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+				if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutDelete)); errWaitTask != nil {
 					return diag.Errorf("error waiting for nic (%s) to be deleted: %s", utils.StringValue(taskUUID), errWaitTask)
 				}
 			}
@@ -1763,16 +1757,9 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 				TaskRef := resp.Data.GetValue().(import1.TaskReference)
 				taskUUID := TaskRef.ExtId
 
-				taskconn := meta.(*conns.Client).PrismAPI
+				taskconn := meta.(*conns.Client).PrismAPI()
 				// Wait for the task to complete
-				stateConf := &resource.StateChangeConf{
-					Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-					Target:  []string{"SUCCEEDED"},
-					Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-					Timeout: d.Timeout(schema.TimeoutUpdate),
-				}
-
-				if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+				if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutUpdate)); errWaitTask != nil {
 					return diag.Errorf("error waiting for nic (%s) to be updated: %s", utils.StringValue(taskUUID), errWaitTask)
 				}
 			}
@@ -1797,16 +1784,9 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 				TaskRef := resp.Data.GetValue().(import1.TaskReference)
 				taskUUID := TaskRef.ExtId
 
-				taskconn := meta.(*conns.Client).PrismAPI
+				taskconn := meta.(*conns.Client).PrismAPI()
 				// Wait for the task to complete
-				stateConf := &resource.StateChangeConf{
-					Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-					Target:  []string{"SUCCEEDED"},
-					Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-					Timeout: d.Timeout(schema.TimeoutCreate),
-				}
-
-				if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+				if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
 					return diag.Errorf("error waiting for NIC (%s) to add: %s", utils.StringValue(taskUUID), errWaitTask)
 				}
 			}
@@ -1836,16 +1816,9 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 				TaskRef := resp.Data.GetValue().(import1.TaskReference)
 				taskUUID := TaskRef.ExtId
 
-				taskconn := meta.(*conns.Client).PrismAPI
+				taskconn := meta.(*conns.Client).PrismAPI()
 				// Wait for the task to complete
-				stateConf := &resource.StateChangeConf{
-					Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-					Target:  []string{"SUCCEEDED"},
-					Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-					Timeout: d.Timeout(schema.TimeoutCreate),
-				}
-
-				if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+				if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
 					return diag.Errorf("error waiting for CdRom (%s) to add: %s", utils.StringValue(taskUUID), errWaitTask)
 				}
 			}
@@ -1873,16 +1846,9 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 				TaskRef := resp.Data.GetValue().(import1.TaskReference)
 				taskUUID := TaskRef.ExtId
 
-				taskconn := meta.(*conns.Client).PrismAPI
+				taskconn := meta.(*conns.Client).PrismAPI()
 				// Wait for the task to complete
-				stateConf := &resource.StateChangeConf{
-					Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-					Target:  []string{"SUCCEEDED"},
-					Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-					Timeout: d.Timeout(schema.TimeoutDelete),
-				}
-
-				if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+				if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutDelete)); errWaitTask != nil {
 					return diag.Errorf("error waiting for cdrom (%s) to be deleted: %s", utils.StringValue(taskUUID), errWaitTask)
 				}
 			}
@@ -1915,16 +1881,9 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 				TaskRef := resp.Data.GetValue().(import1.TaskReference)
 				taskUUID := TaskRef.ExtId
 
-				taskconn := meta.(*conns.Client).PrismAPI
+				taskconn := meta.(*conns.Client).PrismAPI()
 				// Wait for the task to complete
-				stateConf := &resource.StateChangeConf{
-					Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-					Target:  []string{"SUCCEEDED"},
-					Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-					Timeout: d.Timeout(schema.TimeoutDelete),
-				}
-
-				if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+				if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutDelete)); errWaitTask != nil {
 					return diag.Errorf("error waiting for serial port (%s) to delete: %s", utils.StringValue(taskUUID), errWaitTask)
 				}
 			}
@@ -1951,16 +1910,9 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 				TaskRef := resp.Data.GetValue().(import1.TaskReference)
 				taskUUID := TaskRef.ExtId
 
-				taskconn := meta.(*conns.Client).PrismAPI
+				taskconn := meta.(*conns.Client).PrismAPI()
 				// Wait for the task to complete
-				stateConf := &resource.StateChangeConf{
-					Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-					Target:  []string{"SUCCEEDED"},
-					Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-					Timeout: d.Timeout(schema.TimeoutUpdate),
-				}
-
-				if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+				if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutUpdate)); errWaitTask != nil {
 					return diag.Errorf("error waiting for seial port (%s) to be updated: %s", utils.StringValue(taskUUID), errWaitTask)
 				}
 			}
@@ -1985,16 +1937,9 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 				TaskRef := resp.Data.GetValue().(import1.TaskReference)
 				taskUUID := TaskRef.ExtId
 
-				taskconn := meta.(*conns.Client).PrismAPI
+				taskconn := meta.(*conns.Client).PrismAPI()
 				// Wait for the task to complete
-				stateConf := &resource.StateChangeConf{
-					Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-					Target:  []string{"SUCCEEDED"},
-					Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-					Timeout: d.Timeout(schema.TimeoutCreate),
-				}
-
-				if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+				if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
 					return diag.Errorf("error waiting for SerialPort (%s) to add: %s", utils.StringValue(taskUUID), errWaitTask)
 				}
 			}
@@ -2025,16 +1970,9 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 				TaskRef := resp.Data.GetValue().(import1.TaskReference)
 				taskUUID := TaskRef.ExtId
 
-				taskconn := meta.(*conns.Client).PrismAPI
+				taskconn := meta.(*conns.Client).PrismAPI()
 				// Wait for the task to complete
-				stateConf := &resource.StateChangeConf{
-					Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-					Target:  []string{"SUCCEEDED"},
-					Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-					Timeout: d.Timeout(schema.TimeoutCreate),
-				}
-
-				if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+				if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
 					return diag.Errorf("error waiting for Gpu (%s) to add: %s", utils.StringValue(taskUUID), errWaitTask)
 				}
 			}
@@ -2061,16 +1999,9 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 				TaskRef := resp.Data.GetValue().(import1.TaskReference)
 				taskUUID := TaskRef.ExtId
 
-				taskconn := meta.(*conns.Client).PrismAPI
+				taskconn := meta.(*conns.Client).PrismAPI()
 				// Wait for the task to complete
-				stateConf := &resource.StateChangeConf{
-					Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-					Target:  []string{"SUCCEEDED"},
-					Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-					Timeout: d.Timeout(schema.TimeoutDelete),
-				}
-
-				if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+				if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutDelete)); errWaitTask != nil {
 					return diag.Errorf("error waiting for gpu (%s) to be deleted: %s", utils.StringValue(taskUUID), errWaitTask)
 				}
 			}
@@ -2104,16 +2035,9 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 			TaskRef := resp.Data.GetValue().(import1.TaskReference)
 			taskUUID := TaskRef.ExtId
 
-			taskconn := meta.(*conns.Client).PrismAPI
+			taskconn := meta.(*conns.Client).PrismAPI()
 			// Wait for the task to complete
-			stateConf := &resource.StateChangeConf{
-				Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-				Target:  []string{"SUCCEEDED"},
-				Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-				Timeout: d.Timeout(schema.TimeoutDelete),
-			}
-
-			if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+			if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutDelete)); errWaitTask != nil {
 				return diag.Errorf("error waiting for categories (%s) to diassociate: %s", utils.StringValue(taskUUID), errWaitTask)
 			}
 		}
@@ -2139,21 +2063,20 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 			TaskRef := resp.Data.GetValue().(import1.TaskReference)
 			taskUUID := TaskRef.ExtId
 
-			taskconn := meta.(*conns.Client).PrismAPI
+			taskconn := meta.(*conns.Client).PrismAPI()
 			// Wait for the task to complete
-			stateConf := &resource.StateChangeConf{
-				Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-				Target:  []string{"SUCCEEDED"},
-				Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-				Timeout: d.Timeout(schema.TimeoutCreate),
-			}
-
-			if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+			if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
 				return diag.Errorf("error waiting for categories (%s) to attach: %s", utils.StringValue(taskUUID), errWaitTask)
 			}
 		}
 	}
 
+	if migrateToHost != "" {
+		if _, diags := migrateVMToHost(ctx, meta, d.Id(), migrateToHost, d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+			return diags
+		}
+	}
+
 	// call for power on VM after updating
 	if checkForHotPlugChanges(d) || (!isCpuHotplugEnabled && d.HasChange("num_sockets")) || checkMemoryAndSocketsDecreased(d) {
 		if power, ok := d.GetOk("power_state"); ok {
@@ -2186,7 +2109,7 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 }
 
 func ResourceNutanixVirtualMachineV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	readResp, err := conn.VMAPIInstance.GetVmById(utils.StringPtr(d.Id()))
 	if err != nil {
@@ -2205,16 +2128,9 @@ func ResourceNutanixVirtualMachineV2Delete(ctx context.Context, d *schema.Resour
 
 	// calling group API to poll for completion of task
 
-	taskconn := meta.(*conns.Client).PrismAPI
+	taskconn := meta.(*conns.Client).PrismAPI()
 	// Wait for the task to complete
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutDelete),
-	}
-
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutDelete)); errWaitTask != nil {
 		return diag.Errorf("error waiting for vm (%s) to delete: %s", utils.StringValue(taskUUID), errWaitTask)
 	}
 	return nil
@@ -3175,7 +3091,7 @@ const (
 )
 
 func callForPowerOffVM(ctx context.Context, conn *vmm.Client, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	prismConn := meta.(*conns.Client).PrismAPI
+	prismConn := meta.(*conns.Client).PrismAPI()
 	var lastErr error
 	var taskUUID *string
 
@@ -3209,14 +3125,7 @@ func callForPowerOffVM(ctx context.Context, conn *vmm.Client, d *schema.Resource
 		}
 		taskUUID = TaskRef.ExtId
 
-		stateConf := &resource.StateChangeConf{
-			Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-			Target:  []string{"SUCCEEDED"},
-			Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, prismConn, utils.StringValue(taskUUID)),
-			Timeout: d.Timeout(schema.TimeoutCreate),
-		}
-
-		_, errWaitTask := stateConf.WaitForStateContext(ctx)
+		_, errWaitTask := common.WaitForTask(ctx, prismConn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate))
 		if errWaitTask == nil {
 			log.Printf("[DEBUG] Power-off task reported SUCCEEDED for task %s; verifying VM power_state", utils.StringValue(taskUUID))
 			verifyResp, errV := conn.VMAPIInstance.GetVmById(utils.StringPtr(d.Id()))
@@ -3250,7 +3159,7 @@ func callForPowerOffVM(ctx context.Context, conn *vmm.Client, d *schema.Resource
 }
 
 func callForPowerOnVM(ctx context.Context, conn *vmm.Client, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	prismConn := meta.(*conns.Client).PrismAPI
+	prismConn := meta.(*conns.Client).PrismAPI()
 	var lastErr error
 	var taskUUID *string
 
@@ -3285,14 +3194,7 @@ func callForPowerOnVM(ctx context.Context, conn *vmm.Client, d *schema.ResourceD
 		taskUUID = TaskRef.ExtId
 		log.Printf("[DEBUG] PowerOn Response: TaskReference ExtId: %s (waiting for task)", utils.StringValue(taskUUID))
 
-		stateConf := &resource.StateChangeConf{
-			Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-			Target:  []string{"SUCCEEDED"},
-			Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, prismConn, utils.StringValue(taskUUID)),
-			Timeout: d.Timeout(schema.TimeoutUpdate),
-		}
-
-		_, errWaitTask := stateConf.WaitForStateContext(ctx)
+		_, errWaitTask := common.WaitForTask(ctx, prismConn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutUpdate))
 		if errWaitTask == nil {
 			log.Printf("[DEBUG] Power-on task reported SUCCEEDED for task %s; verifying VM power_state", utils.StringValue(taskUUID))
 			verifyResp, errV := conn.VMAPIInstance.GetVmById(utils.StringPtr(d.Id()))
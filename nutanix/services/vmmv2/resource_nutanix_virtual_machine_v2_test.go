@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
 )
 
@@ -1121,6 +1122,45 @@ func TestAccV2NutanixVmsResource_ClusterAutomaticSelection(t *testing.T) {
 	})
 }
 
+func TestAccV2NutanixVmsResource_DiskStorageContainerMigration(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-vm-%d", r)
+	desc := "test vm disk migration"
+	diskExtID := ""
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testVmsV4ConfigWithDiskStorageContainer(name, desc, "data.nutanix_storage_containers_v2.default.storage_containers[0].ext_id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVms, "name", name),
+					resource.TestCheckResourceAttrPair(resourceNameVms, "disks.0.backing_info.0.vm_disk.0.storage_container.0.ext_id",
+						"data.nutanix_storage_containers_v2.default", "storage_containers.0.ext_id"),
+					func(s *terraform.State) error {
+						diskExtID = s.RootModule().Resources[resourceNameVms].Primary.Attributes["disks.0.ext_id"]
+						return nil
+					},
+				),
+			},
+			// the disk is migrated in place to the new storage container
+			{
+				Config: testVmsV4ConfigWithDiskStorageContainer(name, desc, "nutanix_storage_containers_v2.test.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceNameVms, "disks.0.backing_info.0.vm_disk.0.storage_container.0.ext_id",
+						"nutanix_storage_containers_v2.test", "id"),
+					func(s *terraform.State) error {
+						if extID := s.RootModule().Resources[resourceNameVms].Primary.Attributes["disks.0.ext_id"]; extID != diskExtID {
+							return fmt.Errorf("disk %s was re-created as %s, expected it to be migrated", diskExtID, extID)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testVmsV4Config(name, desc string) string {
 	return fmt.Sprintf(`
 		data "nutanix_clusters_v2" "clusters" {}
@@ -2783,3 +2823,63 @@ func testVmsV4ConfigWithTwoNics(name, desc string, r int) string {
 		}
 `, name, desc, r, filepath)
 }
+
+func testVmsV4ConfigWithDiskStorageContainer(name, desc, storageContainer string) string {
+	return fmt.Sprintf(`
+		data "nutanix_clusters_v2" "clusters" {
+			filter = "config/clusterFunction/any(t:t eq Clustermgmt.Config.ClusterFunctionRef'AOS')"
+		}
+
+		locals {
+			cluster0 = data.nutanix_clusters_v2.clusters.cluster_entities[0].ext_id
+			config = jsondecode(file("%[4]s"))
+			storage_container = local.config.storage_container
+		}
+
+		data "nutanix_storage_containers_v2" "default" {
+			filter = "clusterExtId eq '${local.cluster0}' and startswith(name,'default-container-')"
+			limit = 1
+		}
+
+		resource "nutanix_storage_containers_v2" "test" {
+			name = "%[1]s-sc"
+			cluster_ext_id = local.cluster0
+			logical_advertised_capacity_bytes = local.storage_container.logical_advertised_capacity_bytes
+			logical_explicit_reserved_capacity_bytes = local.storage_container.logical_explicit_reserved_capacity_bytes
+			replication_factor = local.storage_container.replication_factor
+			erasure_code = "OFF"
+			is_inline_ec_enabled = false
+			has_higher_ec_fault_domain_preference = false
+			cache_deduplication = "OFF"
+			on_disk_dedup = "OFF"
+			is_compression_enabled = true
+			is_internal = false
+			is_software_encryption_enabled = false
+		}
+
+		resource "nutanix_virtual_machine_v2" "test"{
+			name= "%[1]s"
+			description =  "%[2]s"
+			num_cores_per_socket = 1
+			num_sockets = 1
+			power_state = "OFF"
+			cluster {
+				ext_id = local.cluster0
+			}
+			disks{
+				disk_address{
+					bus_type = "SCSI"
+					index = 0
+				}
+				backing_info{
+					vm_disk{
+						disk_size_bytes = "1073741824"
+						storage_container{
+							ext_id = %[3]s
+						}
+					}
+				}
+			}
+		}
+`, name, desc, storageContainer, filepath)
+}
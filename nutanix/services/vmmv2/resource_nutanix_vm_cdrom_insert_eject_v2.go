@@ -199,7 +199,7 @@ func ResourceNutanixVmsCdRomsInsertEjectV2() *schema.Resource {
 func ResourceNutanixVmsCdRomsInsertEjectV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] ResourceNutanixVmsCdRomsInsertEjectV2Create : Inserting ISO into the CD-ROM %s of the VM %s", d.Get("ext_id").(string), d.Get("vm_ext_id").(string))
 	if action, ok := d.GetOk("action"); ok && action.(string) == "insert" {
-		conn := meta.(*conns.Client).VmmAPI
+		conn := meta.(*conns.Client).VmmAPI()
 
 		vmExtID := d.Get("vm_ext_id")
 		extID := d.Get("ext_id")
@@ -225,16 +225,9 @@ func ResourceNutanixVmsCdRomsInsertEjectV2Create(ctx context.Context, d *schema.
 		TaskRef := resp.Data.GetValue().(import1.TaskReference)
 		taskUUID := TaskRef.ExtId
 
-		taskconn := meta.(*conns.Client).PrismAPI
+		taskconn := meta.(*conns.Client).PrismAPI()
 		// Wait for the CD-ROM to be inserted
-		stateConf := &resource.StateChangeConf{
-			Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-			Target:  []string{"SUCCEEDED"},
-			Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-			Timeout: d.Timeout(schema.TimeoutCreate),
-		}
-
-		if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+		if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
 			return diag.Errorf("error waiting for CD-ROM insert (%s) to complete: %s", utils.StringValue(taskUUID), errWaitTask)
 		}
 
@@ -257,7 +250,7 @@ func ResourceNutanixVmsCdRomsInsertEjectV2Create(ctx context.Context, d *schema.
 
 func ResourceNutanixVmsCdRomsInsertEjectV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] ResourceNutanixVmsCdRomsInsertEjectV2Read : Reading CD-ROM %s of the VM %s", d.Get("ext_id").(string), d.Get("vm_ext_id").(string))
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	vmExtID := d.Get("vm_ext_id")
 	extID := d.Get("ext_id")
@@ -0,0 +1,255 @@
+package vmmv2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/prism/v4/config"
+	"github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/vmm/v4/ahv/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// ResourceNutanixVMCrossClusterMigrateV2 migrates a VM to another cluster registered to the same Prism Central
+func ResourceNutanixVMCrossClusterMigrateV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceNutanixVMCrossClusterMigrateV2Create,
+		ReadContext:   ResourceNutanixVMCrossClusterMigrateV2Read,
+		DeleteContext: ResourceNutanixVMCrossClusterMigrateV2Delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"vm_ext_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target_cluster": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ext_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"target_availability_zone_ext_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"is_live_migration": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"dry_run": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"subnet_mapping": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_subnet_ext_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"target_subnet_ext_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"storage_container_mapping": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_storage_container_ext_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"target_storage_container_ext_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceNutanixVMCrossClusterMigrateV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceNutanixVMCrossClusterMigrateV2(ctx, d, meta)
+}
+
+func ResourceNutanixVMCrossClusterMigrateV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func ResourceNutanixVMCrossClusterMigrateV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceNutanixVMCrossClusterMigrateV2(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VmmAPI()
+
+	vmExtID := d.Get("vm_ext_id").(string)
+	dryRun := d.Get("dry_run").(bool)
+
+	readResp, err := conn.VMAPIInstance.GetVmById(utils.StringPtr(vmExtID))
+	if err != nil {
+		return diag.Errorf("error while reading vm : %v", err)
+	}
+	vm := readResp.Data.GetValue().(config.Vm)
+
+	body := config.VmCrossClusterMigrateParams{
+		IsLiveMigration: utils.BoolPtr(d.Get("is_live_migration").(bool)),
+		TargetCluster:   expandClusterReference(d.Get("target_cluster")),
+	}
+	if azExtID, ok := d.GetOk("target_availability_zone_ext_id"); ok {
+		body.TargetAvailabilityZone = &config.AvailabilityZoneReference{ExtId: utils.StringPtr(azExtID.(string))}
+	}
+
+	overrides := &config.VmCrossClusterMigrateOverrides{}
+	if mappings, ok := d.GetOk("subnet_mapping"); ok {
+		nics, err := overrideNicSubnets(vm.Nics, expandSubnetMappings(mappings.([]interface{})))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		overrides.OverrideNicList = nics
+	}
+	if mappings, ok := d.GetOk("storage_container_mapping"); ok {
+		overrides.StorageContainersMapping = expandStorageContainersMapping(mappings.([]interface{}))
+	}
+	if len(overrides.OverrideNicList) > 0 || len(overrides.StorageContainersMapping) > 0 {
+		body.Overrides = overrides
+	}
+
+	// Extract E-Tag Header
+	args := make(map[string]interface{})
+	args["If-Match"] = getEtagHeader(readResp, conn)
+
+	aJSON, _ := json.MarshalIndent(body, "", "  ")
+	log.Printf("[DEBUG] VM Cross Cluster Migrate Request Body (dry run: %t): %s", dryRun, string(aJSON))
+
+	resp, err := conn.VMAPIInstance.CrossClusterMigrateVm(utils.StringPtr(vmExtID), &body, utils.BoolPtr(dryRun), args)
+	if err != nil {
+		if dryRun {
+			return diag.Errorf("vm %s failed cross cluster migration validation: %v", vmExtID, err)
+		}
+		return diag.Errorf("error while migrating vm %s to cluster : %v", vmExtID, err)
+	}
+
+	TaskRef := resp.Data.GetValue().(import1.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	taskconn := meta.(*conns.Client).PrismAPI()
+	// Wait for the VM migration, or its validation on dry run, to complete
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), d.Timeout(schema.TimeoutCreate)); errWaitTask != nil {
+		if dryRun {
+			return diag.Errorf("vm %s failed cross cluster migration validation: %s", vmExtID, errWaitTask)
+		}
+		return diag.Errorf("error waiting for vm (%s) to migrate to cluster: %s", vmExtID, errWaitTask)
+	}
+
+	// This is an action resource that does not maintain state.
+	// The resource ID is set to the task ExtId for traceability.
+	d.SetId(utils.StringValue(taskUUID))
+	return nil
+}
+
+// expandSubnetMappings returns the target subnet ext ids by source subnet ext id
+func expandSubnetMappings(pr []interface{}) map[string]string {
+	mappings := make(map[string]string, len(pr))
+	for _, v := range pr {
+		val := v.(map[string]interface{})
+		mappings[val["source_subnet_ext_id"].(string)] = val["target_subnet_ext_id"].(string)
+	}
+	return mappings
+}
+
+func expandStorageContainersMapping(pr []interface{}) []config.StorageContainerMapping {
+	mappings := make([]config.StorageContainerMapping, 0, len(pr))
+	for _, v := range pr {
+		val := v.(map[string]interface{})
+		mapping := config.NewStorageContainerMapping()
+		mapping.SourceStorageContainer = &config.VmDiskContainerReference{
+			ExtId: utils.StringPtr(val["source_storage_container_ext_id"].(string)),
+		}
+		mapping.TargetStorageContainer = &config.VmDiskContainerReference{
+			ExtId: utils.StringPtr(val["target_storage_container_ext_id"].(string)),
+		}
+		mappings = append(mappings, *mapping)
+	}
+	return mappings
+}
+
+// overrideNicSubnets returns the nics of the VM attached to a source subnet of mappings, attached to
+// the matching target subnet instead. Their addresses are released so that the target subnet assigns
+// them. Every source subnet must be attached to a nic of the VM.
+func overrideNicSubnets(nics []config.Nic, mappings map[string]string) ([]config.Nic, error) {
+	mapped := make(map[string]bool, len(mappings))
+	overrides := make([]config.Nic, 0)
+	for _, nic := range nics {
+		target := ""
+		if nic.NetworkInfo != nil && nic.NetworkInfo.Subnet != nil {
+			if subnet, ok := mappings[utils.StringValue(nic.NetworkInfo.Subnet.ExtId)]; ok {
+				mapped[utils.StringValue(nic.NetworkInfo.Subnet.ExtId)] = true
+				target = subnet
+				nic.NetworkInfo.Subnet = &config.SubnetReference{ExtId: utils.StringPtr(subnet)}
+				nic.NetworkInfo.Ipv4Info = nil
+				if nic.NetworkInfo.Ipv4Config != nil {
+					nic.NetworkInfo.Ipv4Config.IpAddress = nil
+				}
+			}
+		}
+		if nic.NicNetworkInfo != nil {
+			if info, ok := nic.NicNetworkInfo.GetValue().(config.VirtualEthernetNicNetworkInfo); ok && info.Subnet != nil {
+				if subnet, ok := mappings[utils.StringValue(info.Subnet.ExtId)]; ok {
+					mapped[utils.StringValue(info.Subnet.ExtId)] = true
+					target = subnet
+					info.Subnet = &config.SubnetReference{ExtId: utils.StringPtr(subnet)}
+					info.Ipv4Info = nil
+					if info.Ipv4Config != nil {
+						info.Ipv4Config.IpAddress = nil
+					}
+					if err := nic.NicNetworkInfo.SetValue(info); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+		if target != "" {
+			overrides = append(overrides, nic)
+		}
+	}
+
+	for source := range mappings {
+		if !mapped[source] {
+			return nil, fmt.Errorf("no nic of the vm is attached to subnet %s of subnet_mapping", source)
+		}
+	}
+	return overrides, nil
+}
@@ -0,0 +1,73 @@
+package vmmv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+)
+
+const resourceNameVMCrossClusterMigrate = "nutanix_vm_cross_cluster_migrate_v2.test"
+
+func TestAccV2NutanixVMCrossClusterMigrateResource_DryRun(t *testing.T) {
+	r := acctest.RandInt()
+	vmName := fmt.Sprintf("tf-test-vm-cc-migrate-%d", r)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testVMPreEnvConfig(r) + testVMCrossClusterMigrateDryRunConfig(vmName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceNameVMCrossClusterMigrate, "id"),
+					resource.TestCheckResourceAttr(resourceNameVMCrossClusterMigrate, "dry_run", "true"),
+					// a dry run leaves the vm on its cluster
+					resource.TestCheckResourceAttrPair("data.nutanix_virtual_machine_v2.validated", "cluster.0.ext_id",
+						"nutanix_virtual_machine_v2.test-vm", "cluster.0.ext_id"),
+				),
+			},
+		},
+	})
+}
+
+func testVMCrossClusterMigrateDryRunConfig(vmName string) string {
+	return fmt.Sprintf(`
+		locals {
+		  targetClusterUUID = [
+			for cluster in data.nutanix_clusters_v2.clusters.cluster_entities :
+			cluster.ext_id if cluster.config[0].cluster_function[0] != "PRISM_CENTRAL" && cluster.ext_id != local.clusterUUID
+		  ][0]
+		}
+
+		resource "nutanix_virtual_machine_v2" "test-vm" {
+		  name                 = "%[1]s"
+		  description          = "vm to migrate across clusters"
+		  num_cores_per_socket = 1
+		  num_sockets          = 1
+		  memory_size_bytes    = 1 * 1024 * 1024 * 1024
+		  cluster {
+			ext_id = local.clusterUUID
+		  }
+		  power_state = "ON"
+
+		  lifecycle {
+			ignore_changes = [guest_tools]
+		  }
+		}
+
+		resource "nutanix_vm_cross_cluster_migrate_v2" "test" {
+		  vm_ext_id = nutanix_virtual_machine_v2.test-vm.id
+		  target_cluster {
+			ext_id = local.targetClusterUUID
+		  }
+		  dry_run = true
+		}
+
+		data "nutanix_virtual_machine_v2" "validated" {
+		  ext_id     = nutanix_virtual_machine_v2.test-vm.id
+		  depends_on = [nutanix_vm_cross_cluster_migrate_v2.test]
+		}
+`, vmName)
+}
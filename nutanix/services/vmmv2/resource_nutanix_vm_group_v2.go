@@ -0,0 +1,744 @@
+package vmmv2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/prism/v4/config"
+	"github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/vmm/v4/ahv/config"
+	import5 "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/vmm/v4/content"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v4/vmm"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+const (
+	vmGroupTimeout = 120 * time.Minute
+	// vmGroupHealthCheckTimeout is the time a VM has to pass its health checks when none is configured
+	vmGroupHealthCheckTimeout = 10
+)
+
+// vmGroupSpec is the configuration rolled out to the VMs of a group. Unset fields keep the value of the
+// source of the VMs.
+type vmGroupSpec struct {
+	numSockets        *int
+	numCoresPerSocket *int
+	numThreadsPerCore *int
+	memorySizeBytes   *int64
+}
+
+// vmGroupHealthCheck is the readiness a VM of a group must reach after it is created or updated
+type vmGroupHealthCheck struct {
+	waitForIP  bool
+	waitForNGT bool
+	timeout    time.Duration
+}
+
+// ResourceNutanixVMGroupV2 manages a group of identical VMs cloned from a VM or deployed from a template.
+// Changes of the VM configuration are rolled out a few VMs at a time, and the rollout stops on the first
+// VM which fails to update or to become healthy.
+func ResourceNutanixVMGroupV2() *schema.Resource {
+	guestCustomization := schemaForGuestCustomization()
+	// the guest customization applies to the VMs created after it changes, it does not replace the group
+	guestCustomization.ForceNew = false
+
+	return &schema.Resource{
+		CreateContext: ResourceNutanixVMGroupV2Create,
+		ReadContext:   ResourceNutanixVMGroupV2Read,
+		UpdateContext: ResourceNutanixVMGroupV2Update,
+		DeleteContext: ResourceNutanixVMGroupV2Delete,
+		CustomizeDiff: resourceNutanixVMGroupV2CustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(vmGroupTimeout),
+			Update: schema.DefaultTimeout(vmGroupTimeout),
+			Delete: schema.DefaultTimeout(vmGroupTimeout),
+		},
+		Schema: map[string]*schema.Schema{
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"replicas": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"source": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vm_ext_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"source.0.vm_ext_id", "source.0.template_ext_id"},
+						},
+						"template_ext_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							RequiredWith: []string{"source.0.cluster_ext_id"},
+						},
+						"template_version_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"cluster_ext_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"num_sockets": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"num_cores_per_socket": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"num_threads_per_core": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"memory_size_bytes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"guest_customization": guestCustomization,
+			"rolling_update": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_unavailable": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"pause_on_failure": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"health_check": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"wait_for_ip": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"wait_for_ngt": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"timeout_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      vmGroupHealthCheckTimeout,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"vms": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ext_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"num_sockets": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"num_cores_per_socket": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"num_threads_per_core": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"memory_size_bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"power_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_ngt_reachable": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceNutanixVMGroupV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(utils.GenUUID())
+	return updateVMGroup(ctx, d, meta, nil, d.Timeout(schema.TimeoutCreate))
+}
+
+func ResourceNutanixVMGroupV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VmmAPI()
+
+	vms, err := readVMGroupMembers(conn, vmGroupMemberIDs(d.Get("vms")))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("vms", flattenVMGroupMembers(vms)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func ResourceNutanixVMGroupV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// the members are computed, so they are unknown in the new state when the group changes
+	oldVMs, _ := d.GetChange("vms")
+	return updateVMGroup(ctx, d, meta, vmGroupMemberIDs(oldVMs), d.Timeout(schema.TimeoutUpdate))
+}
+
+func ResourceNutanixVMGroupV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VmmAPI()
+
+	for _, vmExtID := range vmGroupMemberIDs(d.Get("vms")) {
+		if err := deleteVMGroupMember(ctx, conn, meta, vmExtID, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+// resourceNutanixVMGroupV2CustomizeDiff plans an update of the group when its VMs no longer match its
+// configuration, so that a rollout paused on a failure resumes on the next apply
+func resourceNutanixVMGroupV2CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	vms := d.Get("vms").([]interface{})
+	spec := expandVMGroupSpec(d.GetOk)
+
+	outdated := len(vms) != d.Get("replicas").(int)
+	for _, vm := range vms {
+		if !spec.matchesMember(vm.(map[string]interface{})) {
+			outdated = true
+		}
+	}
+	if outdated {
+		return d.SetNewComputed("vms")
+	}
+	return nil
+}
+
+// updateVMGroup brings the VMs of the group to the configured number of replicas and configuration. VMs
+// beyond the replicas are deleted first, then the outdated VMs are updated max_unavailable at a time and
+// the missing VMs are created.
+func updateVMGroup(ctx context.Context, d *schema.ResourceData, meta interface{}, members []string, timeout time.Duration) (diags diag.Diagnostics) {
+	conn := meta.(*conns.Client).VmmAPI()
+	replicas := d.Get("replicas").(int)
+	spec := expandVMGroupSpec(d.GetOk)
+	healthCheck := expandVMGroupHealthCheck(d.Get("health_check"))
+	maxUnavailable, pauseOnFailure := expandVMGroupRollingUpdate(d.Get("rolling_update"))
+
+	// the members are recorded whatever happens, so that no VM is lost from the state
+	defer func() {
+		if err := d.Set("vms", flattenVMGroupMembersByID(conn, members)); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}()
+
+	// the VMs deleted outside of terraform are dropped from the group, and created again below
+	vms, err := readVMGroupMembers(conn, members)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+	members = members[:0]
+	for _, vm := range vms {
+		members = append(members, utils.StringValue(vm.ExtId))
+	}
+
+	for len(members) > replicas {
+		last := members[len(members)-1]
+		log.Printf("[INFO] deleting VM %s of group %s to scale down to %d replicas", last, d.Get("name_prefix"), replicas)
+		if err := deleteVMGroupMember(ctx, conn, meta, last, timeout); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+			return diags
+		}
+		members = members[:len(members)-1]
+		vms = vms[:len(vms)-1]
+	}
+
+	outdated := make([]string, 0)
+	for _, vm := range vms {
+		if !spec.matchesVM(vm) {
+			outdated = append(outdated, utils.StringValue(vm.ExtId))
+		}
+	}
+
+	failed := make([]string, 0)
+	for start := 0; start < len(outdated); start += maxUnavailable {
+		end := start + maxUnavailable
+		if end > len(outdated) {
+			end = len(outdated)
+		}
+		batch := outdated[start:end]
+		log.Printf("[INFO] rolling out the configuration of group %s to VMs %s", d.Get("name_prefix"), strings.Join(batch, ", "))
+
+		errs := runVMGroupBatch(batch, func(vmExtID string) error {
+			return updateVMGroupMember(ctx, conn, meta, vmExtID, spec, healthCheck, timeout)
+		})
+		if len(errs) == 0 {
+			continue
+		}
+		for _, err := range errs {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+		failed = append(failed, batch...)
+		if pauseOnFailure {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "rolling update paused",
+				Detail: fmt.Sprintf("the rollout stopped after %d of %d outdated VMs. The remaining VMs keep their previous configuration, "+
+					"and the rollout resumes on the next apply.", end, len(outdated)),
+			})
+			return diags
+		}
+	}
+
+	usedNames := make(map[string]bool)
+	for _, vm := range vms {
+		usedNames[utils.StringValue(vm.Name)] = true
+	}
+	for len(members) < replicas {
+		name := vmGroupMemberName(d.Get("name_prefix").(string), usedNames)
+		vmExtID, err := createVMGroupMember(ctx, d, meta, name, timeout)
+		if vmExtID != "" {
+			members = append(members, vmExtID)
+		}
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+			return diags
+		}
+		if err := powerVMGroupMember(ctx, conn, meta, vmExtID, true, timeout); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+			return diags
+		}
+		if err := waitForVMGroupMemberHealth(ctx, conn, vmExtID, healthCheck); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+			return diags
+		}
+	}
+
+	if len(failed) > 0 {
+		diags = append(diags, diag.Errorf("failed to update VMs %s of group %s", strings.Join(failed, ", "), d.Get("name_prefix"))...)
+	}
+	return diags
+}
+
+// runVMGroupBatch runs the operation on the VMs of a batch concurrently and returns the errors
+func runVMGroupBatch(batch []string, operation func(vmExtID string) error) []error {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	errs := make([]error, 0)
+
+	for _, vmExtID := range batch {
+		wg.Add(1)
+		go func(vmExtID string) {
+			defer wg.Done()
+			if err := operation(vmExtID); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("VM %s: %s", vmExtID, err))
+				mu.Unlock()
+			}
+		}(vmExtID)
+	}
+	wg.Wait()
+	return errs
+}
+
+// createVMGroupMember clones the source VM or deploys the source template to create the VM name of the group
+func createVMGroupMember(ctx context.Context, d *schema.ResourceData, meta interface{}, name string, timeout time.Duration) (string, error) {
+	conn := meta.(*conns.Client).VmmAPI()
+	source := d.Get("source").([]interface{})[0].(map[string]interface{})
+	spec := expandVMGroupSpec(d.GetOk)
+
+	var guestCustomization *config.GuestCustomizationParams
+	if guestCstm, ok := d.GetOk("guest_customization"); ok {
+		guestCustomization = expandGuestCustomizationParams(guestCstm)
+	}
+
+	var uuid *string
+	if sourceVMExtID := source["vm_ext_id"].(string); sourceVMExtID != "" {
+		readResp, err := conn.VMAPIInstance.GetVmById(utils.StringPtr(sourceVMExtID))
+		if err != nil {
+			return "", fmt.Errorf("error while reading vm : %v", err)
+		}
+		// Extract E-Tag Header
+		args := make(map[string]interface{})
+		args["If-Match"] = getEtagHeader(readResp, conn)
+
+		body := &config.CloneOverrideParams{
+			Name:               utils.StringPtr(name),
+			NumSockets:         spec.numSockets,
+			NumCoresPerSocket:  spec.numCoresPerSocket,
+			NumThreadsPerCore:  spec.numThreadsPerCore,
+			MemorySizeBytes:    spec.memorySizeBytes,
+			GuestCustomization: guestCustomization,
+		}
+		if uuid, err = cloneVM(ctx, meta, sourceVMExtID, body, args, timeout); err != nil {
+			return "", fmt.Errorf("VM %s: %s", name, err)
+		}
+	} else {
+		body := &import5.TemplateDeployment{
+			NumberOfVms:      utils.IntPtr(1),
+			ClusterReference: utils.StringPtr(source["cluster_ext_id"].(string)),
+			OverrideVmConfigMap: map[string]import5.VmConfigOverride{
+				"0": {
+					Name:               utils.StringPtr(name),
+					NumSockets:         spec.numSockets,
+					NumCoresPerSocket:  spec.numCoresPerSocket,
+					NumThreadsPerCore:  spec.numThreadsPerCore,
+					MemorySizeBytes:    spec.memorySizeBytes,
+					GuestCustomization: guestCustomization,
+				},
+			},
+		}
+		if versionID := source["template_version_id"].(string); versionID != "" {
+			body.VersionId = utils.StringPtr(versionID)
+		}
+		var err error
+		if uuid, err = deployTemplate(ctx, meta, source["template_ext_id"].(string), body, timeout); err != nil {
+			return "", fmt.Errorf("VM %s: %s", name, err)
+		}
+	}
+	return utils.StringValue(uuid), nil
+}
+
+// vmGroupMemberName returns the first name of the group which is not used yet, and marks it used
+func vmGroupMemberName(prefix string, used map[string]bool) string {
+	for index := 1; ; index++ {
+		name := fmt.Sprintf("%s-%d", prefix, index)
+		if !used[name] {
+			used[name] = true
+			return name
+		}
+	}
+}
+
+// updateVMGroupMember powers the VM off, applies the configuration of the group, powers it on and waits
+// for it to be healthy
+func updateVMGroupMember(ctx context.Context, conn *vmm.Client, meta interface{}, vmExtID string, spec vmGroupSpec,
+	healthCheck vmGroupHealthCheck, timeout time.Duration,
+) error {
+	if err := powerVMGroupMember(ctx, conn, meta, vmExtID, false, timeout); err != nil {
+		return err
+	}
+
+	readResp, err := conn.VMAPIInstance.GetVmById(utils.StringPtr(vmExtID))
+	if err != nil {
+		return fmt.Errorf("error while reading vm : %v", err)
+	}
+	// Extract E-Tag Header
+	args := make(map[string]interface{})
+	args["If-Match"] = getEtagHeader(readResp, conn)
+
+	vm := readResp.Data.GetValue().(config.Vm)
+	if spec.numSockets != nil {
+		vm.NumSockets = spec.numSockets
+	}
+	if spec.numCoresPerSocket != nil {
+		vm.NumCoresPerSocket = spec.numCoresPerSocket
+	}
+	if spec.numThreadsPerCore != nil {
+		vm.NumThreadsPerCore = spec.numThreadsPerCore
+	}
+	if spec.memorySizeBytes != nil {
+		vm.MemorySizeBytes = spec.memorySizeBytes
+	}
+
+	resp, err := conn.VMAPIInstance.UpdateVmById(utils.StringPtr(vmExtID), &vm, args)
+	if err != nil {
+		return fmt.Errorf("error while updating vm : %v", err)
+	}
+	TaskRef := resp.Data.GetValue().(import1.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	taskconn := meta.(*conns.Client).PrismAPI()
+	// Wait for the VM to be updated
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), timeout); errWaitTask != nil {
+		return fmt.Errorf("error waiting for vm (%s) to update: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+
+	if err := powerVMGroupMember(ctx, conn, meta, vmExtID, true, timeout); err != nil {
+		return err
+	}
+	return waitForVMGroupMemberHealth(ctx, conn, vmExtID, healthCheck)
+}
+
+// powerVMGroupMember powers the VM on or off, unless it already is
+func powerVMGroupMember(ctx context.Context, conn *vmm.Client, meta interface{}, vmExtID string, on bool, timeout time.Duration) error {
+	readResp, err := conn.VMAPIInstance.GetVmById(utils.StringPtr(vmExtID))
+	if err != nil {
+		return fmt.Errorf("error while reading vm : %v", err)
+	}
+	vm := readResp.Data.GetValue().(config.Vm)
+
+	var TaskRef import1.TaskReference
+	switch powerState := flattenPowerState(vm.PowerState); {
+	case on && powerState != "ON":
+		TaskRef, err = powerOnVM(ctx, conn, utils.StringPtr(vmExtID))
+	case !on && powerState != "OFF":
+		TaskRef, err = powerOffVM(ctx, conn, utils.StringPtr(vmExtID))
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	taskconn := meta.(*conns.Client).PrismAPI()
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(TaskRef.ExtId), timeout); errWaitTask != nil {
+		return fmt.Errorf("error waiting for vm (%s) to change its power state: %s", vmExtID, errWaitTask)
+	}
+	return nil
+}
+
+// waitForVMGroupMemberHealth waits for the VM to have an IP address and reachable guest tools, as configured
+func waitForVMGroupMemberHealth(ctx context.Context, conn *vmm.Client, vmExtID string, healthCheck vmGroupHealthCheck) error {
+	if !healthCheck.waitForIP && !healthCheck.waitForNGT {
+		return nil
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"WAITING"},
+		Target:  []string{"HEALTHY"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := conn.VMAPIInstance.GetVmById(utils.StringPtr(vmExtID))
+			if err != nil {
+				return nil, "", err
+			}
+			vm := resp.Data.GetValue().(config.Vm)
+
+			if healthCheck.waitForIP && vmGroupMemberIPAddress(vm) == "" {
+				return vm, "WAITING", nil
+			}
+			if healthCheck.waitForNGT && (vm.GuestTools == nil || !utils.BoolValue(vm.GuestTools.IsReachable)) {
+				return vm, "WAITING", nil
+			}
+			return vm, "HEALTHY", nil
+		},
+		Timeout:    healthCheck.timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("VM %s did not become healthy: %s", vmExtID, err)
+	}
+	return nil
+}
+
+// deleteVMGroupMember deletes the VM, unless it is already deleted
+func deleteVMGroupMember(ctx context.Context, conn *vmm.Client, meta interface{}, vmExtID string, timeout time.Duration) error {
+	readResp, err := conn.VMAPIInstance.GetVmById(utils.StringPtr(vmExtID))
+	if err != nil {
+		if isVmmNotFoundErr(err) {
+			log.Printf("[WARN] VM %s of the group is already deleted", vmExtID)
+			return nil
+		}
+		return fmt.Errorf("error while reading vm : %v", err)
+	}
+	// Extract E-Tag Header
+	args := make(map[string]interface{})
+	args["If-Match"] = getEtagHeader(readResp, conn)
+
+	resp, err := conn.VMAPIInstance.DeleteVmById(utils.StringPtr(vmExtID), args)
+	if err != nil {
+		return fmt.Errorf("error while deleting vm : %v", err)
+	}
+	TaskRef := resp.Data.GetValue().(import1.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	taskconn := meta.(*conns.Client).PrismAPI()
+	// Wait for the VM to be deleted
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), timeout); errWaitTask != nil {
+		return fmt.Errorf("error waiting for vm (%s) to delete: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+	return nil
+}
+
+// readVMGroupMembers reads the VMs of the group. The VMs which no longer exist are left out, so that the
+// group plans to create them again.
+func readVMGroupMembers(conn *vmm.Client, members []string) ([]config.Vm, error) {
+	vms := make([]config.Vm, 0, len(members))
+	for _, vmExtID := range members {
+		resp, err := conn.VMAPIInstance.GetVmById(utils.StringPtr(vmExtID))
+		if err != nil {
+			if isVmmNotFoundErr(err) {
+				log.Printf("[WARN] VM %s of the group not found, dropping it from the group", vmExtID)
+				continue
+			}
+			return nil, fmt.Errorf("error while fetching vm %s : %v", vmExtID, err)
+		}
+		vms = append(vms, resp.Data.GetValue().(config.Vm))
+	}
+	return vms, nil
+}
+
+// flattenVMGroupMembersByID reads and flattens the VMs of the group. The VMs which cannot be read are
+// recorded with their identifier only, so that they stay in the group, and the deleted VMs are left out.
+func flattenVMGroupMembersByID(conn *vmm.Client, members []string) []interface{} {
+	flattened := make([]interface{}, 0, len(members))
+	for _, vmExtID := range members {
+		vms, err := readVMGroupMembers(conn, []string{vmExtID})
+		if err != nil {
+			log.Printf("[WARN] %s", err)
+			flattened = append(flattened, map[string]interface{}{"ext_id": vmExtID})
+			continue
+		}
+		flattened = append(flattened, flattenVMGroupMembers(vms)...)
+	}
+	return flattened
+}
+
+func flattenVMGroupMembers(vms []config.Vm) []interface{} {
+	flattened := make([]interface{}, len(vms))
+	for i, vm := range vms {
+		member := map[string]interface{}{
+			"ext_id":               utils.StringValue(vm.ExtId),
+			"name":                 utils.StringValue(vm.Name),
+			"num_sockets":          utils.IntValue(vm.NumSockets),
+			"num_cores_per_socket": utils.IntValue(vm.NumCoresPerSocket),
+			"num_threads_per_core": utils.IntValue(vm.NumThreadsPerCore),
+			"memory_size_bytes":    int(utils.Int64Value(vm.MemorySizeBytes)),
+			"power_state":          flattenPowerState(vm.PowerState),
+			"ip_address":           vmGroupMemberIPAddress(vm),
+			"is_ngt_reachable":     vm.GuestTools != nil && utils.BoolValue(vm.GuestTools.IsReachable),
+		}
+		flattened[i] = member
+	}
+	return flattened
+}
+
+// vmGroupMemberIPAddress returns the first IP address of the VM
+func vmGroupMemberIPAddress(vm config.Vm) string {
+	for _, nic := range vm.Nics {
+		if ip := getFirstIPAddress(nic); ip != "" {
+			return ip
+		}
+	}
+	return ""
+}
+
+func vmGroupMemberIDs(vms interface{}) []string {
+	members := make([]string, 0)
+	for _, vm := range vms.([]interface{}) {
+		if vm == nil {
+			continue
+		}
+		if extID := vm.(map[string]interface{})["ext_id"].(string); extID != "" {
+			members = append(members, extID)
+		}
+	}
+	return members
+}
+
+func expandVMGroupSpec(getOk func(string) (interface{}, bool)) vmGroupSpec {
+	spec := vmGroupSpec{}
+	if numSock, ok := getOk("num_sockets"); ok {
+		spec.numSockets = utils.IntPtr(numSock.(int))
+	}
+	if numCoresPerSock, ok := getOk("num_cores_per_socket"); ok {
+		spec.numCoresPerSocket = utils.IntPtr(numCoresPerSock.(int))
+	}
+	if numThreadsPerCore, ok := getOk("num_threads_per_core"); ok {
+		spec.numThreadsPerCore = utils.IntPtr(numThreadsPerCore.(int))
+	}
+	if memorySize, ok := getOk("memory_size_bytes"); ok {
+		spec.memorySizeBytes = utils.Int64Ptr(int64(memorySize.(int)))
+	}
+	return spec
+}
+
+func (s vmGroupSpec) matchesVM(vm config.Vm) bool {
+	return s.matchesMember(flattenVMGroupMembers([]config.Vm{vm})[0].(map[string]interface{}))
+}
+
+func (s vmGroupSpec) matchesMember(member map[string]interface{}) bool {
+	if s.numSockets != nil && member["num_sockets"] != *s.numSockets {
+		return false
+	}
+	if s.numCoresPerSocket != nil && member["num_cores_per_socket"] != *s.numCoresPerSocket {
+		return false
+	}
+	if s.numThreadsPerCore != nil && member["num_threads_per_core"] != *s.numThreadsPerCore {
+		return false
+	}
+	if s.memorySizeBytes != nil && member["memory_size_bytes"] != int(*s.memorySizeBytes) {
+		return false
+	}
+	return true
+}
+
+func expandVMGroupHealthCheck(pr interface{}) vmGroupHealthCheck {
+	healthCheck := vmGroupHealthCheck{timeout: vmGroupHealthCheckTimeout * time.Minute}
+	prI := pr.([]interface{})
+	if len(prI) == 0 || prI[0] == nil {
+		return healthCheck
+	}
+	val := prI[0].(map[string]interface{})
+	healthCheck.waitForIP = val["wait_for_ip"].(bool)
+	healthCheck.waitForNGT = val["wait_for_ngt"].(bool)
+	healthCheck.timeout = time.Duration(val["timeout_minutes"].(int)) * time.Minute
+	return healthCheck
+}
+
+func expandVMGroupRollingUpdate(pr interface{}) (int, bool) {
+	prI := pr.([]interface{})
+	if len(prI) == 0 || prI[0] == nil {
+		return 1, true
+	}
+	val := prI[0].(map[string]interface{})
+	return val["max_unavailable"].(int), val["pause_on_failure"].(bool)
+}
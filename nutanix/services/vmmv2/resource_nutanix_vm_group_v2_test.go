@@ -0,0 +1,97 @@
+package vmmv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+)
+
+const resourceNameVMGroup = "nutanix_vm_group_v2.test"
+
+func TestAccV2NutanixVMGroupResource_RollingUpdateAndScale(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-vm-group-%d", r)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			// create the group
+			{
+				Config: testVMGroupV2Config(name, 2, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVMGroup, "vms.#", "2"),
+					resource.TestCheckResourceAttr(resourceNameVMGroup, "vms.0.name", name+"-1"),
+					resource.TestCheckResourceAttr(resourceNameVMGroup, "vms.1.name", name+"-2"),
+					resource.TestCheckResourceAttr(resourceNameVMGroup, "vms.0.num_sockets", "1"),
+					resource.TestCheckResourceAttr(resourceNameVMGroup, "vms.1.power_state", "ON"),
+				),
+			},
+			// roll out a new configuration one VM at a time
+			{
+				Config: testVMGroupV2Config(name, 2, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVMGroup, "vms.#", "2"),
+					resource.TestCheckResourceAttr(resourceNameVMGroup, "vms.0.num_sockets", "2"),
+					resource.TestCheckResourceAttr(resourceNameVMGroup, "vms.1.num_sockets", "2"),
+					resource.TestCheckResourceAttr(resourceNameVMGroup, "vms.1.power_state", "ON"),
+				),
+			},
+			// scale up
+			{
+				Config: testVMGroupV2Config(name, 3, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVMGroup, "vms.#", "3"),
+					resource.TestCheckResourceAttr(resourceNameVMGroup, "vms.2.name", name+"-3"),
+					resource.TestCheckResourceAttr(resourceNameVMGroup, "vms.2.num_sockets", "2"),
+				),
+			},
+			// scale down
+			{
+				Config: testVMGroupV2Config(name, 1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVMGroup, "vms.#", "1"),
+					resource.TestCheckResourceAttr(resourceNameVMGroup, "vms.0.name", name+"-1"),
+				),
+			},
+		},
+	})
+}
+
+func testVMGroupV2Config(name string, replicas, sockets int) string {
+	return fmt.Sprintf(`
+		data "nutanix_clusters_v2" "clusters" {}
+
+		locals {
+			cluster0 = [
+			  for cluster in data.nutanix_clusters_v2.clusters.cluster_entities :
+			  cluster.ext_id if cluster.config[0].cluster_function[0] != "PRISM_CENTRAL"
+			][0]
+		}
+
+		resource "nutanix_virtual_machine_v2" "source" {
+			name                 = "%[1]s-source"
+			num_cores_per_socket = 1
+			num_sockets          = 1
+			memory_size_bytes    = 1073741824
+			cluster {
+				ext_id = local.cluster0
+			}
+		}
+
+		resource "nutanix_vm_group_v2" "test" {
+			name_prefix = "%[1]s"
+			replicas    = %[2]d
+			source {
+				vm_ext_id = nutanix_virtual_machine_v2.source.id
+			}
+			num_sockets = %[3]d
+			rolling_update {
+				max_unavailable = 1
+			}
+		}
+`, name, replicas, sockets)
+}
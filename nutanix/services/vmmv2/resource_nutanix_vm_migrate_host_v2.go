@@ -0,0 +1,138 @@
+package vmmv2
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/prism/v4/config"
+	"github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/vmm/v4/ahv/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/common"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// ResourceNutanixVMMigrateHostV2 live migrates a VM to another host of its cluster
+func ResourceNutanixVMMigrateHostV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceNutanixVMMigrateHostV2Create,
+		ReadContext:   ResourceNutanixVMMigrateHostV2Read,
+		DeleteContext: ResourceNutanixVMMigrateHostV2Delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"vm_ext_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"host": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ext_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceNutanixVMMigrateHostV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vmExtID := d.Get("vm_ext_id").(string)
+	host := expandHostReference(d.Get("host"))
+
+	taskUUID, diags := migrateVMToHost(ctx, meta, vmExtID, utils.StringValue(host.ExtId), d.Timeout(schema.TimeoutCreate))
+	if diags.HasError() {
+		return diags
+	}
+
+	// This is an action resource that does not maintain state.
+	// The resource ID is set to the task ExtId for traceability, or to the VM ExtId if the VM
+	// already runs on the host.
+	if taskUUID == "" {
+		taskUUID = vmExtID
+	}
+	d.SetId(taskUUID)
+	return nil
+}
+
+func ResourceNutanixVMMigrateHostV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func ResourceNutanixVMMigrateHostV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+// migrateVMToHost live migrates the VM vmExtID to the host hostExtID of its cluster, waits for the
+// migration task and checks the VM runs on the host. The migration task ext id is returned, empty if
+// the VM already runs on the host.
+func migrateVMToHost(ctx context.Context, meta interface{}, vmExtID, hostExtID string, timeout time.Duration) (string, diag.Diagnostics) {
+	conn := meta.(*conns.Client).VmmAPI()
+
+	readResp, err := conn.VMAPIInstance.GetVmById(utils.StringPtr(vmExtID))
+	if err != nil {
+		return "", diag.Errorf("error while reading vm : %v", err)
+	}
+	vm := readResp.Data.GetValue().(config.Vm)
+	if vm.Host != nil && utils.StringValue(vm.Host.ExtId) == hostExtID {
+		log.Printf("[DEBUG] vm %s already runs on host %s, it is not migrated", vmExtID, hostExtID)
+		return "", nil
+	}
+	if vm.PowerState != nil && *vm.PowerState != config.POWERSTATE_ON {
+		return "", diag.Errorf("vm %s must be powered on to be migrated to host %s, its power state is %s",
+			vmExtID, hostExtID, vm.PowerState.GetName())
+	}
+
+	// Extract E-Tag Header
+	args := make(map[string]interface{})
+	args["If-Match"] = getEtagHeader(readResp, conn)
+
+	body := config.VmMigrateToHostParams{
+		Host: &config.HostReference{ExtId: utils.StringPtr(hostExtID)},
+	}
+	aJSON, _ := json.MarshalIndent(body, "", "  ")
+	log.Printf("[DEBUG] VM Migrate To Host Request Body: %s", string(aJSON))
+
+	resp, err := conn.VMAPIInstance.MigrateVmToHost(utils.StringPtr(vmExtID), &body, args)
+	if err != nil {
+		return "", diag.Errorf("error while migrating vm %s to host %s: %v", vmExtID, hostExtID, err)
+	}
+
+	TaskRef := resp.Data.GetValue().(import1.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	taskconn := meta.(*conns.Client).PrismAPI()
+	// Wait for the VM migration to complete
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), timeout); errWaitTask != nil {
+		return "", diag.Errorf("error waiting for vm (%s) to migrate to host %s: %s", vmExtID, hostExtID, errWaitTask)
+	}
+
+	migratedResp, err := conn.VMAPIInstance.GetVmById(utils.StringPtr(vmExtID))
+	if err != nil {
+		return "", diag.Errorf("error while reading vm : %v", err)
+	}
+	migrated := migratedResp.Data.GetValue().(config.Vm)
+	if migrated.Host == nil || utils.StringValue(migrated.Host.ExtId) != hostExtID {
+		current := ""
+		if migrated.Host != nil {
+			current = utils.StringValue(migrated.Host.ExtId)
+		}
+		return "", diag.Errorf("vm %s runs on host %s after migration task %s, expected host %s",
+			vmExtID, current, utils.StringValue(taskUUID), hostExtID)
+	}
+
+	return utils.StringValue(taskUUID), nil
+}
@@ -0,0 +1,73 @@
+package vmmv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+)
+
+const resourceNameVMMigrateHost = "nutanix_vm_migrate_host_v2.test"
+
+func TestAccV2NutanixVMMigrateHostResource_Basic(t *testing.T) {
+	r := acctest.RandInt()
+	vmName := fmt.Sprintf("tf-test-vm-migrate-%d", r)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testVMPreEnvConfig(r) + testVMMigrateHostConfig(vmName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceNameVMMigrateHost, "id"),
+					resource.TestCheckResourceAttrPair(resourceNameVMMigrateHost, "host.0.ext_id", "data.nutanix_virtual_machine_v2.migrated", "host.0.ext_id"),
+				),
+			},
+		},
+	})
+}
+
+func testVMMigrateHostConfig(vmName string) string {
+	return fmt.Sprintf(`
+		data "nutanix_hosts_v2" "hosts" {
+		  filter = "cluster/uuid eq '${local.clusterUUID}'"
+		}
+
+		resource "nutanix_virtual_machine_v2" "test-vm" {
+		  name                 = "%[1]s"
+		  description          = "vm to migrate"
+		  num_cores_per_socket = 1
+		  num_sockets          = 1
+		  memory_size_bytes    = 1 * 1024 * 1024 * 1024
+		  cluster {
+			ext_id = local.clusterUUID
+		  }
+		  power_state = "ON"
+
+		  lifecycle {
+			ignore_changes = [guest_tools, host]
+		  }
+		}
+
+		locals {
+		  target_host = [
+			for host in data.nutanix_hosts_v2.hosts.host_entities :
+			host.ext_id if host.ext_id != nutanix_virtual_machine_v2.test-vm.host[0].ext_id
+		  ][0]
+		}
+
+		resource "nutanix_vm_migrate_host_v2" "test" {
+		  vm_ext_id = nutanix_virtual_machine_v2.test-vm.id
+		  host {
+			ext_id = local.target_host
+		  }
+		}
+
+		data "nutanix_virtual_machine_v2" "migrated" {
+		  ext_id     = nutanix_virtual_machine_v2.test-vm.id
+		  depends_on = [nutanix_vm_migrate_host_v2.test]
+		}
+`, vmName)
+}
@@ -3,10 +3,11 @@ package vmmv2
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	import2 "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
@@ -1226,7 +1227,7 @@ func ResourceNutanixVMCloneV2() *schema.Resource {
 }
 
 func ResourceNutanixVMCloneV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 	vmExtID := d.Get("vm_ext_id")
 
 	readResp, err := conn.VMAPIInstance.GetVmById(utils.StringPtr(vmExtID.(string)))
@@ -1261,46 +1262,17 @@ func ResourceNutanixVMCloneV2Create(ctx context.Context, d *schema.ResourceData,
 		body.BootConfig = expandOneOfCloneVMBootConfig(bootConfig)
 	}
 
-	resp, err := conn.VMAPIInstance.CloneVm(utils.StringPtr(vmExtID.(string)), body, args)
+	uuid, err := cloneVM(ctx, meta, vmExtID.(string), body, args, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.Errorf("error while Cloning Vm : %v", err)
-	}
-
-	TaskRef := resp.Data.GetValue().(import1.TaskReference)
-	taskUUID := TaskRef.ExtId
-
-	taskconn := meta.(*conns.Client).PrismAPI
-	// Wait for the VM to be cloned
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: common.TaskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutCreate),
-	}
-
-	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
-		return diag.Errorf("error waiting for VM clone (%s) to complete: %s", utils.StringValue(taskUUID), errWaitTask)
-	}
-
-	// Get UUID from TASK API
-	taskResp, err := taskconn.TaskRefAPI.GetTaskById(taskUUID, nil)
-	if err != nil {
-		return diag.Errorf("error while fetching VM clone task (%s): %v", utils.StringValue(taskUUID), err)
+		return diag.FromErr(err)
 	}
-	taskDetails := taskResp.Data.GetValue().(import2.Task)
-
-	aJSON, _ := json.MarshalIndent(taskDetails, "", " ")
-	log.Printf("[DEBUG] Clone VM Task Details: %s", string(aJSON))
-
-	// The cloned VM is the second entity (index 1) in EntitiesAffected
-	uuid := taskDetails.EntitiesAffected[1].ExtId
 	d.SetId(utils.StringValue(uuid))
 
 	return ResourceNutanixVMCloneV2Read(ctx, d, meta)
 }
 
 func ResourceNutanixVMCloneV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VmmAPI
+	conn := meta.(*conns.Client).VmmAPI()
 
 	resp, err := conn.VMAPIInstance.GetVmById(utils.StringPtr(d.Id()))
 	if err != nil {
@@ -1476,6 +1448,46 @@ func ResourceNutanixVMCloneV2Delete(ctx context.Context, d *schema.ResourceData,
 	return ResourceNutanixVirtualMachineV2Delete(ctx, d, meta)
 }
 
+// cloneVM clones the VM vmExtID with the overrides of body, waits for the clone task and returns the
+// ext_id of the cloned VM. args carries the If-Match header of the VM.
+func cloneVM(ctx context.Context, meta interface{}, vmExtID string, body *config.CloneOverrideParams,
+	args map[string]interface{}, timeout time.Duration,
+) (*string, error) {
+	conn := meta.(*conns.Client).VmmAPI()
+
+	resp, err := conn.VMAPIInstance.CloneVm(utils.StringPtr(vmExtID), body, args)
+	if err != nil {
+		return nil, fmt.Errorf("error while Cloning Vm : %v", err)
+	}
+
+	TaskRef := resp.Data.GetValue().(import1.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	taskconn := meta.(*conns.Client).PrismAPI()
+	// Wait for the VM to be cloned
+	if _, errWaitTask := common.WaitForTask(ctx, taskconn, utils.StringValue(taskUUID), timeout); errWaitTask != nil {
+		return nil, fmt.Errorf("error waiting for VM clone (%s) to complete: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+
+	// Get UUID from TASK API
+	taskResp, err := taskconn.TaskRefAPI.GetTaskById(taskUUID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching VM clone task (%s): %v", utils.StringValue(taskUUID), err)
+	}
+	taskDetails := taskResp.Data.GetValue().(import2.Task)
+
+	aJSON, _ := json.MarshalIndent(taskDetails, "", " ")
+	log.Printf("[DEBUG] Clone VM Task Details: %s", string(aJSON))
+
+	// the source VM is an affected entity as well, the cloned VM is the other one
+	for _, entity := range taskDetails.EntitiesAffected {
+		if utils.StringValue(entity.Rel) == utils.RelEntityTypeVM && utils.StringValue(entity.ExtId) != vmExtID {
+			return entity.ExtId, nil
+		}
+	}
+	return nil, fmt.Errorf("cloned VM UUID not found in entities affected of task %s", utils.StringValue(taskUUID))
+}
+
 func expandOneOfCloneVMBootConfig(pr interface{}) *config.OneOfCloneOverrideParamsBootConfig {
 	if pr != nil {
 		prI := pr.([]interface{})
@@ -168,11 +168,11 @@ func testAccCheckNutanixVirtualMachineV2Destroy(s *terraform.State) error {
 		if rs.Type != "nutanix_virtual_machine_v2" {
 			continue
 		}
-		_, err := conn.VmmAPI.VMAPIInstance.GetVmById(utils.StringPtr(rs.Primary.ID))
+		_, err := conn.VmmAPI().VMAPIInstance.GetVmById(utils.StringPtr(rs.Primary.ID))
 		if err == nil {
 			// delete the vm
 			fmt.Printf("Deleting VM with ID: %s\n", rs.Primary.ID)
-			_, errVM := conn.VmmAPI.VMAPIInstance.DeleteVmById(utils.StringPtr(rs.Primary.ID))
+			_, errVM := conn.VmmAPI().VMAPIInstance.DeleteVmById(utils.StringPtr(rs.Primary.ID))
 			if errVM != nil {
 				return errVM
 			}
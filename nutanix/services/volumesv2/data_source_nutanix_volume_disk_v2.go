@@ -130,7 +130,7 @@ func DatasourceNutanixVolumeDiskV2() *schema.Resource {
 }
 
 func DatasourceNutanixVolumeDiskV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VolumeAPI
+	conn := meta.(*conns.Client).VolumeAPI()
 
 	volumeGroupExtID := d.Get("volume_group_ext_id")
 	volumeDiskExtID := d.Get("ext_id")
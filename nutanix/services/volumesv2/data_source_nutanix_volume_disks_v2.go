@@ -166,7 +166,7 @@ func DatasourceNutanixVolumeDisksV2() *schema.Resource {
 }
 
 func DatasourceNutanixVolumeDisksV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VolumeAPI
+	conn := meta.(*conns.Client).VolumeAPI()
 
 	var filter, orderBy, selects *string
 	var page, limit *int
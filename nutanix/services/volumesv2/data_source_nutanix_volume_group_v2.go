@@ -137,7 +137,7 @@ func DatasourceNutanixVolumeGroupV2() *schema.Resource {
 }
 
 func DatasourceNutanixVolumeGroupV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VolumeAPI
+	conn := meta.(*conns.Client).VolumeAPI()
 
 	extID := d.Get("ext_id")
 
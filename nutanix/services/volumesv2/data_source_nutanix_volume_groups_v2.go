@@ -176,7 +176,7 @@ func DatasourceNutanixVolumeGroupsV2() *schema.Resource {
 }
 
 func DatasourceNutanixVolumeGroupsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VolumeAPI
+	conn := meta.(*conns.Client).VolumeAPI()
 
 	var filter, orderBy, expand, selects *string
 	var page, limit *int
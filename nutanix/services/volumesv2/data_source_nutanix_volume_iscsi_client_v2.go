@@ -116,7 +116,7 @@ func DatasourceNutanixVolumeIscsiClientV2() *schema.Resource {
 }
 
 func DatasourceNutanixVolumeIscsiClientV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VolumeAPI
+	conn := meta.(*conns.Client).VolumeAPI()
 
 	extID := d.Get("ext_id")
 
@@ -155,7 +155,7 @@ func DatasourceNutanixVolumeIscsiClientsV2() *schema.Resource {
 }
 
 func DatasourceNutanixVolumeIscsiClientsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.Client).VolumeAPI
+	conn := meta.(*conns.Client).VolumeAPI()
 
 	var filter, orderBy, expand, selects *string
 	var page, limit *int
@@ -65,7 +65,7 @@ func testAccCheckNutanixVolumeGroupV2Destroy(s *terraform.State) error {
 			continue
 		}
 		// Verify the volume group is destroyed by trying to get it (not by deleting again).
-		_, err := conn.VolumeAPI.VolumeAPIInstance.GetVolumeGroupById(utils.StringPtr(rs.Primary.ID), nil)
+		_, err := conn.VolumeAPI().VolumeAPIInstance.GetVolumeGroupById(utils.StringPtr(rs.Primary.ID), nil)
 		if err == nil {
 			return fmt.Errorf("volume group %s still exists", rs.Primary.ID)
 		}
@@ -80,7 +80,7 @@ func resourceNutanixVolumeGroupV2Exists(conn *conns.Client, name string) (*strin
 	var vgUUID *string
 
 	filter := fmt.Sprintf("name==%s", name)
-	vgList, err := conn.VolumeAPI.VolumeAPIInstance.ListVolumeGroups(nil, nil, &filter, nil, nil, nil)
+	vgList, err := conn.VolumeAPI().VolumeAPIInstance.ListVolumeGroups(nil, nil, &filter, nil, nil, nil)
 
 	log.Printf("Volume Group List: %v", vgList)
 
@@ -6,6 +6,7 @@ const (
 	RelEntityTypeVM                      = "vmm:ahv:config:vm"
 	RelEntityTypeImages                  = "vmm:content:image"
 	RelEntityTypeImagePlacementPolicy    = "vmm:images:config:placement-policy"
+	RelEntityTypeImageRateLimitPolicy    = "vmm:images:config:rate-limit-policy"
 	RelEntityTypeTemplates               = "vmm:content:template"
 	RelEntityTypeVolumeGroup             = "volumes:config:volume-group"
 	RelEntityTypeVolumeGroupDisk         = "volumes:config:volume-group:disk"
@@ -27,6 +28,13 @@ const (
 	RelEntityTypeVMRecoveryPoint         = "dataprotection:config:vm-recovery-point"
 	RelEntityTypeStorageContainer        = "clustermgmt:config:storage-containers"
 	RelEntityTypeRoute                   = "networking:config:route"
+	RelEntityTypeBgpSession              = "networking:config:bgp-session"
+	RelEntityTypeGateway                 = "networking:config:gateway"
+	RelEntityTypeVpnConnection           = "networking:config:vpn-connection"
+	RelEntityTypeLayer2Stretch           = "networking:config:layer2-stretch"
+	RelEntityTypeTrafficMirror           = "networking:config:traffic-mirror"
+	RelEntityTypeIPFIXExporter           = "networking:config:ipfix-exporter"
+	RelEntityTypeVirtualSwitch           = "networking:config:virtual-switch"
 	RelEntityTypeObjects                 = "objects:config:object-store"
 	RelEntityTypeObjectStoreCertificate  = "objects:config:object-store:certificate"
 	RelEntityTypeOVA                     = "vmm:content:ova"
@@ -26,18 +26,26 @@ func ToJSONString(v interface{}) string {
 }
 
 // DebugRequest ...
+// Credential headers are redacted, use the NUTANIX_TRACE_FILE tracing for redacted bodies.
 func DebugRequest(req *http.Request) {
-	requestDump, err := httputil.DumpRequest(req, true)
+	r := req.Clone(req.Context())
+	r.Header = redactHeaders(req.Header)
+	requestDump, err := httputil.DumpRequest(r, true)
 	if err != nil {
 		log.Printf("[WARN] Error getting request's dump: %s\n", err)
 	}
+	req.Body = r.Body
 
 	log.Printf("[DEBUG] %s\n", string(requestDump))
 }
 
 // DebugResponse ...
+// Credential headers are redacted, use the NUTANIX_TRACE_FILE tracing for redacted bodies.
 func DebugResponse(res *http.Response) {
+	header := res.Header
+	res.Header = redactHeaders(header)
 	requestDump, err := httputil.DumpResponse(res, true)
+	res.Header = header
 	if err != nil {
 		log.Printf("[WARN] Error getting response's dump: %s\n", err)
 	}
@@ -45,6 +53,17 @@ func DebugResponse(res *http.Response) {
 	log.Printf("[DEBUG] %s\n", string(requestDump))
 }
 
+// redactHeaders returns a copy of header without the values of the credential headers
+func redactHeaders(header http.Header) http.Header {
+	redacted := header.Clone()
+	for _, name := range []string{"Authorization", "X-Ntnx-Api-Key", "Cookie", "Set-Cookie"} {
+		if redacted.Get(name) != "" {
+			redacted.Set(name, "REDACTED")
+		}
+	}
+	return redacted
+}
+
 func ConvertMapString(o map[string]interface{}) map[string]string {
 	converted := make(map[string]string)
 	for k, v := range o {